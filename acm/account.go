@@ -39,6 +39,8 @@ type Account interface {
 	Sequence() uint64
 	// The permission flags and roles for this account
 	Permissions() permission.AccountPermissions
+	// The members and threshold of a multisig account, nil for other accounts
+	Multisig() *MultisigPolicy
 	// Obtain a deterministic serialisation of this account
 	// (i.e. update order and Go runtime independent)
 	Encode() ([]byte, error)
@@ -106,6 +108,7 @@ func AsConcreteAccount(account Account) *ConcreteAccount {
 		Code:        account.Code(),
 		Sequence:    account.Sequence(),
 		Permissions: account.Permissions(),
+		Multisig:    account.Multisig(),
 	}
 }
 
@@ -147,6 +150,7 @@ func (acc MutableAccount) Sequence() uint64            { return acc.concreteAcco
 func (acc MutableAccount) Permissions() permission.AccountPermissions {
 	return acc.concreteAccount.Permissions
 }
+func (acc MutableAccount) Multisig() *MultisigPolicy { return acc.concreteAccount.Multisig }
 
 ///---- Mutable methods
 // Set public key (needed for lazy initialisation), should also set the dependent address
@@ -194,6 +198,20 @@ func (acc *MutableAccount) SetPermissions(accPerms permission.AccountPermissions
 	return nil
 }
 
+// Makes the account a multisig account controlled by policy, which must be the policy from which its address derives
+func (acc *MutableAccount) SetMultisig(policy *MultisigPolicy) error {
+	err := policy.Validate()
+	if err != nil {
+		return err
+	}
+	if policy.Address() != acc.Address() {
+		return fmt.Errorf("cannot set multisig policy with address %v on account %v", policy.Address(),
+			acc.Address())
+	}
+	acc.concreteAccount.Multisig = policy
+	return nil
+}
+
 func (acc *MutableAccount) MutablePermissions() *permission.AccountPermissions {
 	return &acc.concreteAccount.Permissions
 }
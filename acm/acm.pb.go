@@ -9,6 +9,8 @@
 
 	It has these top-level messages:
 		ConcreteAccount
+		MultisigPolicy
+		ContractMeta
 */
 package acm
 
@@ -21,6 +23,7 @@ import permission "github.com/hyperledger/burrow/permission"
 import crypto "github.com/hyperledger/burrow/crypto"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 
 import io "io"
 
@@ -43,6 +46,8 @@ type ConcreteAccount struct {
 	Balance     uint64                                       `protobuf:"varint,4,opt,name=Balance,proto3" json:"Balance,omitempty"`
 	Code        Bytecode                                     `protobuf:"bytes,5,opt,name=Code,proto3,customtype=Bytecode" json:"Code"`
 	Permissions permission.AccountPermissions                `protobuf:"bytes,6,opt,name=Permissions" json:"Permissions"`
+	// Set for a multisig account, which has no PublicKey and whose inputs must be signed by Threshold of its members
+	Multisig *MultisigPolicy `protobuf:"bytes,7,opt,name=Multisig" json:"Multisig,omitempty"`
 }
 
 func (m *ConcreteAccount) Reset()                    { *m = ConcreteAccount{} }
@@ -77,12 +82,87 @@ func (m *ConcreteAccount) GetPermissions() permission.AccountPermissions {
 	return permission.AccountPermissions{}
 }
 
+func (m *ConcreteAccount) GetMultisig() *MultisigPolicy {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
 func (*ConcreteAccount) XXX_MessageName() string {
 	return "acm.ConcreteAccount"
 }
+
+// The members of a multisig account and the number of them that must sign for it, the account's address is derived
+// from the policy
+type MultisigPolicy struct {
+	Threshold  uint64             `protobuf:"varint,1,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	PublicKeys []crypto.PublicKey `protobuf:"bytes,2,rep,name=PublicKeys" json:"PublicKeys"`
+}
+
+func (m *MultisigPolicy) Reset()                    { *m = MultisigPolicy{} }
+func (m *MultisigPolicy) String() string            { return proto.CompactTextString(m) }
+func (*MultisigPolicy) ProtoMessage()               {}
+func (*MultisigPolicy) Descriptor() ([]byte, []int) { return fileDescriptorAcm, []int{1} }
+
+func (m *MultisigPolicy) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigPolicy) GetPublicKeys() []crypto.PublicKey {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+func (*MultisigPolicy) XXX_MessageName() string {
+	return "acm.MultisigPolicy"
+}
+
+// Metadata recorded on-chain along with a contract when it is created
+type ContractMeta struct {
+	// The JSON ABI of the contract
+	Abi             string `protobuf:"bytes,1,opt,name=Abi,proto3" json:"Abi,omitempty"`
+	CompilerVersion string `protobuf:"bytes,2,opt,name=CompilerVersion,proto3" json:"CompilerVersion,omitempty"`
+	// The hash of the contract source as given by the compiler
+	SourceHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=SourceHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"SourceHash"`
+	// The account that created the contract, which is set by the chain
+	Deployer github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,4,opt,name=Deployer,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Deployer"`
+}
+
+func (m *ContractMeta) Reset()                    { *m = ContractMeta{} }
+func (m *ContractMeta) String() string            { return proto.CompactTextString(m) }
+func (*ContractMeta) ProtoMessage()               {}
+func (*ContractMeta) Descriptor() ([]byte, []int) { return fileDescriptorAcm, []int{2} }
+
+func (m *ContractMeta) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *ContractMeta) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (*ContractMeta) XXX_MessageName() string {
+	return "acm.ContractMeta"
+}
 func init() {
 	proto.RegisterType((*ConcreteAccount)(nil), "acm.ConcreteAccount")
 	golang_proto.RegisterType((*ConcreteAccount)(nil), "acm.ConcreteAccount")
+	proto.RegisterType((*MultisigPolicy)(nil), "acm.MultisigPolicy")
+	golang_proto.RegisterType((*MultisigPolicy)(nil), "acm.MultisigPolicy")
+	proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
+	golang_proto.RegisterType((*ContractMeta)(nil), "acm.ContractMeta")
 }
 func (m *ConcreteAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -141,6 +221,97 @@ func (m *ConcreteAccount) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if m.Multisig != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(m.Multisig.Size()))
+		n5, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *MultisigPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, msg := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAcm(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ContractMeta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMeta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Abi) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(len(m.Abi)))
+		i += copy(dAtA[i:], m.Abi)
+	}
+	if len(m.CompilerVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAcm(dAtA, i, uint64(len(m.CompilerVersion)))
+		i += copy(dAtA[i:], m.CompilerVersion)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintAcm(dAtA, i, uint64(m.SourceHash.Size()))
+	n6, err := m.SourceHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintAcm(dAtA, i, uint64(m.Deployer.Size()))
+	n7, err := m.Deployer.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	return i, nil
 }
 
@@ -170,6 +341,43 @@ func (m *ConcreteAccount) Size() (n int) {
 	n += 1 + l + sovAcm(uint64(l))
 	l = m.Permissions.Size()
 	n += 1 + l + sovAcm(uint64(l))
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	return n
+}
+
+func (m *MultisigPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		n += 1 + sovAcm(uint64(m.Threshold))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, e := range m.PublicKeys {
+			l = e.Size()
+			n += 1 + l + sovAcm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContractMeta) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	l = len(m.CompilerVersion)
+	if l > 0 {
+		n += 1 + l + sovAcm(uint64(l))
+	}
+	l = m.SourceHash.Size()
+	n += 1 + l + sovAcm(uint64(l))
+	l = m.Deployer.Size()
+	n += 1 + l + sovAcm(uint64(l))
 	return n
 }
 
@@ -373,6 +581,307 @@ func (m *ConcreteAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Multisig == nil {
+				m.Multisig = &MultisigPolicy{}
+			}
+			if err := m.Multisig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, crypto.PublicKey{})
+			if err := m.PublicKeys[len(m.PublicKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAcm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAcm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAcm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompilerVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompilerVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAcm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAcm
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deployer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAcm(dAtA[iNdEx:])
@@ -503,26 +1012,36 @@ func init() { proto.RegisterFile("acm.proto", fileDescriptorAcm) }
 func init() { golang_proto.RegisterFile("acm.proto", fileDescriptorAcm) }
 
 var fileDescriptorAcm = []byte{
-	// 330 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xbd, 0x4e, 0xfb, 0x30,
-	0x14, 0xc5, 0xeb, 0x36, 0xff, 0x7e, 0xb8, 0x95, 0xfe, 0xc5, 0x53, 0xd4, 0xc1, 0x2d, 0x88, 0xa1,
-	0x03, 0x24, 0x12, 0x1f, 0x42, 0x62, 0x6b, 0x2a, 0xb1, 0x20, 0xa1, 0x2a, 0x6c, 0x6c, 0x89, 0x73,
-	0x49, 0x23, 0x35, 0x71, 0x70, 0x6c, 0xa1, 0xbc, 0x09, 0x23, 0x8f, 0x82, 0x98, 0x3a, 0x32, 0x33,
-	0x54, 0xa8, 0x7d, 0x11, 0x54, 0xe3, 0x96, 0x4c, 0x6c, 0x39, 0xf9, 0xdd, 0x73, 0xef, 0xd1, 0x31,
-	0xee, 0x04, 0x2c, 0x75, 0x72, 0xc1, 0x25, 0x27, 0x8d, 0x80, 0xa5, 0x83, 0xd3, 0x38, 0x91, 0x73,
-	0x15, 0x3a, 0x8c, 0xa7, 0x6e, 0xcc, 0x63, 0xee, 0x6a, 0x16, 0xaa, 0x47, 0xad, 0xb4, 0xd0, 0x5f,
-	0x3f, 0x9e, 0x41, 0x3f, 0x07, 0x91, 0x26, 0x45, 0x91, 0xf0, 0xcc, 0xfc, 0xe9, 0x31, 0x51, 0xe6,
-	0xd2, 0xf0, 0xa3, 0xf7, 0x3a, 0xfe, 0x3f, 0xe5, 0x19, 0x13, 0x20, 0x61, 0xc2, 0x18, 0x57, 0x99,
-	0x24, 0x77, 0xb8, 0x35, 0x89, 0x22, 0x01, 0x45, 0x61, 0xa3, 0x11, 0x1a, 0xf7, 0xbc, 0x8b, 0xe5,
-	0x6a, 0x58, 0xfb, 0x5c, 0x0d, 0x4f, 0x2a, 0xb7, 0xe7, 0x65, 0x0e, 0x62, 0x01, 0x51, 0x0c, 0xc2,
-	0x0d, 0x95, 0x10, 0xfc, 0xd9, 0x35, 0x8b, 0x8d, 0xd7, 0xdf, 0x2d, 0x21, 0x97, 0xb8, 0x33, 0x53,
-	0xe1, 0x22, 0x61, 0xb7, 0x50, 0xda, 0xf5, 0x11, 0x1a, 0x77, 0xcf, 0x0e, 0x1c, 0x33, 0xbc, 0x07,
-	0x9e, 0xb5, 0x3d, 0xe2, 0xff, 0x4e, 0x92, 0x01, 0x6e, 0xdf, 0xc3, 0x93, 0x82, 0x8c, 0x81, 0xdd,
-	0x18, 0xa1, 0xb1, 0xe5, 0xef, 0x35, 0xb1, 0x71, 0xcb, 0x0b, 0x16, 0xc1, 0x16, 0x59, 0x1a, 0xed,
-	0x24, 0x39, 0xc6, 0xd6, 0x94, 0x47, 0x60, 0xff, 0xd3, 0xc9, 0xfb, 0x26, 0x79, 0xdb, 0x2b, 0x25,
-	0x30, 0x1e, 0x81, 0xaf, 0x29, 0xb9, 0xc1, 0xdd, 0xd9, 0xbe, 0x98, 0xc2, 0x6e, 0xea, 0x50, 0xd4,
-	0xa9, 0x94, 0x65, 0xca, 0xa8, 0x4c, 0x99, 0x84, 0x55, 0xe3, 0xb5, 0xf5, 0xf2, 0x3a, 0xac, 0x79,
-	0x57, 0xcb, 0x35, 0x45, 0x1f, 0x6b, 0x8a, 0xbe, 0xd6, 0x14, 0xbd, 0x6d, 0x28, 0x5a, 0x6e, 0x28,
-	0x7a, 0x38, 0xfc, 0xbb, 0xad, 0x80, 0xa5, 0x61, 0x53, 0x3f, 0xc2, 0xf9, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5b, 0x02, 0x22, 0xf4, 0xe5, 0x01, 0x00, 0x00,
+	// 484 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0xbd, 0x4d, 0xcc, 0x25, 0xde, 0x8b, 0x20, 0x2c, 0x8d, 0x15, 0x21, 0x27, 0x44, 0x14, 0x29,
+	0x38, 0x5b, 0x02, 0x4e, 0x27, 0xd1, 0xc5, 0x41, 0xe8, 0x24, 0x74, 0x28, 0xf2, 0x01, 0x05, 0x9d,
+	0xbd, 0x1e, 0xec, 0x95, 0x6c, 0xaf, 0xd9, 0x5d, 0x0b, 0xfc, 0x27, 0x94, 0x7c, 0x0a, 0x65, 0x4a,
+	0x6a, 0x8a, 0x13, 0xca, 0x7d, 0xc2, 0xfd, 0x00, 0xf2, 0xe2, 0x38, 0x86, 0x22, 0x0d, 0xdd, 0xce,
+	0xbc, 0x37, 0x6f, 0xc6, 0x6f, 0xc6, 0xd8, 0x0c, 0x68, 0xe6, 0x14, 0x82, 0x2b, 0x4e, 0xfa, 0x01,
+	0xcd, 0x26, 0xa7, 0x31, 0x53, 0x49, 0x19, 0x3a, 0x94, 0x67, 0x6e, 0xcc, 0x63, 0xee, 0x6a, 0x2c,
+	0x2c, 0x3f, 0xea, 0x48, 0x07, 0xfa, 0xf5, 0xa7, 0x66, 0x32, 0x2e, 0x40, 0x64, 0x4c, 0x4a, 0xc6,
+	0xf3, 0x26, 0x33, 0xa2, 0xa2, 0x2a, 0x54, 0x83, 0xcf, 0x6f, 0x7b, 0xf8, 0xde, 0x8a, 0xe7, 0x54,
+	0x80, 0x82, 0x25, 0xa5, 0xbc, 0xcc, 0x15, 0x79, 0x83, 0x07, 0xcb, 0x28, 0x12, 0x20, 0xa5, 0x85,
+	0x66, 0x68, 0x31, 0xf2, 0x9e, 0x6f, 0xae, 0xa7, 0x47, 0x3f, 0xaf, 0xa7, 0x4f, 0x3a, 0xbd, 0x93,
+	0xaa, 0x00, 0x91, 0x42, 0x14, 0x83, 0x70, 0xc3, 0x52, 0x08, 0xfe, 0xd9, 0x6d, 0x84, 0x9b, 0x5a,
+	0x7f, 0x27, 0x42, 0xce, 0xb0, 0xb9, 0x2e, 0xc3, 0x94, 0xd1, 0xd7, 0x50, 0x59, 0xbd, 0x19, 0x5a,
+	0x9c, 0x3c, 0xbd, 0xef, 0x34, 0xe4, 0x16, 0xf0, 0x8c, 0xba, 0x89, 0xbf, 0x67, 0x92, 0x09, 0x1e,
+	0x5e, 0xc1, 0xa7, 0x12, 0x72, 0x0a, 0x56, 0x7f, 0x86, 0x16, 0x86, 0xdf, 0xc6, 0xc4, 0xc2, 0x03,
+	0x2f, 0x48, 0x83, 0x1a, 0x32, 0x34, 0xb4, 0x0b, 0xc9, 0x63, 0x6c, 0xac, 0x78, 0x04, 0xd6, 0x1d,
+	0x3d, 0xf9, 0xb8, 0x99, 0x7c, 0xe8, 0x55, 0x0a, 0x28, 0x8f, 0xc0, 0xd7, 0x28, 0x79, 0x85, 0x4f,
+	0xd6, 0xad, 0x31, 0xd2, 0x3a, 0xd6, 0x43, 0xd9, 0x4e, 0xc7, 0xac, 0xc6, 0x8c, 0x0e, 0xab, 0x99,
+	0xb0, 0x5b, 0x48, 0x5c, 0x3c, 0xbc, 0x2c, 0x53, 0xc5, 0x24, 0x8b, 0xad, 0x81, 0x16, 0x79, 0xe0,
+	0xd4, 0x0b, 0xdb, 0x25, 0xd7, 0x3c, 0x65, 0xb4, 0xf2, 0x5b, 0xd2, 0x0b, 0xe3, 0xeb, 0xb7, 0xe9,
+	0xd1, 0x3c, 0xc6, 0x77, 0xff, 0x66, 0x90, 0x87, 0xd8, 0x7c, 0x9b, 0x08, 0x90, 0x09, 0x4f, 0x23,
+	0xed, 0xba, 0xe1, 0xef, 0x13, 0xe4, 0x1c, 0xe3, 0xd6, 0x17, 0x69, 0xf5, 0x66, 0xfd, 0x43, 0x16,
+	0x76, 0xa8, 0xf3, 0x5b, 0x84, 0x47, 0x2b, 0x9e, 0x2b, 0x11, 0x50, 0x75, 0x09, 0x2a, 0x20, 0x63,
+	0xdc, 0x5f, 0x86, 0x4c, 0x77, 0x30, 0xfd, 0xfa, 0x49, 0x16, 0xf5, 0x01, 0x64, 0x05, 0x4b, 0x41,
+	0xbc, 0x07, 0x51, 0x7f, 0x96, 0xde, 0x91, 0xe9, 0xff, 0x9b, 0x26, 0xef, 0x30, 0xbe, 0xe2, 0xa5,
+	0xa0, 0x70, 0x11, 0xc8, 0x44, 0xaf, 0x64, 0xe4, 0x9d, 0x35, 0x06, 0x9f, 0x1e, 0x3e, 0x8d, 0x90,
+	0xe5, 0x81, 0xa8, 0x9c, 0x0b, 0xf8, 0x52, 0x2f, 0x42, 0xfa, 0x1d, 0x21, 0xb2, 0xc6, 0xc3, 0x97,
+	0x50, 0xa4, 0xbc, 0x02, 0x61, 0x19, 0xff, 0x71, 0x6f, 0xad, 0x8a, 0x77, 0xbe, 0xd9, 0xda, 0xe8,
+	0xc7, 0xd6, 0x46, 0xbf, 0xb6, 0x36, 0xfa, 0x7e, 0x63, 0xa3, 0xcd, 0x8d, 0x8d, 0x3e, 0x3c, 0x3a,
+	0xac, 0x16, 0xd0, 0x2c, 0x3c, 0xd6, 0x3f, 0xc5, 0xb3, 0xdf, 0x03, 0x00, 0x0b, 0xbe, 0x18, 0xfa,
+	0x75, 0x03, 0x00, 0x00,
 }
@@ -0,0 +1,86 @@
+package acm
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+)
+
+// Returns a policy requiring threshold of the members with publicKeys to sign. The public keys are ordered by address
+// so that the policy, and so the address of its account, does not depend on the order in which they are given.
+func NewMultisigPolicy(threshold uint64, publicKeys ...crypto.PublicKey) (*MultisigPolicy, error) {
+	policy := &MultisigPolicy{
+		Threshold:  threshold,
+		PublicKeys: make([]crypto.PublicKey, len(publicKeys)),
+	}
+	copy(policy.PublicKeys, publicKeys)
+	sort.Slice(policy.PublicKeys, func(i, j int) bool {
+		return addressLess(policy.PublicKeys[i].Address(), policy.PublicKeys[j].Address())
+	})
+	err := policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Returns an error unless the policy's public keys are valid, distinct, and ordered by address and its threshold is
+// between 1 and the number of public keys
+func (mp *MultisigPolicy) Validate() error {
+	if len(mp.PublicKeys) == 0 {
+		return fmt.Errorf("multisig policy has no public keys")
+	}
+	if mp.Threshold == 0 || mp.Threshold > uint64(len(mp.PublicKeys)) {
+		return fmt.Errorf("multisig policy has threshold %d but must have a threshold between 1 and its %d "+
+			"public keys", mp.Threshold, len(mp.PublicKeys))
+	}
+	for i, publicKey := range mp.PublicKeys {
+		if !publicKey.IsValid() {
+			return fmt.Errorf("multisig policy has invalid public key %v", publicKey)
+		}
+		if i > 0 && !addressLess(mp.PublicKeys[i-1].Address(), publicKey.Address()) {
+			return fmt.Errorf("multisig policy public keys must be distinct and ordered by address")
+		}
+	}
+	return nil
+}
+
+// The address of the account controlled by the policy
+func (mp *MultisigPolicy) Address() crypto.Address {
+	bs := []byte("multisig")
+	threshold := make([]byte, 8)
+	binary.PutUint64BE(threshold, mp.Threshold)
+	bs = append(bs, threshold...)
+	for _, publicKey := range mp.PublicKeys {
+		bs = append(bs, publicKey.Encode()...)
+	}
+	var address crypto.Address
+	copy(address[:], sha3.Sha3(bs)[12:])
+	return address
+}
+
+// Returns the public key of the member with address or nil if there is none
+func (mp *MultisigPolicy) Member(address crypto.Address) *crypto.PublicKey {
+	for i, publicKey := range mp.PublicKeys {
+		if publicKey.Address() == address {
+			return &mp.PublicKeys[i]
+		}
+	}
+	return nil
+}
+
+// Creates an empty multisig account controlled by policy
+func NewMultisigAccount(policy *MultisigPolicy) *ConcreteAccount {
+	return &ConcreteAccount{
+		Address:  policy.Address(),
+		Multisig: policy,
+	}
+}
+
+func addressLess(a, b crypto.Address) bool {
+	return bytes.Compare(a[:], b[:]) < 0
+}
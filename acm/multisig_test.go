@@ -0,0 +1,57 @@
+package acm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMultisigPolicy(t *testing.T) {
+	a := GeneratePrivateAccountFromSecret("a").PublicKey()
+	b := GeneratePrivateAccountFromSecret("b").PublicKey()
+	c := GeneratePrivateAccountFromSecret("c").PublicKey()
+
+	policy, err := NewMultisigPolicy(2, a, b, c)
+	require.NoError(t, err)
+	// The address does not depend on the order of the members
+	other, err := NewMultisigPolicy(2, c, a, b)
+	require.NoError(t, err)
+	assert.Equal(t, policy, other)
+	assert.Equal(t, policy.Address(), other.Address())
+	// But does depend on the threshold
+	other, err = NewMultisigPolicy(3, a, b, c)
+	require.NoError(t, err)
+	assert.NotEqual(t, policy.Address(), other.Address())
+
+	assert.Equal(t, &b, policy.Member(b.Address()))
+	assert.Nil(t, policy.Member(crypto.Address{1}))
+
+	_, err = NewMultisigPolicy(0, a, b)
+	assert.Error(t, err)
+	_, err = NewMultisigPolicy(3, a, b)
+	assert.Error(t, err)
+	_, err = NewMultisigPolicy(1, a, a)
+	assert.Error(t, err)
+	_, err = NewMultisigPolicy(1)
+	assert.Error(t, err)
+}
+
+func TestMutableAccount_SetMultisig(t *testing.T) {
+	policy, err := NewMultisigPolicy(1, GeneratePrivateAccountFromSecret("a").PublicKey())
+	require.NoError(t, err)
+	acc := NewMultisigAccount(policy).MutableAccount()
+	assert.Equal(t, policy, acc.Multisig())
+	assert.NoError(t, acc.SetMultisig(policy))
+
+	acc = ConcreteAccount{Address: crypto.Address{1}}.MutableAccount()
+	assert.Error(t, acc.SetMultisig(policy))
+
+	// Multisig policies survive encoding
+	bs, err := NewMultisigAccount(policy).Encode()
+	require.NoError(t, err)
+	decoded, err := Decode(bs)
+	require.NoError(t, err)
+	assert.Equal(t, policy, decoded.Multisig())
+}
@@ -9,8 +9,9 @@ import (
 )
 
 type MemoryState struct {
-	Accounts map[crypto.Address]acm.Account
-	Storage  map[crypto.Address]map[binary.Word256]binary.Word256
+	Accounts      map[crypto.Address]acm.Account
+	Storage       map[crypto.Address]map[binary.Word256]binary.Word256
+	ContractMetas map[crypto.Address]*acm.ContractMeta
 }
 
 var _ IterableReaderWriter = &MemoryState{}
@@ -18,8 +19,9 @@ var _ IterableReaderWriter = &MemoryState{}
 // Get an in-memory state IterableReader
 func NewMemoryState() *MemoryState {
 	return &MemoryState{
-		Accounts: make(map[crypto.Address]acm.Account),
-		Storage:  make(map[crypto.Address]map[binary.Word256]binary.Word256),
+		Accounts:      make(map[crypto.Address]acm.Account),
+		Storage:       make(map[crypto.Address]map[binary.Word256]binary.Word256),
+		ContractMetas: make(map[crypto.Address]*acm.ContractMeta),
 	}
 }
 
@@ -62,6 +64,15 @@ func (ms *MemoryState) SetStorage(address crypto.Address, key, value binary.Word
 	return nil
 }
 
+func (ms *MemoryState) GetContractMeta(address crypto.Address) (*acm.ContractMeta, error) {
+	return ms.ContractMetas[address], nil
+}
+
+func (ms *MemoryState) SetContractMeta(address crypto.Address, meta *acm.ContractMeta) error {
+	ms.ContractMetas[address] = meta
+	return nil
+}
+
 func (ms *MemoryState) IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
 	for _, acc := range ms.Accounts {
 		if consumer(acc) {
@@ -45,6 +45,16 @@ type StorageIterable interface {
 	IterateStorage(address crypto.Address, consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error)
 }
 
+type ContractMetaGetter interface {
+	// Get the metadata stored when the contract at address was created, return nil if there is none
+	GetContractMeta(address crypto.Address) (*acm.ContractMeta, error)
+}
+
+type ContractMetaSetter interface {
+	// Store the metadata of the contract at address
+	SetContractMeta(address crypto.Address, meta *acm.ContractMeta) error
+}
+
 // Compositions
 
 // Read-only account and storage state
@@ -29,6 +29,8 @@ type Cache struct {
 	name     string
 	backend  Reader
 	accounts map[crypto.Address]*accountInfo
+	// Contract metadata set on the cache
+	metas map[crypto.Address]*acm.ContractMeta
 }
 
 type accountInfo struct {
@@ -47,6 +49,7 @@ func NewCache(backend Reader, options ...CacheOption) *Cache {
 	cache := &Cache{
 		backend:  backend,
 		accounts: make(map[crypto.Address]*accountInfo),
+		metas:    make(map[crypto.Address]*acm.ContractMeta),
 	}
 	for _, option := range options {
 		option(cache)
@@ -176,6 +179,28 @@ func (cache *Cache) IterateCachedStorage(address crypto.Address,
 	return false, nil
 }
 
+// Returns the contract metadata set on the cache or else from the backend if it is a ContractMetaGetter
+func (cache *Cache) GetContractMeta(address crypto.Address) (*acm.ContractMeta, error) {
+	cache.RLock()
+	meta, ok := cache.metas[address]
+	cache.RUnlock()
+	if ok {
+		return meta, nil
+	}
+	getter, ok := cache.backend.(ContractMetaGetter)
+	if !ok {
+		return nil, nil
+	}
+	return getter.GetContractMeta(address)
+}
+
+func (cache *Cache) SetContractMeta(address crypto.Address, meta *acm.ContractMeta) error {
+	cache.Lock()
+	defer cache.Unlock()
+	cache.metas[address] = meta
+	return nil
+}
+
 // Syncs changes to the backend in deterministic order. Sends storage updates before updating
 // the account they belong so that storage values can be taken account of in the update.
 func (cache *Cache) Sync(state Writer) error {
@@ -218,6 +243,24 @@ func (cache *Cache) Sync(state Writer) error {
 		}
 		accInfo.RUnlock()
 	}
+	if len(cache.metas) == 0 {
+		return nil
+	}
+	setter, ok := state.(ContractMetaSetter)
+	if !ok {
+		return fmt.Errorf("cannot sync contract metadata to %v since it does not store contract metadata", state)
+	}
+	addresses = addresses[:0]
+	for address := range cache.metas {
+		addresses = append(addresses, address)
+	}
+	sort.Sort(addresses)
+	for _, address := range addresses {
+		err := setter.SetContractMeta(address, cache.metas[address])
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -227,6 +270,7 @@ func (cache *Cache) Reset(backend Reader) {
 	defer cache.Unlock()
 	cache.backend = backend
 	cache.accounts = make(map[crypto.Address]*accountInfo, len(cache.accounts))
+	cache.metas = make(map[crypto.Address]*acm.ContractMeta)
 }
 
 // Syncs the Cache to output and Resets it to use backend as Reader
@@ -260,6 +260,33 @@ func TestStateCache_Sync(t *testing.T) {
 	require.Nil(t, newAccOut)
 }
 
+func TestStateCache_ContractMeta(t *testing.T) {
+	backend := NewMemoryState()
+	cache := NewCache(backend)
+	address := acm.NewConcreteAccountFromSecret("contract").Address
+	meta := &acm.ContractMeta{Abi: "[]", CompilerVersion: "0.4.25"}
+
+	err := cache.SetContractMeta(address, meta)
+	require.NoError(t, err)
+
+	metaOut, err := cache.GetContractMeta(address)
+	require.NoError(t, err)
+	assert.Equal(t, meta, metaOut)
+
+	// Not visible in backend until synced
+	metaOut, err = backend.GetContractMeta(address)
+	require.NoError(t, err)
+	assert.Nil(t, metaOut)
+
+	err = cache.Sync(backend)
+	require.NoError(t, err)
+
+	// Read through from backend by a fresh cache
+	metaOut, err = NewCache(backend).GetContractMeta(address)
+	require.NoError(t, err)
+	assert.Equal(t, meta, metaOut)
+}
+
 func TestStateCache_get(t *testing.T) {
 	backend := NewCache(NewMemoryState())
 	cache := NewCache(backend)
@@ -45,6 +45,28 @@ func SyncWriter(locker sync.Locker, writerFunc WriterFunc) WriterFunc {
 	})
 }
 
+// Guards both reads and writes to rw with locker
+func SyncReaderWriter(locker sync.Locker, rw ReaderWriter) ReaderWriter {
+	return &syncReaderWriter{locker: locker, rw: rw}
+}
+
+type syncReaderWriter struct {
+	locker sync.Locker
+	rw     ReaderWriter
+}
+
+func (srw *syncReaderWriter) Power(id crypto.Address) *big.Int {
+	srw.locker.Lock()
+	defer srw.locker.Unlock()
+	return srw.rw.Power(id)
+}
+
+func (srw *syncReaderWriter) AlterPower(id crypto.PublicKey, power *big.Int) (flow *big.Int, err error) {
+	srw.locker.Lock()
+	defer srw.locker.Unlock()
+	return srw.rw.AlterPower(id, power)
+}
+
 func (wf WriterFunc) AlterPower(id crypto.PublicKey, power *big.Int) (flow *big.Int, err error) {
 	return wf(id, power)
 }
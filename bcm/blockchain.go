@@ -32,6 +32,9 @@ import (
 // Blocks to average validator power over
 const DefaultValidatorsWindowSize = 10
 
+// Number of most recent block hashes to retain (as needed by the EVM BLOCKHASH opcode)
+const DefaultBlockHashesWindowSize = 256
+
 var stateKey = []byte("BlockchainState")
 
 type BlockchainInfo interface {
@@ -42,6 +45,8 @@ type BlockchainInfo interface {
 	LastBlockTime() time.Time
 	LastCommitTime() time.Time
 	LastBlockHash() []byte
+	// Returns the hash of the block at height, which must be one of the DefaultBlockHashesWindowSize most recent
+	BlockHash(height uint64) ([]byte, error)
 	AppHashAfterLastBlock() []byte
 	Validators() validator.IterableReader
 	ValidatorsHistory() (currentSet *validator.Set, deltas []*validator.Set, height uint64)
@@ -59,6 +64,7 @@ type Blockchain struct {
 	lastBlockHash         []byte
 	lastCommitTime        time.Time
 	appHashAfterLastBlock []byte
+	recentBlockHashes     [][]byte
 	validatorCache        *validator.Ring
 	validatorCheckCache   *validator.Ring
 }
@@ -71,6 +77,7 @@ type PersistedState struct {
 	GenesisDoc            genesis.GenesisDoc
 	ValidatorSet          []validator.Validator
 	ValidatorCache        validator.PersistedRing
+	RecentBlockHashes     [][]byte
 }
 
 func LoadOrNewBlockchain(db dbm.DB, genesisDoc *genesis.GenesisDoc, logger *logging.Logger) (*Blockchain, error) {
@@ -128,12 +135,12 @@ func loadBlockchain(db dbm.DB) (*Blockchain, error) {
 	return bc, nil
 }
 
-func (bc *Blockchain) ValidatorChecker() validator.Writer {
-	return validator.SyncWriter(bc, bc.validatorCheckCache.AlterPower)
+func (bc *Blockchain) ValidatorChecker() validator.ReaderWriter {
+	return validator.SyncReaderWriter(bc, bc.validatorCheckCache)
 }
 
-func (bc *Blockchain) ValidatorWriter() validator.Writer {
-	return validator.SyncWriter(bc, bc.validatorCache.AlterPower)
+func (bc *Blockchain) ValidatorWriter() validator.ReaderWriter {
+	return validator.SyncReaderWriter(bc, bc.validatorCache)
 }
 
 func (bc *Blockchain) CommitBlock(blockTime time.Time,
@@ -158,6 +165,10 @@ func (bc *Blockchain) CommitBlock(blockTime time.Time,
 	bc.lastBlockHeight += 1
 	bc.lastBlockTime = blockTime
 	bc.lastBlockHash = blockHash
+	bc.recentBlockHashes = append(bc.recentBlockHashes, blockHash)
+	if len(bc.recentBlockHashes) > DefaultBlockHashesWindowSize {
+		bc.recentBlockHashes = bc.recentBlockHashes[len(bc.recentBlockHashes)-DefaultBlockHashesWindowSize:]
+	}
 	bc.appHashAfterLastBlock = appHash
 	bc.lastCommitTime = time.Now().UTC()
 	return
@@ -182,6 +193,7 @@ func (bc *Blockchain) Encode() ([]byte, error) {
 		AppHashAfterLastBlock: bc.appHashAfterLastBlock,
 		LastBlockHeight:       bc.lastBlockHeight,
 		ValidatorCache:        bc.validatorCache.Persistable(),
+		RecentBlockHashes:     bc.recentBlockHashes,
 	}
 	encodedState, err := cdc.MarshalBinary(persistedState)
 	if err != nil {
@@ -201,6 +213,10 @@ func DecodeBlockchain(encodedState []byte) (*Blockchain, error) {
 	bc.appHashAfterLastBlock = persistedState.AppHashAfterLastBlock
 	bc.validatorCache = validator.UnpersistRing(persistedState.ValidatorCache)
 	bc.validatorCheckCache = validator.UnpersistRing(persistedState.ValidatorCache)
+	bc.recentBlockHashes = persistedState.RecentBlockHashes
+	if len(bc.recentBlockHashes) > 0 {
+		bc.lastBlockHash = bc.recentBlockHashes[len(bc.recentBlockHashes)-1]
+	}
 	return bc, nil
 }
 
@@ -240,6 +256,17 @@ func (bc *Blockchain) LastBlockHash() []byte {
 	return bc.lastBlockHash
 }
 
+func (bc *Blockchain) BlockHash(height uint64) ([]byte, error) {
+	bc.RLock()
+	defer bc.RUnlock()
+	numHashes := uint64(len(bc.recentBlockHashes))
+	if height > bc.lastBlockHeight || height+numHashes <= bc.lastBlockHeight {
+		return nil, fmt.Errorf("block hash for height %v is not available, last block height is %v and "+
+			"only the previous %v block hashes are retained", height, bc.lastBlockHeight, numHashes)
+	}
+	return bc.recentBlockHashes[numHashes-1-(bc.lastBlockHeight-height)], nil
+}
+
 func (bc *Blockchain) AppHashAfterLastBlock() []byte {
 	bc.RLock()
 	defer bc.RUnlock()
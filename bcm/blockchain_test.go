@@ -47,6 +47,43 @@ func TestBlockchain_Encode(t *testing.T) {
 	assertZero(t, bc.validatorCache.Power(id1.Address()))
 }
 
+func TestBlockchain_BlockHash(t *testing.T) {
+	genesisDoc, _, _ := genesis.NewDeterministicGenesis(234).GenesisDoc(5, true, 232, 3, true, 34)
+	bc := newBlockchain(db.NewMemDB(), genesisDoc)
+	_, err := bc.BlockHash(0)
+	require.Error(t, err)
+
+	numBlocks := DefaultBlockHashesWindowSize + 10
+	for i := 1; i <= numBlocks; i++ {
+		_, _, err = bc.CommitBlock(time.Now(), []byte(fmt.Sprintf("blockhash%d", i)), []byte("apphash"))
+		require.NoError(t, err)
+	}
+	blockHash, err := bc.BlockHash(uint64(numBlocks))
+	require.NoError(t, err)
+	assert.Equal(t, bc.LastBlockHash(), blockHash)
+
+	oldest := uint64(numBlocks - DefaultBlockHashesWindowSize + 1)
+	blockHash, err = bc.BlockHash(oldest)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(fmt.Sprintf("blockhash%d", oldest)), blockHash)
+
+	_, err = bc.BlockHash(oldest - 1)
+	require.Error(t, err)
+	_, err = bc.BlockHash(uint64(numBlocks + 1))
+	require.Error(t, err)
+
+	// Block hashes survive a round trip through the persisted state
+	bs, err := bc.Encode()
+	require.NoError(t, err)
+	bcOut, err := DecodeBlockchain(bs)
+	require.NoError(t, err)
+	bcOut.lastBlockHeight = bc.lastBlockHeight
+	assert.Equal(t, bc.LastBlockHash(), bcOut.LastBlockHash())
+	blockHash, err = bcOut.BlockHash(oldest)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(fmt.Sprintf("blockhash%d", oldest)), blockHash)
+}
+
 // Since we have -0 and 0 with big.Int due to its representation with a neg flag
 func assertZero(t testing.TB, i *big.Int) {
 	assert.True(t, big0.Cmp(i) == 0, "expected 0 but got %v", i)
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func Abi(output Output) func(cmd *cli.Cmd) {
+	return func(abiCmd *cli.Cmd) {
+		configOpt := abiCmd.StringOpt("c config", "", "Use the a specified burrow config file")
+
+		// Runs fn against the state of the node, which must not be running
+		withState := func(fn func(st *execution.State)) {
+			conf, err := obtainBurrowConfig(*configOpt, "")
+			if err != nil {
+				output.Fatalf("Could not obtain config: %v", err)
+			}
+			if conf.GenesisDoc == nil {
+				output.Fatalf("No GenesisDoc defined in config, cannot load state")
+			}
+			tmConf := conf.Tendermint.TendermintConfig()
+			stateDB := dbm.NewDB(core.StateDBName, dbm.GoLevelDBBackend, tmConf.DBDir())
+			defer stateDB.Close()
+
+			blockchain, err := bcm.LoadOrNewBlockchain(stateDB, conf.GenesisDoc, logging.NewNoopLogger())
+			if err != nil {
+				output.Fatalf("Could not load blockchain: %v", err)
+			}
+			if blockchain.LastBlockHeight() == 0 {
+				output.Fatalf("No blocks have been committed so there are no contracts")
+			}
+			st, err := execution.LoadState(stateDB, blockchain.AppHashAfterLastBlock())
+			if err != nil {
+				output.Fatalf("Could not load state: %v", err)
+			}
+			fn(st)
+		}
+
+		abiCmd.Command("set", "store the ABI of a contract replacing any already stored (the node must not be running)",
+			func(cmd *cli.Cmd) {
+				addressArg := cmd.StringArg("ADDRESS", "", "address of the contract")
+				fileArg := cmd.StringArg("ABI", "", "file containing the JSON ABI")
+				cmd.Spec = "ADDRESS ABI"
+
+				cmd.Action = func() {
+					address, err := crypto.AddressFromHexString(*addressArg)
+					if err != nil {
+						output.Fatalf("Could not read address: %v", err)
+					}
+					abiJSON, err := ioutil.ReadFile(*fileArg)
+					if err != nil {
+						output.Fatalf("Could not read ABI file: %v", err)
+					}
+					withState(func(st *execution.State) {
+						err = st.SetAbi(address, string(abiJSON))
+						if err != nil {
+							output.Fatalf("Could not store ABI: %v", err)
+						}
+						output.Printf("Stored ABI for %v", address)
+					})
+				}
+			})
+
+		abiCmd.Command("delete", "remove the ABI stored for a contract (the node must not be running)",
+			func(cmd *cli.Cmd) {
+				addressArg := cmd.StringArg("ADDRESS", "", "address of the contract")
+				cmd.Spec = "ADDRESS"
+
+				cmd.Action = func() {
+					address, err := crypto.AddressFromHexString(*addressArg)
+					if err != nil {
+						output.Fatalf("Could not read address: %v", err)
+					}
+					withState(func(st *execution.State) {
+						st.DeleteAbi(address)
+						output.Printf("Removed any ABI stored for %v", address)
+					})
+				}
+			})
+	}
+}
@@ -187,6 +187,14 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 				if err != nil {
 					output.Fatalf("could not realise GenesisSpec: %v", err)
 				}
+
+				if conf.Execution != nil {
+					// Record the gas schedule in genesis so that all validators charge the same costs
+					conf.GenesisDoc.GasSchedule, err = conf.Execution.SelectedGasSchedule()
+					if err != nil {
+						output.Fatalf("could not select gas schedule: %v", err)
+					}
+				}
 			} else if *genesisDocOpt != "" {
 				genesisDoc := new(genesis.GenesisDoc)
 				err := source.FromFile(*genesisSpecOpt, genesisDoc)
@@ -241,9 +249,10 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 			}
 
 			if *debugOpt {
-				conf.Execution = &execution.ExecutionConfig{
-					VMOptions: []execution.VMOption{execution.DumpTokens, execution.DebugOpcodes},
+				if conf.Execution == nil {
+					conf.Execution = execution.DefaultExecutionConfig()
 				}
+				conf.Execution.VMOptions = []execution.VMOption{execution.DumpTokens, execution.DebugOpcodes}
 			}
 
 			if *separateGenesisDoc != "" {
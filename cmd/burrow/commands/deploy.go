@@ -36,8 +36,8 @@ func Deploy(output Output) func(cmd *cli.Cmd) {
 		binPathOpt := cmd.StringOpt("b bin-path", "[dir]/bin",
 			"path to the bin directory jobs should use when saving binaries after the compile process defaults to --dir + /bin")
 
-		defaultGasOpt := cmd.StringOpt("g gas", "1111111111",
-			"default gas to use; can be overridden for any single job")
+		defaultGasOpt := cmd.StringOpt("g gas", "",
+			"default gas to use; can be overridden for any single job (when not set gas is estimated for each transaction)")
 
 		jobsOpt := cmd.IntOpt("j jobs", 2,
 			"default number of concurrent solidity compilers to run")
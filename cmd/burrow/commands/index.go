@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/logging"
+	cli "github.com/jawher/mow.cli"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func Index(output Output) func(cmd *cli.Cmd) {
+	return func(index *cli.Cmd) {
+		configOpt := index.StringOpt("c config", "", "Use the a specified burrow config file")
+
+		index.Command("rebuild", "rebuild the event index so that it covers every block in the chain "+
+			"(the node must not be running)", func(cmd *cli.Cmd) {
+			cmd.Action = func() {
+				conf, err := obtainBurrowConfig(*configOpt, "")
+				if err != nil {
+					output.Fatalf("Could not obtain config: %v", err)
+				}
+				if conf.GenesisDoc == nil {
+					output.Fatalf("No GenesisDoc defined in config, cannot load state")
+				}
+				tmConf := conf.Tendermint.TendermintConfig()
+				stateDB := dbm.NewDB(core.StateDBName, dbm.GoLevelDBBackend, tmConf.DBDir())
+				defer stateDB.Close()
+
+				blockchain, err := bcm.LoadOrNewBlockchain(stateDB, conf.GenesisDoc, logging.NewNoopLogger())
+				if err != nil {
+					output.Fatalf("Could not load blockchain: %v", err)
+				}
+				if blockchain.LastBlockHeight() == 0 {
+					output.Printf("No blocks have been committed so there is nothing to index")
+					return
+				}
+				st, err := execution.LoadState(stateDB, blockchain.AppHashAfterLastBlock())
+				if err != nil {
+					output.Fatalf("Could not load state: %v", err)
+				}
+				err = st.RebuildEventIndex()
+				if err != nil {
+					output.Fatalf("Could not rebuild event index: %v", err)
+				}
+				output.Printf("Rebuilt event index up to height %d", blockchain.LastBlockHeight())
+			}
+		})
+	}
+}
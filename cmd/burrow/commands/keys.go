@@ -116,6 +116,100 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("mnemonic", "Generates a BIP-39 mnemonic from which keys can be derived with 'restore'", func(cmd *cli.Cmd) {
+			bits := cmd.IntOpt("b bits", keys.DefaultMnemonicBits, "bits of entropy encoded by the mnemonic, a multiple of 32 between 128 and 256")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.GenerateMnemonic(ctx, &keys.MnemonicRequest{Bits: uint32(*bits)})
+				if err != nil {
+					output.Fatalf("failed to generate mnemonic: %v", err)
+				}
+
+				fmt.Printf("%s\n", resp.GetMnemonic())
+			}
+		})
+
+		cmd.Command("restore", "Derives keys from a BIP-39 mnemonic and imports them", func(cmd *cli.Cmd) {
+			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to derive. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
+			noPassword := cmd.BoolOpt("n no-password", false, "don't use a password for the derived keys")
+			mnemonicPassphrase := cmd.BoolOpt("mnemonic-passphrase", false, "prompt for the passphrase protecting the mnemonic")
+			path := cmd.StringOpt("path", "", "derivation path of the key, e.g. m/44'/60'/0'/0/0 (defaults to the BIP-44 path of the curve type)")
+			count := cmd.IntOpt("count", 1, "number of keys to derive from successive default derivation paths when no path is given")
+			keyName := cmd.StringOpt("name", "", "name of key to derive, suffixed with the index of the key when deriving more than one")
+
+			cmd.Action = func() {
+				curve, err := crypto.CurveTypeFromString(*curveType)
+				if err != nil {
+					output.Fatalf("Unrecognised curve type %v", *curveType)
+				}
+
+				fmt.Printf("Enter Mnemonic:")
+				mnemonic, err := gopass.GetPasswdMasked()
+				if err != nil {
+					os.Exit(1)
+				}
+				err = keys.ValidateMnemonic(string(mnemonic))
+				if err != nil {
+					output.Fatalf("invalid mnemonic: %v", err)
+				}
+
+				var seedPassphrase string
+				if *mnemonicPassphrase {
+					fmt.Printf("Enter Mnemonic Passphrase:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					seedPassphrase = string(pwd)
+				}
+
+				var password string
+				if !*noPassword {
+					fmt.Printf("Enter Password:")
+					pwd, err := gopass.GetPasswdMasked()
+					if err != nil {
+						os.Exit(1)
+					}
+					password = string(pwd)
+				}
+
+				var paths []string
+				if *path != "" {
+					paths = []string{*path}
+				} else {
+					for i := 0; i < *count; i++ {
+						paths = append(paths, keys.DefaultDerivationPath(curve, uint32(i)).String())
+					}
+				}
+
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				for i, p := range paths {
+					name := *keyName
+					if name != "" && len(paths) > 1 {
+						name = fmt.Sprintf("%s-%d", name, i)
+					}
+					resp, err := c.ImportMnemonic(ctx, &keys.ImportMnemonicRequest{
+						Passphrase:         password,
+						Name:               name,
+						CurveType:          curve.String(),
+						Mnemonic:           string(mnemonic),
+						MnemonicPassphrase: seedPassphrase,
+						Path:               p,
+					})
+					if err != nil {
+						output.Fatalf("failed to derive key at %s: %v", p, err)
+					}
+
+					fmt.Printf("%s %s\n", resp.GetAddress(), p)
+				}
+			}
+		})
+
 		cmd.Command("hash", "hash <some data>", func(cmd *cli.Cmd) {
 			hashType := cmd.StringOpt("t type", keys.DefaultHashType, "specify the hash function to use")
 
@@ -273,6 +367,72 @@ func Keys(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("unlock", "Decrypts a key so that it can sign without its password until the timeout", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key to use")
+			addr := cmd.StringOpt("addr", "", "address of key to use")
+			timeout := cmd.IntOpt("t timeout", 0, "seconds for which the key stays unlocked, 0 to keep it unlocked until locked with 'lock' or the server stops")
+
+			cmd.Action = func() {
+				if *timeout < 0 {
+					output.Fatalf("timeout must not be negative")
+				}
+				fmt.Printf("Enter Password:")
+				pwd, err := gopass.GetPasswdMasked()
+				if err != nil {
+					os.Exit(1)
+				}
+
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.UnlockKey(ctx, &keys.UnlockKeyRequest{Passphrase: string(pwd), Name: *name, Address: *addr,
+					Timeout: uint64(*timeout)})
+				if err != nil {
+					output.Fatalf("failed to unlock key: %v", err)
+				}
+
+				if resp.Expires.IsZero() {
+					fmt.Printf("%s unlocked until locked\n", resp.GetAddress())
+				} else {
+					fmt.Printf("%s unlocked until %v\n", resp.GetAddress(), resp.Expires)
+				}
+			}
+		})
+
+		cmd.Command("lock", "Ends the session of an unlocked key", func(cmd *cli.Cmd) {
+			name := cmd.StringOpt("name", "", "name of key to use")
+			addr := cmd.StringOpt("addr", "", "address of key to use")
+
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				_, err := c.LockKey(ctx, &keys.LockKeyRequest{Name: *name, Address: *addr})
+				if err != nil {
+					output.Fatalf("failed to lock key: %v", err)
+				}
+			}
+		})
+
+		cmd.Command("unlocked", "list unlocked keys", func(cmd *cli.Cmd) {
+			cmd.Action = func() {
+				c := grpcKeysClient(output)
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				defer cancel()
+				resp, err := c.ListUnlocked(ctx, &keys.ListUnlockedRequest{})
+				if err != nil {
+					output.Fatalf("failed to list unlocked keys: %v", err)
+				}
+				for _, k := range resp.Keys {
+					if k.Expires.IsZero() {
+						fmt.Printf("%s\n", k.Address)
+					} else {
+						fmt.Printf("%s %v\n", k.Address, k.Expires)
+					}
+				}
+			}
+		})
+
 		cmd.Command("verify", "verify <some data> <sig> <pubkey>", func(cmd *cli.Cmd) {
 			curveType := cmd.StringOpt("t curvetype", "ed25519", "specify the curve type of key to create. Supports 'secp256k1' (ethereum),  'ed25519' (tendermint)")
 
@@ -44,6 +44,12 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("dump", "Dump objects from an offline Burrow .burrow directory",
 		commands.Dump(output))
 
+	app.Command("index", "Maintain the event index of an offline Burrow .burrow directory",
+		commands.Index(output))
+
+	app.Command("abi", "Manage the contract ABIs stored by an offline Burrow .burrow directory",
+		commands.Abi(output))
+
 	app.Command("deploy", "Deploy and test contracts",
 		commands.Deploy(output))
 
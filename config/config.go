@@ -43,7 +43,9 @@ func DefaultBurrowConfig() *BurrowConfig {
 	}
 }
 
-func (conf *BurrowConfig) Kernel(ctx context.Context) (*core.Kernel, error) {
+// Makes a Kernel from the config. Any extraOptions are applied after those derived from the ExecutionConfig, which
+// allows a node to be built with native contracts registered using execution.Natives.
+func (conf *BurrowConfig) Kernel(ctx context.Context, extraOptions ...execution.ExecutionOption) (*core.Kernel, error) {
 	if conf.GenesisDoc == nil {
 		return nil, fmt.Errorf("no GenesisDoc defined in config, cannot make Kernel")
 	}
@@ -56,7 +58,12 @@ func (conf *BurrowConfig) Kernel(ctx context.Context) (*core.Kernel, error) {
 	}
 	var keyClient keys.KeyClient
 	var keyStore *keys.KeyStore
-	if conf.Keys.RemoteAddress != "" {
+	if conf.Keys.PKCS11 != nil {
+		keyClient, err = keys.NewPKCS11KeyClient(conf.Keys.PKCS11, logger)
+		if err != nil {
+			return nil, err
+		}
+	} else if conf.Keys.RemoteAddress != "" {
 		keyClient, err = keys.NewRemoteKeyClient(conf.Keys.RemoteAddress, logger)
 		if err != nil {
 			return nil, err
@@ -77,15 +84,26 @@ func (conf *BurrowConfig) Kernel(ctx context.Context) (*core.Kernel, error) {
 	privValidator := tendermint.NewPrivValidatorMemory(val, signer)
 
 	var exeOptions []execution.ExecutionOption
+	var transOptions []execution.TransactorOption
 	if conf.Execution != nil {
 		exeOptions, err = conf.Execution.ExecutionOptions()
 		if err != nil {
 			return nil, err
 		}
+		transOptions, err = conf.Execution.TransactorOptions()
+		if err != nil {
+			return nil, err
+		}
+		err = conf.Execution.VerifyGasSchedule(conf.GenesisDoc)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	exeOptions = append(exeOptions, extraOptions...)
+
 	return core.NewKernel(ctx, keyClient, privValidator, conf.GenesisDoc, conf.Tendermint.TendermintConfig(), conf.RPC,
-		conf.Keys, keyStore, exeOptions, logger)
+		conf.Keys, keyStore, exeOptions, transOptions, logger)
 }
 
 func (conf *BurrowConfig) JSONString() string {
@@ -27,6 +27,7 @@ type App struct {
 	blockchain    *bcm.Blockchain
 	checker       execution.BatchExecutor
 	committer     execution.BatchCommitter
+	queryState    QueryState
 	checkTx       func(txBytes []byte) abciTypes.ResponseCheckTx
 	deliverTx     func(txBytes []byte) abciTypes.ResponseCheckTx
 	mempoolLocker sync.Locker
@@ -41,12 +42,13 @@ type App struct {
 var _ abciTypes.Application = &App{}
 
 func NewApp(nodeInfo string, blockchain *bcm.Blockchain, checker execution.BatchExecutor, committer execution.BatchCommitter,
-	txDecoder txs.Decoder, panicFunc func(error), logger *logging.Logger) *App {
+	queryState QueryState, txDecoder txs.Decoder, panicFunc func(error), logger *logging.Logger) *App {
 	return &App{
 		nodeInfo:   nodeInfo,
 		blockchain: blockchain,
 		checker:    checker,
 		committer:  committer,
+		queryState: queryState,
 		checkTx:    txExecutor("CheckTx", checker, txDecoder, logger.WithScope("CheckTx")),
 		deliverTx:  txExecutor("DeliverTx", committer, txDecoder, logger.WithScope("DeliverTx")),
 		panicFunc:  panicFunc,
@@ -77,12 +79,6 @@ func (app *App) SetOption(option abciTypes.RequestSetOption) (respSetOption abci
 	return
 }
 
-func (app *App) Query(reqQuery abciTypes.RequestQuery) (respQuery abciTypes.ResponseQuery) {
-	respQuery.Log = "Query not supported"
-	respQuery.Code = codes.UnsupportedRequestCode
-	return
-}
-
 func (app *App) InitChain(chain abciTypes.RequestInitChain) (respInitChain abciTypes.ResponseInitChain) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -127,6 +123,11 @@ func (app *App) BeginBlock(block abciTypes.RequestBeginBlock) (respBeginBlock ab
 			}
 		}
 	}
+	// Slash and jail validators for the double-signing and downtime reported in this block
+	err := app.committer.BeginBlock(&block)
+	if err != nil {
+		panic(errors.Wrap(err, "could not apply validator penalties"))
+	}
 	return
 }
 
@@ -0,0 +1,219 @@
+package abci
+
+import (
+	"encoding/hex"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/consensus/tendermint/codes"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/proof"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// Paths served by Query
+const (
+	// /account/<address>
+	AccountQueryPath = "account"
+	// /storage/<address>/<key>
+	StorageQueryPath = "storage"
+	// /name/<name>
+	NameQueryPath = "name"
+	// /tx/<hash>
+	TxQueryPath = "tx"
+)
+
+// The state that Query reads from
+type QueryState interface {
+	AtHeight(height uint64) (*execution.ReadState, error)
+	GetTx(txHash []byte) (*exec.TxExecution, error)
+}
+
+// Serves reads of the state tree (at the latest or a retained earlier height) over ABCI. Values are returned in the
+// same encoding as they are stored so that when Prove is requested the Proof (a marshalled proof.Proof) can be
+// verified against the value directly. A key that is absent from state yields an empty Value (and an absence Proof).
+func (app *App) Query(reqQuery abciTypes.RequestQuery) (respQuery abciTypes.ResponseQuery) {
+	defer func() {
+		if r := recover(); r != nil {
+			respQuery.Code = codes.QueryErrorCode
+			respQuery.Log = fmt.Sprintf("panic occurred in abci.App/Query: %v\n%s", r, debug.Stack())
+		}
+	}()
+	if reqQuery.Height < 0 {
+		return invalidQuery(respQuery, "query height %d is negative", reqQuery.Height)
+	}
+	height := uint64(reqQuery.Height)
+	segments := strings.Split(strings.Trim(reqQuery.Path, "/"), "/")
+	switch segments[0] {
+	case AccountQueryPath:
+		if len(segments) != 2 {
+			return invalidQuery(respQuery, "expected query path of the form /%s/<address> but got %s",
+				AccountQueryPath, reqQuery.Path)
+		}
+		return app.queryAccount(respQuery, segments[1], height, reqQuery.Prove)
+	case StorageQueryPath:
+		if len(segments) != 3 {
+			return invalidQuery(respQuery, "expected query path of the form /%s/<address>/<key> but got %s",
+				StorageQueryPath, reqQuery.Path)
+		}
+		return app.queryStorage(respQuery, segments[1], segments[2], height, reqQuery.Prove)
+	case NameQueryPath:
+		// Names may themselves contain '/'
+		name := strings.TrimPrefix(strings.TrimPrefix(reqQuery.Path, "/"), NameQueryPath+"/")
+		if len(segments) < 2 || name == "" {
+			return invalidQuery(respQuery, "expected query path of the form /%s/<name> but got %s",
+				NameQueryPath, reqQuery.Path)
+		}
+		return app.queryName(respQuery, name, height, reqQuery.Prove)
+	case TxQueryPath:
+		if len(segments) != 2 {
+			return invalidQuery(respQuery, "expected query path of the form /%s/<hash> but got %s",
+				TxQueryPath, reqQuery.Path)
+		}
+		if reqQuery.Prove {
+			return invalidQuery(respQuery, "transactions are not stored in the state tree so cannot be proved")
+		}
+		return app.queryTx(respQuery, segments[1])
+	default:
+		respQuery.Code = codes.UnsupportedRequestCode
+		respQuery.Log = fmt.Sprintf("query path %s not supported", reqQuery.Path)
+		return
+	}
+}
+
+func (app *App) queryAccount(respQuery abciTypes.ResponseQuery, addressHex string, height uint64,
+	prove bool) abciTypes.ResponseQuery {
+
+	address, err := crypto.AddressFromHexString(addressHex)
+	if err != nil {
+		return invalidQuery(respQuery, "could not parse address: %v", err)
+	}
+	st, height, err := app.stateAt(height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	respQuery.Height = int64(height)
+	respQuery.Key = proof.AccountKeyFormat.Key(address)
+	account, prf, err := st.GetAccountWithProof(address, height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	if account != nil {
+		respQuery.Value, err = account.Encode()
+		if err != nil {
+			return queryError(respQuery, err)
+		}
+	}
+	return withProof(respQuery, prf, prove)
+}
+
+func (app *App) queryStorage(respQuery abciTypes.ResponseQuery, addressHex, keyHex string, height uint64,
+	prove bool) abciTypes.ResponseQuery {
+
+	address, err := crypto.AddressFromHexString(addressHex)
+	if err != nil {
+		return invalidQuery(respQuery, "could not parse address: %v", err)
+	}
+	keyBytes, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return invalidQuery(respQuery, "could not parse storage key: %v", err)
+	}
+	if len(keyBytes) > binary.Word256Length {
+		return invalidQuery(respQuery, "storage key %X is longer than %d bytes", keyBytes, binary.Word256Length)
+	}
+	key := binary.LeftPadWord256(keyBytes)
+	st, height, err := app.stateAt(height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	respQuery.Height = int64(height)
+	respQuery.Key = proof.StorageKeyFormat.Key(address, key)
+	value, prf, err := st.GetStorageWithProof(address, key, height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	// Zero values are not stored
+	if value != binary.Zero256 {
+		respQuery.Value = value.Bytes()
+	}
+	return withProof(respQuery, prf, prove)
+}
+
+func (app *App) queryName(respQuery abciTypes.ResponseQuery, name string, height uint64,
+	prove bool) abciTypes.ResponseQuery {
+
+	st, height, err := app.stateAt(height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	respQuery.Height = int64(height)
+	respQuery.Key = proof.NameKeyFormat.Key(name)
+	entry, prf, err := st.GetNameWithProof(name, height)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	if entry != nil {
+		respQuery.Value, err = entry.Encode()
+		if err != nil {
+			return queryError(respQuery, err)
+		}
+	}
+	return withProof(respQuery, prf, prove)
+}
+
+func (app *App) queryTx(respQuery abciTypes.ResponseQuery, txHashHex string) abciTypes.ResponseQuery {
+	txHash, err := hex.DecodeString(txHashHex)
+	if err != nil {
+		return invalidQuery(respQuery, "could not parse transaction hash: %v", err)
+	}
+	respQuery.Key = txHash
+	txe, err := app.queryState.GetTx(txHash)
+	if err != nil {
+		return queryError(respQuery, err)
+	}
+	if txe != nil {
+		respQuery.Height = int64(txe.Height)
+		respQuery.Value, err = txe.Encode()
+		if err != nil {
+			return queryError(respQuery, err)
+		}
+	}
+	return respQuery
+}
+
+// Returns the state at height, or at the latest height if height is zero, along with that height
+func (app *App) stateAt(height uint64) (*execution.ReadState, uint64, error) {
+	if height == 0 {
+		height = app.blockchain.LastBlockHeight()
+	}
+	st, err := app.queryState.AtHeight(height)
+	return st, height, err
+}
+
+func withProof(respQuery abciTypes.ResponseQuery, prf *proof.Proof, prove bool) abciTypes.ResponseQuery {
+	if !prove {
+		return respQuery
+	}
+	bs, err := prf.Marshal()
+	if err != nil {
+		return queryError(respQuery, fmt.Errorf("could not encode proof: %v", err))
+	}
+	respQuery.Proof = bs
+	return respQuery
+}
+
+func invalidQuery(respQuery abciTypes.ResponseQuery, format string, args ...interface{}) abciTypes.ResponseQuery {
+	respQuery.Code = codes.InvalidQueryCode
+	respQuery.Log = fmt.Sprintf(format, args...)
+	return respQuery
+}
+
+func queryError(respQuery abciTypes.ResponseQuery, err error) abciTypes.ResponseQuery {
+	respQuery.Code = codes.QueryErrorCode
+	respQuery.Log = err.Error()
+	return respQuery
+}
@@ -0,0 +1,107 @@
+package abci
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/consensus/tendermint/codes"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/db"
+)
+
+func TestApp_Query(t *testing.T) {
+	st := execution.NewState(db.NewMemDB())
+	account := acm.NewConcreteAccountFromSecret("Foo").MutableAccount()
+	absent := acm.NewConcreteAccountFromSecret("Bar").Address
+	entry := &names.Entry{Name: "foo/bar", Data: "baz", Owner: account.Address(), Expires: 100}
+	txHash := tmhash.Sum([]byte("tx"))
+	stateHash, err := st.Update(func(ws execution.Updatable) error {
+		err := ws.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		err = ws.SetStorage(account.Address(), binary.One256, binary.Int64ToWord256(42))
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateName(entry)
+		if err != nil {
+			return err
+		}
+		return ws.AddBlock(&exec.BlockExecution{
+			Height:       1,
+			TxExecutions: []*exec.TxExecution{{TxHash: txHash, Height: 1}},
+		})
+	})
+	require.NoError(t, err)
+	app := &App{queryState: st}
+
+	resp := app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/account/%v", account.Address()), Height: 1,
+		Prove: true})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	assert.Equal(t, int64(1), resp.Height)
+	accountOut, err := acm.Decode(resp.Value)
+	require.NoError(t, err)
+	assert.Equal(t, account.Balance(), accountOut.Balance())
+	prf := decodeProof(t, resp.Proof)
+	assert.Equal(t, resp.Key, prf.Key.Bytes())
+	assert.NoError(t, prf.VerifyAccount(stateHash, account.Address(), accountOut))
+
+	resp = app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/account/%v", absent), Height: 1, Prove: true})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	assert.Empty(t, resp.Value)
+	assert.NoError(t, decodeProof(t, resp.Proof).VerifyAccount(stateHash, absent, nil))
+
+	resp = app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/storage/%v/01", account.Address()), Height: 1,
+		Prove: true})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	value := binary.LeftPadWord256(resp.Value)
+	assert.Equal(t, binary.Int64ToWord256(42), value)
+	assert.NoError(t, decodeProof(t, resp.Proof).VerifyStorage(stateHash, account.Address(), binary.One256, value))
+
+	resp = app.Query(abciTypes.RequestQuery{Path: "/name/foo/bar", Height: 1, Prove: true})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	entryOut, err := names.DecodeEntry(resp.Value)
+	require.NoError(t, err)
+	assert.Equal(t, entry, entryOut)
+	assert.NoError(t, decodeProof(t, resp.Proof).VerifyName(stateHash, entry.Name, entryOut))
+
+	// Without Prove we get no proof
+	resp = app.Query(abciTypes.RequestQuery{Path: "/name/foo/bar", Height: 1})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	assert.Nil(t, resp.Proof)
+
+	resp = app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/tx/%X", txHash)})
+	require.Equal(t, codes.TxExecutionSuccessCode, resp.Code, resp.Log)
+	assert.Equal(t, int64(1), resp.Height)
+	txe, err := exec.DecodeTxExecution(resp.Value)
+	require.NoError(t, err)
+	assert.Equal(t, txHash, txe.TxHash.Bytes())
+
+	resp = app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/tx/%X", txHash), Prove: true})
+	assert.Equal(t, codes.InvalidQueryCode, resp.Code)
+
+	resp = app.Query(abciTypes.RequestQuery{Path: "/account/not-an-address", Height: 1})
+	assert.Equal(t, codes.InvalidQueryCode, resp.Code)
+
+	resp = app.Query(abciTypes.RequestQuery{Path: fmt.Sprintf("/account/%v", account.Address()), Height: 2})
+	assert.Equal(t, codes.QueryErrorCode, resp.Code)
+
+	resp = app.Query(abciTypes.RequestQuery{Path: "/validators", Height: 1})
+	assert.Equal(t, codes.UnsupportedRequestCode, resp.Code)
+}
+
+func decodeProof(t *testing.T, bs []byte) *proof.Proof {
+	prf := new(proof.Proof)
+	require.NoError(t, prf.Unmarshal(bs))
+	return prf
+}
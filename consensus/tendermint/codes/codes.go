@@ -10,9 +10,11 @@ const (
 
 	// Informational
 	UnsupportedRequestCode uint32 = 400
+	InvalidQueryCode       uint32 = 401
 
 	// Internal errors
 	EncodingErrorCode    uint32 = 500
 	TxExecutionErrorCode uint32 = 501
 	CommitErrorCode      uint32 = 502
+	QueryErrorCode       uint32 = 503
 )
@@ -43,6 +43,7 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
+	"github.com/hyperledger/burrow/rpc/web3"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
 	tmConfig "github.com/tendermint/tendermint/config"
@@ -57,6 +58,8 @@ const (
 	ServerShutdownTimeout  = 1000 * time.Millisecond
 	LoggingCallerDepth     = 5
 	AccountsRingMutexCount = 100
+	// Name of the database holding the blockchain and execution state
+	StateDBName = "burrow_state"
 )
 
 // Kernel is the root structure of Burrow
@@ -79,7 +82,8 @@ type Kernel struct {
 
 func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTypes.PrivValidator,
 	genesisDoc *genesis.GenesisDoc, tmConf *tmConfig.Config, rpcConfig *rpc.RPCConfig, keyConfig *keys.KeysConfig,
-	keyStore *keys.KeyStore, exeOptions []execution.ExecutionOption, logger *logging.Logger) (*Kernel, error) {
+	keyStore *keys.KeyStore, exeOptions []execution.ExecutionOption, transOptions []execution.TransactorOption,
+	logger *logging.Logger) (*Kernel, error) {
 
 	var err error
 	kern := &Kernel{
@@ -93,7 +97,7 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 		structure.RunId, kern.RunID.String())
 	tmLogger := logger.With(structure.CallerKey, log.Caller(LoggingCallerDepth+1))
 	kern.Logger = logger.WithInfo(structure.CallerKey, log.Caller(LoggingCallerDepth))
-	stateDB := dbm.NewDB("burrow_state", dbm.GoLevelDBBackend, tmConf.DBDir())
+	stateDB := dbm.NewDB(StateDBName, dbm.GoLevelDBBackend, tmConf.DBDir())
 
 	kern.Blockchain, err = bcm.LoadOrNewBlockchain(stateDB, genesisDoc, kern.Logger)
 	if err != nil {
@@ -121,7 +125,7 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 	committer := execution.NewBatchCommitter(kern.State, kern.Blockchain, kern.Emitter, kern.Logger, exeOptions...)
 
 	kern.nodeInfo = fmt.Sprintf("Burrow_%s_ValidatorID:%X", genesisDoc.ChainID(), privValidator.GetAddress())
-	app := abci.NewApp(kern.nodeInfo, kern.Blockchain, checker, committer, txCodec, kern.Panic, logger)
+	app := abci.NewApp(kern.nodeInfo, kern.Blockchain, checker, committer, kern.State, txCodec, kern.Panic, logger)
 	// We could use this to provide/register our own metrics (though this will register them with us). Unfortunately
 	// Tendermint currently ignores the metrics passed unless its own server is turned on.
 	metricsProvider := node.DefaultMetricsProvider(&tmConfig.InstrumentationConfig{
@@ -134,7 +138,10 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 	}
 
 	transactor := execution.NewTransactor(kern.Blockchain, kern.Emitter, execution.NewAccounts(checker, keyClient, AccountsRingMutexCount),
-		kern.Node.MempoolReactor().BroadcastTx, txCodec, kern.Logger)
+		kern.Node.MempoolReactor().BroadcastTx, txCodec, kern.Logger,
+		append(transOptions, execution.SimulateWith(exeOptions...))...)
+	// Serves historical state and re-executes historical transactions
+	replayer := execution.NewReplayer(kern.State, kern.Blockchain, kern.Logger, exeOptions...)
 
 	nameRegState := kern.State
 	accountState := kern.State
@@ -198,6 +205,22 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				}), nil
 			},
 		},
+		{
+			Name:    "Future transaction queue",
+			Enabled: transactor.QueuesFutureTxs(),
+			Launch: func() (process.Process, error) {
+				ctx, cancel := context.WithCancel(context.Background())
+				err := transactor.ProcessFutureTxs(ctx)
+				if err != nil {
+					cancel()
+					return nil, err
+				}
+				return process.ShutdownFunc(func(ctx context.Context) error {
+					cancel()
+					return nil
+				}), nil
+			},
+		},
 		{
 			Name:    "RPC/info",
 			Enabled: rpcConfig.Info.Enabled,
@@ -221,6 +244,19 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				return server, nil
 			},
 		},
+		{
+			Name:    "RPC/web3",
+			Enabled: rpcConfig.Web3.Enabled,
+			Launch: func() (process.Process, error) {
+				service := web3.NewEthService(kern.State, replayer, kern.Blockchain, transactor, nodeView, txCodec,
+					kern.Logger)
+				server, err := web3.StartServer(service, rpcConfig.Web3.ListenAddress, kern.Logger)
+				if err != nil {
+					return nil, err
+				}
+				return server, nil
+			},
+		},
 		{
 			Name:    "RPC/GRPC",
 			Enabled: rpcConfig.GRPC.Enabled,
@@ -244,12 +280,13 @@ func NewKernel(ctx context.Context, keyClient keys.KeyClient, privValidator tmTy
 				}
 
 				rpcquery.RegisterQueryServer(grpcServer, rpcquery.NewQueryServer(kern.State, nameRegState,
-					kern.Blockchain, nodeView, kern.Logger))
+					kern.State, kern.Blockchain, nodeView, kern.Logger))
 
-				rpctransact.RegisterTransactServer(grpcServer, rpctransact.NewTransactServer(transactor, txCodec))
+				rpctransact.RegisterTransactServer(grpcServer, rpctransact.NewTransactServer(transactor, replayer,
+					txCodec, kern.Logger))
 
 				rpcevents.RegisterExecutionEventsServer(grpcServer, rpcevents.NewExecutionEventsServer(kern.State,
-					kern.Emitter, kern.Blockchain, kern.Logger))
+					replayer, kern.Emitter, kern.Blockchain, kern.Logger))
 
 				// Provides metadata about services registered
 				//reflection.Register(grpcServer)
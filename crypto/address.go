@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/tmthrgd/go-hex"
 	"golang.org/x/crypto/ripemd160"
 )
@@ -195,3 +196,15 @@ func NewContractAddress(caller Address, sequence uint64) (newAddr Address) {
 	copy(newAddr[:], hasher.Sum(nil))
 	return
 }
+
+// NewContractAddress2 derives the address of a contract created with CREATE2 as described in EIP-1014:
+// keccak256(0xff ++ caller ++ salt ++ keccak256(initCode))[12:]
+func NewContractAddress2(caller Address, salt binary.Word256, initCode []byte) (newAddr Address) {
+	temp := make([]byte, 0, 1+binary.Word160Length+2*binary.Word256Length)
+	temp = append(temp, 0xff)
+	temp = append(temp, caller[:]...)
+	temp = append(temp, salt[:]...)
+	temp = append(temp, sha3.Sha3(initCode)...)
+	copy(newAddr[:], sha3.Sha3(temp)[12:])
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/hyperledger/burrow/binary"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,6 +26,18 @@ func TestNewContractAddress(t *testing.T) {
 	}, addr)
 }
 
+func TestNewContractAddress2(t *testing.T) {
+	// Examples from EIP-1014
+	addr := NewContractAddress2(ZeroAddress, binary.Zero256, []byte{0x00})
+	assert.Equal(t, "4D1A2E2BB4F88F0250F26FFFF098B0B30B26BF38", addr.String())
+
+	caller, err := AddressFromHexString("00000000000000000000000000000000DEADBEEF")
+	require.NoError(t, err)
+	salt := binary.LeftPadWord256([]byte{0xca, 0xfe, 0xba, 0xbe})
+	addr = NewContractAddress2(caller, salt, []byte{0xde, 0xad, 0xbe, 0xef})
+	assert.Equal(t, "60F3F640A8508FC6A86D45DF051962668E1E8AC7", addr.String())
+}
+
 func TestAddress_MarshalJSON(t *testing.T) {
 	addr := Address{
 		73, 234, 48, 252, 174,
@@ -59,6 +59,29 @@ type SolidityOutputContract struct {
 	Metadata string
 }
 
+// The parts of the metadata solc generates for a contract that identify how it was built
+type SolidityMetadata struct {
+	Compiler struct {
+		Version string
+	}
+	// Keyed by source file name
+	Sources map[string]struct {
+		Keccak256 string
+	}
+}
+
+func (contract *SolidityOutputContract) GetMetadata() (*SolidityMetadata, error) {
+	if contract.Metadata == "" {
+		return nil, fmt.Errorf("contract has no metadata")
+	}
+	metadata := new(SolidityMetadata)
+	err := json.Unmarshal([]byte(contract.Metadata), metadata)
+	if err != nil {
+		return nil, fmt.Errorf("could not read contract metadata: %v", err)
+	}
+	return metadata, nil
+}
+
 type Response struct {
 	Objects []ResponseItem `json:"objects"`
 	Warning string         `json:"warning"`
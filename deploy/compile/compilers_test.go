@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // full solc response object
@@ -169,3 +170,17 @@ func contains(s []ResponseItem, e ResponseItem) bool {
 	}
 	return false
 }
+
+func TestGetMetadata(t *testing.T) {
+	contract := SolidityOutputContract{
+		Metadata: `{"compiler":{"version":"0.4.25+commit.59dbf8f1"},"language":"Solidity",` +
+			`"sources":{"foo.sol":{"keccak256":"0x1234","urls":["bzzr://5678"]}}}`,
+	}
+	metadata, err := contract.GetMetadata()
+	require.NoError(t, err)
+	assert.Equal(t, "0.4.25+commit.59dbf8f1", metadata.Compiler.Version)
+	assert.Equal(t, "0x1234", metadata.Sources["foo.sol"].Keccak256)
+
+	_, err = (&SolidityOutputContract{}).GetMetadata()
+	assert.Error(t, err)
+}
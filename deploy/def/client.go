@@ -325,6 +325,20 @@ func (c *Client) Call(arg *CallArg) (*payload.CallTx, error) {
 	return tx, nil
 }
 
+// Find the smallest gas limit with which tx succeeds against the current state
+func (c *Client) EstimateGas(tx *payload.CallTx) (*rpctransact.GasEstimate, error) {
+	return c.transactClient.EstimateGas(context.Background(), tx)
+}
+
+// Store the ABI of the contract at address with the node so that it can decode the contract's events
+func (c *Client) PutAbi(address crypto.Address, abiJSON string) error {
+	_, err := c.executionEventsClient.PutAbi(context.Background(), &rpcevents.ContractAbi{
+		Address: address,
+		Abi:     abiJSON,
+	})
+	return err
+}
+
 type SendArg struct {
 	Input    string
 	Amount   string
@@ -31,7 +31,7 @@ func (do *Packages) Validate() error {
 		validation.Field(&do.Address, rule.Address),
 		validation.Field(&do.DefaultAmount, rule.Uint64),
 		validation.Field(&do.DefaultFee, rule.Uint64),
-		validation.Field(&do.DefaultGas, rule.Uint64),
+		validation.Field(&do.DefaultGas, rule.OptionalUint64),
 		validation.Field(&do.Package),
 	)
 }
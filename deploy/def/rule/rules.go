@@ -118,6 +118,9 @@ var (
 
 	Uint64OrPlaceholder = Or(Placeholder, Uint64)
 
+	// Like Uint64 but may be omitted
+	OptionalUint64 = validation.NewStringRule(IsUint64, "should be a 64 bit unsigned integer")
+
 	Uint64 = validation.By(func(value interface{}) error {
 		str, err := validation.EnsureString(value)
 		if err != nil {
@@ -162,6 +165,11 @@ func (orr *orRule) Validate(value interface{}) error {
 	return fmt.Errorf("did not validate any requirements: %s", strings.Join(errs, ", "))
 }
 
+func IsUint64(value string) bool {
+	_, err := strconv.ParseUint(value, 10, 64)
+	return err == nil
+}
+
 func IsAddress(value string) bool {
 	_, err := crypto.AddressFromHexString(value)
 	return err == nil
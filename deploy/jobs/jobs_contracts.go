@@ -9,10 +9,12 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/crypto"
 	compilers "github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/deploy/def"
 	"github.com/hyperledger/burrow/deploy/util"
+	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/txs/payload"
@@ -140,7 +142,8 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 			contractCode = contractCode + callData
 		}
 
-		tx, err := deployTx(do, deploy, contractName, string(contractCode))
+		tx, err := deployTx(do, deploy, contractName, string(contractCode),
+			&acm.ContractMeta{Abi: string(binaryResponse.Abi)})
 		if err != nil {
 			return "could not deploy binary contract", err
 		}
@@ -148,6 +151,7 @@ func DeployJob(deploy *def.Deploy, do *def.Packages, resp *compilers.Response) (
 		if err != nil {
 			return "", fmt.Errorf("Error finalizing contract deploy from path %s: %v", contractPath, err)
 		}
+		putAbi(do, *result, binaryResponse.Abi)
 		return result.String(), err
 	} else {
 		contractPath = deploy.Contract
@@ -274,7 +278,7 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 		contractCode = contractCode + callData
 	}
 
-	tx, err := deployTx(do, deploy, compilersResponse.Objectname, contractCode)
+	tx, err := deployTx(do, deploy, compilersResponse.Objectname, contractCode, contractMeta(compilersResponse))
 	if err != nil {
 		return "", err
 	}
@@ -287,6 +291,7 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 
 	// saving contract/library abi at abi/address
 	if contractAddress != nil {
+		putAbi(do, *contractAddress, compilersResponse.Binary.Abi)
 		// saving binary
 		b, err := json.Marshal(compilersResponse.Binary)
 		if err != nil {
@@ -309,7 +314,8 @@ func deployContract(deploy *def.Deploy, do *def.Packages, compilersResponse comp
 	}
 }
 
-func deployTx(do *def.Packages, deploy *def.Deploy, contractName, contractCode string) (*payload.CallTx, error) {
+func deployTx(do *def.Packages, deploy *def.Deploy, contractName, contractCode string,
+	meta *acm.ContractMeta) (*payload.CallTx, error) {
 	// Deploy contract
 	log.WithFields(log.Fields{
 		"name": contractName,
@@ -321,7 +327,7 @@ func deployTx(do *def.Packages, deploy *def.Deploy, contractName, contractCode s
 		"chain-url": do.ChainURL,
 	}).Info()
 
-	return do.Call(&def.CallArg{
+	tx, err := do.Call(&def.CallArg{
 		Input:    deploy.Source,
 		Amount:   deploy.Amount,
 		Fee:      deploy.Fee,
@@ -329,6 +335,66 @@ func deployTx(do *def.Packages, deploy *def.Deploy, contractName, contractCode s
 		Data:     contractCode,
 		Sequence: deploy.Sequence,
 	})
+	if err != nil {
+		return nil, err
+	}
+	tx.ContractMeta = meta
+	return tx, estimateGas(do, tx, deploy.Gas)
+}
+
+// Builds the metadata committed on-chain along with the contract, which is as complete as the compiler output allows
+func contractMeta(compilersResponse compilers.ResponseItem) *acm.ContractMeta {
+	meta := &acm.ContractMeta{Abi: string(compilersResponse.Binary.Abi)}
+	metadata, err := compilersResponse.Binary.GetMetadata()
+	if err != nil {
+		log.WithField("error", err).Debug("Deploying contract without compiler metadata")
+		return meta
+	}
+	meta.CompilerVersion = metadata.Compiler.Version
+	if source, ok := metadata.Sources[compilersResponse.Filename]; ok {
+		sourceHash, err := hex.DecodeString(strings.TrimPrefix(source.Keccak256, "0x"))
+		if err == nil {
+			meta.SourceHash = sourceHash
+		}
+	}
+	return meta
+}
+
+// Stores the ABI with the chain node so it can decode the contract's events, which is not essential to the deploy
+func putAbi(do *def.Packages, address crypto.Address, abiJSON []byte) {
+	if len(abiJSON) == 0 {
+		return
+	}
+	err := do.PutAbi(address, string(abiJSON))
+	if err != nil {
+		log.WithFields(log.Fields{
+			"address": address,
+			"error":   err,
+		}).Warn("Could not store ABI with node")
+	}
+}
+
+// When no gas is given sets the GasLimit of tx to the smallest with which it succeeds against the current state
+func estimateGas(do *def.Packages, tx *payload.CallTx, gas string) error {
+	if gas != "" {
+		return nil
+	}
+	estimate, err := do.EstimateGas(tx)
+	if err != nil {
+		return fmt.Errorf("could not estimate gas: %v", err)
+	}
+	if estimate.Exception != nil {
+		// Send it anyway so that the failure is reported in the same way as any other
+		log.WithFields(log.Fields{
+			"exception":     estimate.Exception,
+			"revert reason": estimate.RevertReason,
+		}).Warn("Transaction fails with any gas limit")
+		tx.GasLimit = execution.MaxGasEstimate
+		return nil
+	}
+	log.WithField("=>", estimate.GasLimit).Info("Estimated gas")
+	tx.GasLimit = estimate.GasLimit
+	return nil
 }
 
 func CallJob(call *def.Call, do *def.Packages) (string, []*abi.Variable, error) {
@@ -383,6 +449,10 @@ func CallJob(call *def.Call, do *def.Packages) (string, []*abi.Variable, error)
 	if err != nil {
 		return "", nil, err
 	}
+	err = estimateGas(do, tx, call.Gas)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// Sign, broadcast, display
 	txe, err := do.SignAndBroadcast(tx)
@@ -13,6 +13,7 @@ const (
 	IndexKey       = "Index"
 	StackDepthKey  = "StackDepth"
 	AddressKey     = "Address"
+	EventNameKey   = "EventName"
 )
 
 // Get a query that matches events with a specific eventID
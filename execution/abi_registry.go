@@ -0,0 +1,89 @@
+package execution
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// Contract ABIs live under the refs prefix and are not part of the state tree, they are known only to this node and
+// are used to decode the events of contracts
+var abiKeyFormat = storage.NewMustKeyFormat("a", crypto.AddressLength)
+
+// Stores the JSON ABI for the contract at address, which must hold code. Since anyone who can reach the node may store
+// an ABI, one that is already stored or was committed with the contract is never replaced (see SetAbi).
+func (s *State) PutAbi(address crypto.Address, abiJSON string) error {
+	_, err := abi.ReadAbiSpec([]byte(abiJSON))
+	if err != nil {
+		return fmt.Errorf("could not read ABI for %v: %v", address, err)
+	}
+	s.Lock()
+	defer s.Unlock()
+	acc, err := s.GetAccount(address)
+	if err != nil {
+		return err
+	}
+	if acc == nil || len(acc.Code()) == 0 {
+		return fmt.Errorf("there is no contract at %v to store an ABI for", address)
+	}
+	existing, err := s.GetAbi(address)
+	if err != nil {
+		return err
+	}
+	if existing != "" {
+		if existing == abiJSON {
+			return nil
+		}
+		return fmt.Errorf("an ABI is already stored for %v and cannot be replaced", address)
+	}
+	s.setAbi(address, []byte(abiJSON))
+	return nil
+}
+
+// Stores the JSON ABI for address replacing any already stored. Meant for the node operator (via burrow abi) to correct
+// an ABI, which still does not take precedence over one committed with the contract.
+func (s *State) SetAbi(address crypto.Address, abiJSON string) error {
+	_, err := abi.ReadAbiSpec([]byte(abiJSON))
+	if err != nil {
+		return fmt.Errorf("could not read ABI for %v: %v", address, err)
+	}
+	s.Lock()
+	defer s.Unlock()
+	s.setAbi(address, []byte(abiJSON))
+	return nil
+}
+
+// Removes the JSON ABI stored for address, if any
+func (s *State) DeleteAbi(address crypto.Address) {
+	s.Lock()
+	defer s.Unlock()
+	s.setAbi(address, nil)
+}
+
+// Sets or, if abiJSON is nil, deletes the stored ABI, must be called with the write lock held
+func (s *State) setAbi(address crypto.Address, abiJSON []byte) {
+	if abiJSON == nil {
+		s.refs.Delete(abiKeyFormat.Key(address))
+	} else {
+		s.refs.Set(abiKeyFormat.Key(address), abiJSON)
+	}
+	// No block is in progress while we hold the lock so this commits only the ABI
+	batch := s.db.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+}
+
+// Returns the JSON ABI in any contract metadata committed when the contract at address was created, since that is
+// agreed on by the chain, falling back to the ABI stored with this node, or the empty string if there is neither
+func (s *State) GetAbi(address crypto.Address) (string, error) {
+	meta, err := s.GetContractMeta(address)
+	if err != nil {
+		return "", err
+	}
+	if meta != nil && meta.Abi != "" {
+		return meta.Abi, nil
+	}
+	return string(s.refs.Get(abiKeyFormat.Key(address))), nil
+}
@@ -2,8 +2,11 @@ package execution
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/genesis"
 )
 
 type VMOption string
@@ -15,6 +18,27 @@ const (
 
 type ExecutionConfig struct {
 	VMOptions []VMOption `json:",omitempty" toml:",omitempty"`
+	// Name of a preset gas schedule, one of "burrow-legacy" or "ethereum-istanbul"
+	GasSchedule string `json:",omitempty" toml:",omitempty"`
+	// A complete gas schedule to use instead of a preset
+	CustomGasSchedule *schedule.GasSchedule `json:",omitempty" toml:",omitempty"`
+	// The number of past heights for which to retain state for historical queries (zero retains all)
+	StateRetention uint64 `json:",omitempty" toml:",omitempty"`
+	// Whether to maintain an index of the addresses and log topics of events to speed up event queries
+	EventIndex bool `json:",omitempty" toml:",omitempty"`
+	// Hold transactions with sequence numbers ahead of the mempool until the gap before them is filled
+	FutureTxQueue *FutureTxQueueConfig `json:",omitempty" toml:",omitempty"`
+	// The number of transactions the mempool may hold before the fee required to join it starts to double with each
+	// further such number of transactions, prioritising those paying higher fees (zero disables)
+	MempoolFeeThreshold int `json:",omitempty" toml:",omitempty"`
+}
+
+type FutureTxQueueConfig struct {
+	Enabled bool
+	// How long a transaction may be queued before it is dropped, as a Go duration such as "1m30s"
+	TTL string `json:",omitempty" toml:",omitempty"`
+	// The maximum number of transactions queued for any one input address
+	Limit int `json:",omitempty" toml:",omitempty"`
 }
 
 func DefaultExecutionConfig() *ExecutionConfig {
@@ -29,6 +53,34 @@ func VMOptions(vmOptions ...func(*evm.VM)) func(*executor) {
 	}
 }
 
+// Make natives callable from the EVM in addition to the precompiles and SNatives
+func Natives(natives *evm.Natives) func(*executor) {
+	return func(exe *executor) {
+		exe.natives = natives
+	}
+}
+
+// Index the events of each block as it is committed
+func IndexEvents(enabled bool) func(*executor) {
+	return func(exe *executor) {
+		exe.indexEvents = enabled
+	}
+}
+
+// Retain only the most recent heights of historical state
+func StateRetention(heights uint64) func(*executor) {
+	return func(exe *executor) {
+		exe.stateRetention = heights
+	}
+}
+
+// Raise the fee required of transactions checked for the mempool once it holds threshold transactions
+func MempoolFeeThreshold(threshold int) func(*executor) {
+	return func(exe *executor) {
+		exe.mempoolFeeThreshold = threshold
+	}
+}
+
 func (ec *ExecutionConfig) ExecutionOptions() ([]ExecutionOption, error) {
 	var exeOptions []ExecutionOption
 	var vmOptions []func(*evm.VM)
@@ -42,6 +94,65 @@ func (ec *ExecutionConfig) ExecutionOptions() ([]ExecutionOption, error) {
 			return nil, fmt.Errorf("VM option '%s' not recognised", option)
 		}
 	}
-	exeOptions = append(exeOptions, VMOptions(vmOptions...))
+	exeOptions = append(exeOptions, VMOptions(vmOptions...), StateRetention(ec.StateRetention),
+		IndexEvents(ec.EventIndex), MempoolFeeThreshold(ec.MempoolFeeThreshold))
 	return exeOptions, nil
 }
+
+func (ec *ExecutionConfig) TransactorOptions() ([]TransactorOption, error) {
+	var transOptions []TransactorOption
+	if ec.FutureTxQueue != nil && ec.FutureTxQueue.Enabled {
+		ttl := DefaultFutureTxTTL
+		if ec.FutureTxQueue.TTL != "" {
+			var err error
+			ttl, err = time.ParseDuration(ec.FutureTxQueue.TTL)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse FutureTxQueue TTL: %v", err)
+			}
+		}
+		limit := DefaultFutureTxLimit
+		if ec.FutureTxQueue.Limit > 0 {
+			limit = ec.FutureTxQueue.Limit
+		}
+		transOptions = append(transOptions, QueueFutureTxs(ttl, limit))
+	}
+	return transOptions, nil
+}
+
+// Returns the gas schedule selected by this config or nil if none has been selected
+func (ec *ExecutionConfig) SelectedGasSchedule() (*schedule.GasSchedule, error) {
+	if ec.CustomGasSchedule == nil {
+		if ec.GasSchedule == "" {
+			return nil, nil
+		}
+		return schedule.Named(ec.GasSchedule)
+	}
+	if ec.GasSchedule != "" {
+		return nil, fmt.Errorf("only one of GasSchedule and CustomGasSchedule may be set but GasSchedule is '%s'",
+			ec.GasSchedule)
+	}
+	err := ec.CustomGasSchedule.Validate()
+	if err != nil {
+		return nil, err
+	}
+	return ec.CustomGasSchedule, nil
+}
+
+// The gas schedule is part of consensus so the one recorded in the GenesisDoc is always used. This checks that schedule
+// is valid and that any schedule selected by this config agrees with it.
+func (ec *ExecutionConfig) VerifyGasSchedule(genesisDoc *genesis.GenesisDoc) error {
+	gasSchedule := schedule.OrLegacy(genesisDoc.GasSchedule)
+	err := gasSchedule.Validate()
+	if err != nil {
+		return fmt.Errorf("GenesisDoc has invalid gas schedule: %v", err)
+	}
+	selected, err := ec.SelectedGasSchedule()
+	if err != nil {
+		return err
+	}
+	if selected != nil && *selected != *gasSchedule {
+		return fmt.Errorf("gas schedule selected by ExecutionConfig differs from the one recorded in GenesisDoc, " +
+			"which all validators must use")
+	}
+	return nil
+}
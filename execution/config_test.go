@@ -0,0 +1,73 @@
+// Copyright 2017 Monax Industries Limited
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecutionConfig_VerifyGasSchedule(t *testing.T) {
+	genesisDoc := &genesis.GenesisDoc{}
+	conf := DefaultExecutionConfig()
+	// Nothing selected and nothing recorded
+	require.NoError(t, conf.VerifyGasSchedule(genesisDoc))
+
+	// Genesis predates gas schedules so uses the legacy one
+	conf.GasSchedule = schedule.BurrowLegacyName
+	require.NoError(t, conf.VerifyGasSchedule(genesisDoc))
+
+	conf.GasSchedule = schedule.EthereumIstanbulName
+	assert.Error(t, conf.VerifyGasSchedule(genesisDoc))
+	genesisDoc.GasSchedule = schedule.EthereumIstanbul()
+	require.NoError(t, conf.VerifyGasSchedule(genesisDoc))
+
+	conf.GasSchedule = ""
+	conf.CustomGasSchedule = schedule.EthereumIstanbul()
+	conf.CustomGasSchedule.StorageUpdate = 5000
+	assert.Error(t, conf.VerifyGasSchedule(genesisDoc))
+	genesisDoc.GasSchedule = conf.CustomGasSchedule
+	require.NoError(t, conf.VerifyGasSchedule(genesisDoc))
+
+	conf.GasSchedule = "not-a-schedule"
+	assert.Error(t, conf.VerifyGasSchedule(genesisDoc))
+}
+
+func TestExecutionConfig_TransactorOptions(t *testing.T) {
+	conf := DefaultExecutionConfig()
+	options, err := conf.TransactorOptions()
+	require.NoError(t, err)
+	assert.Empty(t, options)
+
+	conf.FutureTxQueue = &FutureTxQueueConfig{Enabled: true, TTL: "30s"}
+	options, err = conf.TransactorOptions()
+	require.NoError(t, err)
+	trans := &Transactor{}
+	for _, option := range options {
+		option(trans)
+	}
+	require.True(t, trans.QueuesFutureTxs())
+	assert.Equal(t, 30*time.Second, trans.futureTxs.ttl)
+	assert.Equal(t, DefaultFutureTxLimit, trans.futureTxs.limit)
+
+	conf.FutureTxQueue.TTL = "soon"
+	_, err = conf.TransactorOptions()
+	assert.Error(t, err)
+}
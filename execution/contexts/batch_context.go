@@ -0,0 +1,205 @@
+package contexts
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Executes the transactions of a BatchTx in order against a cache that is only synced to StateWriter and NameReg
+// if all of them succeed. The fees of the transactions are paid even if the batch is discarded.
+type BatchContext struct {
+	Tip         bcm.BlockchainInfo
+	StateWriter state.ReaderWriter
+	NameReg     names.ReaderWriter
+	RunCall     bool
+	VMOptions   []func(*evm.VM)
+	Natives     *evm.Natives
+	Logger      *logging.Logger
+	tx          *payload.BatchTx
+}
+
+type txContext interface {
+	Execute(txe *exec.TxExecution) error
+}
+
+func (ctx *BatchContext) Execute(txe *exec.TxExecution) error {
+	var ok bool
+	ctx.tx, ok = txe.Envelope.Tx.Payload.(*payload.BatchTx)
+	if !ok {
+		return fmt.Errorf("payload must be BatchTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+	err := ctx.Precheck()
+	if err != nil {
+		return err
+	}
+	stateCache := state.NewCache(ctx.StateWriter, state.Name("BatchCache"))
+	nameRegCache := names.NewCache(ctx.NameReg)
+	var gasUsed uint64
+	for i, any := range ctx.tx.Txs {
+		tx := any.GetValue()
+		txCtx, err := ctx.context(tx.Type(), stateCache, nameRegCache)
+		if err != nil {
+			return fmt.Errorf("transaction %d of BatchTx cannot be executed: %v", i, err)
+		}
+		// Events are recorded against the BatchTx so that they can be found by its hash
+		innerTxe := &exec.TxExecution{
+			TxHash:   txe.TxHash,
+			TxType:   tx.Type(),
+			Envelope: txs.Enclose(txe.Envelope.Tx.ChainID, tx),
+			Height:   txe.Height,
+		}
+		err = txCtx.Execute(innerTxe)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("transaction %d of BatchTx failed", i))
+		}
+		txe.Append(innerTxe.Events...)
+		if innerTxe.Exception != nil {
+			// Discard the effects of the whole batch other than its fees
+			ctx.Logger.InfoMsg("Transaction in BatchTx failed so discarding batch",
+				"index", i,
+				"tx_type", tx.Type(),
+				"exception", innerTxe.Exception)
+			err = ctx.chargeFees()
+			if err != nil {
+				return err
+			}
+			txe.SetException(errors.Wrap(innerTxe.Exception, fmt.Sprintf("transaction %d of BatchTx failed", i)))
+			return nil
+		}
+		if innerTxe.Result != nil {
+			gasUsed += innerTxe.Result.GasUsed
+		}
+	}
+	err = stateCache.Sync(ctx.StateWriter)
+	if err != nil {
+		return err
+	}
+	err = nameRegCache.Sync(ctx.NameReg)
+	if err != nil {
+		return err
+	}
+	txe.Return(nil, gasUsed)
+	return nil
+}
+
+// Checks that each transaction of the batch is present and spends only from the inputs of the batch, and that
+// together the CallTxs of the batch are limited to no more gas than a single CallTx may use
+func (ctx *BatchContext) Precheck() error {
+	if len(ctx.tx.Txs) == 0 {
+		return fmt.Errorf("BatchTx contains no transactions")
+	}
+	signers := make(map[crypto.Address]bool, len(ctx.tx.Inputs))
+	for _, input := range ctx.tx.Inputs {
+		signers[input.Address] = true
+	}
+	var gasLimit uint64
+	for i, any := range ctx.tx.Txs {
+		tx := any.GetValue()
+		if tx == nil {
+			return fmt.Errorf("transaction %d of BatchTx is empty", i)
+		}
+		for _, input := range tx.GetInputs() {
+			if input == nil {
+				return fmt.Errorf("transaction %d of BatchTx has an empty input", i)
+			}
+			if !signers[input.Address] {
+				return fmt.Errorf("input %v of transaction %d of BatchTx is not an input of the BatchTx",
+					input.Address, i)
+			}
+		}
+		if callTx, ok := tx.(*payload.CallTx); ok {
+			if callTx.GasLimit > GasLimit-gasLimit {
+				return errors.ErrorCodef(errors.ErrorCodeInsufficientGas,
+					"the CallTxs of BatchTx have a combined GasLimit greater than the maximum of %d", GasLimit)
+			}
+			gasLimit += callTx.GasLimit
+		}
+	}
+	return nil
+}
+
+// Deducts the fee of each transaction of the batch from its input directly against StateWriter
+func (ctx *BatchContext) chargeFees() error {
+	var accounts []*acm.MutableAccount
+	byAddress := make(map[crypto.Address]*acm.MutableAccount)
+	for _, any := range ctx.tx.Txs {
+		tx := any.GetValue()
+		fee := payload.Fee(tx)
+		if fee == 0 {
+			continue
+		}
+		// Only CallTx and NameTx pay fees, each from its single input
+		address := tx.GetInputs()[0].Address
+		acc, ok := byAddress[address]
+		if !ok {
+			var err error
+			acc, err = state.GetMutableAccount(ctx.StateWriter, address)
+			if err != nil {
+				return err
+			}
+			if acc == nil {
+				return errors.ErrorCodef(errors.ErrorCodeInvalidAddress,
+					"could not find account %v to charge BatchTx fee", address)
+			}
+			byAddress[address] = acc
+			accounts = append(accounts, acc)
+		}
+		err := acc.SubtractFromBalance(fee)
+		if err != nil {
+			return err
+		}
+	}
+	for _, acc := range accounts {
+		err := ctx.StateWriter.UpdateAccount(acc)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (ctx *BatchContext) context(txType payload.Type, stateWriter state.ReaderWriter,
+	nameReg names.ReaderWriter) (txContext, error) {
+	switch txType {
+	case payload.TypeSend:
+		return &SendContext{
+			Tip:         ctx.Tip,
+			StateWriter: stateWriter,
+			Logger:      ctx.Logger,
+		}, nil
+	case payload.TypeCall:
+		return &CallContext{
+			Tip:         ctx.Tip,
+			StateWriter: stateWriter,
+			RunCall:     ctx.RunCall,
+			VMOptions:   ctx.VMOptions,
+			Natives:     ctx.Natives,
+			Logger:      ctx.Logger,
+		}, nil
+	case payload.TypeName:
+		return &NameContext{
+			Tip:         ctx.Tip,
+			StateWriter: stateWriter,
+			NameReg:     nameReg,
+			Logger:      ctx.Logger,
+		}, nil
+	case payload.TypePermissions:
+		return &PermissionsContext{
+			Tip:         ctx.Tip,
+			StateWriter: stateWriter,
+			Logger:      ctx.Logger,
+		}, nil
+	}
+	return nil, fmt.Errorf("%v is not allowed in a BatchTx", txType)
+}
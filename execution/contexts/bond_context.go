@@ -0,0 +1,110 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Provides the validators jailed for misbehaviour
+type JailReader interface {
+	// Returns the jailing of the validator at address or nil if it is not jailed
+	Jailed(address crypto.Address) (*exec.Jailing, error)
+}
+
+// Tracks the balance each validator has locked into its power with BondTx, which is all that unbonding can release
+type BondedAmounts interface {
+	// Returns the balance the validator at address has bonded (zero if it has bonded none)
+	Bonded(address crypto.Address) (uint64, error)
+	SetBonded(address crypto.Address, amount uint64) error
+}
+
+type BondContext struct {
+	StateWriter  state.ReaderWriter
+	ValidatorSet validator.ReaderWriter
+	Jails        JailReader
+	Bonds        BondedAmounts
+	Logger       *logging.Logger
+	tx           *payload.BondTx
+}
+
+// BondTx locks the Amount of each input's balance into validator power for the public key that signed for that input.
+// The power can only be converted back to balance by an UnbondTx, which releases only what has been bonded. A jailed validator cannot bond until it is released.
+func (ctx *BondContext) Execute(txe *exec.TxExecution) error {
+	var ok bool
+	ctx.tx, ok = txe.Envelope.Tx.Payload.(*payload.BondTx)
+	if !ok {
+		return fmt.Errorf("payload must be BondTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+	if len(ctx.tx.UnbondTo) > 0 {
+		return fmt.Errorf("BondTx does not support UnbondTo, the recipient of unbonded funds is given by UnbondTx")
+	}
+	accounts, _, err := getInputs(ctx.StateWriter, ctx.tx.Inputs)
+	if err != nil {
+		return err
+	}
+
+	err = allHavePermission(ctx.StateWriter, permission.Bond, accounts, ctx.Logger)
+	if err != nil {
+		return errors.Wrap(err, "at least one input lacks permission for BondTx")
+	}
+
+	for i, input := range ctx.tx.Inputs {
+		if input.Amount == 0 {
+			return errors.ErrorCodeZeroPayment
+		}
+		jailing, err := ctx.Jails.Jailed(input.Address)
+		if err != nil {
+			return err
+		}
+		if jailing != nil {
+			return fmt.Errorf("validator %v cannot bond because it was jailed at height %d (release height: %d)",
+				input.Address, jailing.Height, jailing.ReleaseHeight)
+		}
+		// Envelope.Verify has checked that signatories are in the same order as inputs
+		publicKey := txe.Envelope.Signatories[i].PublicKey
+		if publicKey == nil {
+			return fmt.Errorf("multisig account %v cannot bond since it has no public key", input.Address)
+		}
+		bonded, err := ctx.Bonds.Bonded(input.Address)
+		if err != nil {
+			return err
+		}
+		if bonded+input.Amount < bonded {
+			return fmt.Errorf("bonding %v would overflow the amount bonded by %v", input.Amount, input.Address)
+		}
+		power := new(big.Int).Add(ctx.ValidatorSet.Power(input.Address), new(big.Int).SetUint64(input.Amount))
+		// Bonding counts towards the maximum flow of power so may be refused
+		_, err = ctx.ValidatorSet.AlterPower(*publicKey, power)
+		if err != nil {
+			return err
+		}
+		account := accounts[input.Address]
+		err = account.SubtractFromBalance(input.Amount)
+		if err != nil {
+			return err
+		}
+		err = ctx.StateWriter.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		err = ctx.Bonds.SetBonded(input.Address, bonded+input.Amount)
+		if err != nil {
+			return err
+		}
+		txe.Input(input.Address, nil)
+		txe.Bond(&exec.BondEvent{
+			Validator: input.Address,
+			Amount:    input.Amount,
+		})
+	}
+	return nil
+}
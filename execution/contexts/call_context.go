@@ -9,6 +9,7 @@ import (
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -23,6 +24,7 @@ type CallContext struct {
 	StateWriter state.ReaderWriter
 	RunCall     bool
 	VMOptions   []func(*evm.VM)
+	Natives     *evm.Natives
 	Logger      *logging.Logger
 	tx          *payload.CallTx
 	txe         *exec.TxExecution
@@ -79,12 +81,21 @@ func (ctx *CallContext) Precheck() (*acm.MutableAccount, acm.Account, error) {
 		if !hasCreateContractPermission(ctx.StateWriter, inAcc, ctx.Logger) {
 			return nil, nil, fmt.Errorf("account %s does not have CreateContract permission", ctx.tx.Input.Address)
 		}
+		if ctx.tx.ContractMeta != nil && ctx.tx.ContractMeta.Abi != "" {
+			_, err = abi.ReadAbiSpec([]byte(ctx.tx.ContractMeta.Abi))
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not read ABI from contract metadata: %v", err)
+			}
+		}
 	} else {
+		if ctx.tx.ContractMeta != nil {
+			return nil, nil, fmt.Errorf("contract metadata can only be provided when creating a contract")
+		}
 		if !hasCallPermission(ctx.StateWriter, inAcc, ctx.Logger) {
 			return nil, nil, fmt.Errorf("account %s does not have Call permission", ctx.tx.Input.Address)
 		}
 		// check if its a native contract
-		if evm.IsRegisteredNativeContract(ctx.tx.Address.Word256()) {
+		if evm.IsRegisteredNativeContract(ctx.tx.Address.Word256()) || ctx.Natives.Get(*ctx.tx.Address) != nil {
 			return nil, nil, errors.ErrorCodef(errors.ErrorCodeReservedAddress,
 				"attempt to call a native contract at %s, "+
 					"but native contracts cannot be called using CallTx. Use a "+
@@ -150,6 +161,8 @@ func (ctx *CallContext) Deliver(inAcc, outAcc acm.Account, value uint64) error {
 			BlockHash:   binary.LeftPadWord256(ctx.Tip.LastBlockHash()),
 			BlockTime:   ctx.Tip.LastBlockTime().Unix(),
 			GasLimit:    GasLimit,
+			Blockchain:  ctx.Tip,
+			GasSchedule: ctx.Tip.GenesisDoc().GasSchedule,
 		}
 	)
 
@@ -206,6 +219,16 @@ func (ctx *CallContext) Deliver(inAcc, outAcc acm.Account, value uint64) error {
 		ctx.Logger.TraceMsg("Successful execution")
 		if createContract {
 			callee.SetCode(ret)
+			if ctx.tx.ContractMeta != nil {
+				// The metadata is committed along with the contract's code so it is only stored for contracts that
+				// were actually created
+				meta := *ctx.tx.ContractMeta
+				meta.Deployer = ctx.tx.Input.Address
+				err := txCache.SetContractMeta(callee.Address(), &meta)
+				if err != nil {
+					return err
+				}
+			}
 		}
 		err := txCache.Sync(ctx.StateWriter)
 		if err != nil {
@@ -16,9 +16,14 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
+type MinimumFeeWriter interface {
+	SetMinimumFee(minimumFee *payload.MinimumFee) error
+}
+
 type GovernanceContext struct {
 	StateWriter  state.ReaderWriter
 	ValidatorSet validator.Writer
+	MinimumFees  MinimumFeeWriter
 	Logger       *logging.Logger
 	tx           *payload.GovTx
 	txe          *exec.TxExecution
@@ -49,13 +54,30 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution) error {
 		txe.Input(i.Address, nil)
 	}
 
+	// Check all minimum fees before changing anything so that the GovTx is not partially applied
+	for _, minimumFee := range ctx.tx.MinimumFees {
+		if !minimumFee.TxType.PaysFee() {
+			return fmt.Errorf("GovTx cannot set a minimum fee for %v since transactions of that type do not pay "+
+				"fees", minimumFee.TxType)
+		}
+	}
+
 	for _, update := range ctx.tx.AccountUpdates {
-		if update.Address == nil && update.PublicKey == nil {
+		if update.Multisig != nil {
+			// The address of a multisig account is derived from its policy and it has no public key of its own
+			address := update.Multisig.Address()
+			if update.PublicKey != nil || (update.Address != nil && *update.Address != address) ||
+				update.Balances().HasPower() {
+				return fmt.Errorf("GovTx account template %v for multisig account %v must not have a public key, "+
+					"power, or another address", update, address)
+			}
+			update.Address = &address
+		} else if update.Address == nil && update.PublicKey == nil {
 			// We do not want to generate a key
 			return fmt.Errorf("could not execution GovTx since account template %v contains neither "+
 				"address or public key", update)
 		}
-		if update.PublicKey == nil {
+		if update.PublicKey == nil && update.Multisig == nil {
 			update.PublicKey, err = ctx.MaybeGetPublicKey(*update.Address)
 			if err != nil {
 				return err
@@ -84,6 +106,16 @@ func (ctx *GovernanceContext) Execute(txe *exec.TxExecution) error {
 		}
 		txe.GovernAccount(governAccountEvent, nil)
 	}
+
+	for _, minimumFee := range ctx.tx.MinimumFees {
+		err = ctx.MinimumFees.SetMinimumFee(minimumFee)
+		if err != nil {
+			return err
+		}
+		ctx.Logger.InfoMsg("Minimum fee set by GovTx",
+			"tx_type", minimumFee.TxType,
+			"minimum_fee", minimumFee.Fee)
+	}
 	return nil
 }
 
@@ -117,6 +149,12 @@ func (ctx *GovernanceContext) UpdateAccount(account *acm.MutableAccount, update
 			return ev, err
 		}
 	}
+	if update.Multisig != nil {
+		err = account.SetMultisig(update.Multisig)
+		if err != nil {
+			return ev, err
+		}
+	}
 	if update.Code != nil {
 		err = account.SetCode(*update.Code)
 		if err != nil {
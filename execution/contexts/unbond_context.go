@@ -0,0 +1,117 @@
+package contexts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/txs/payload"
+)
+
+// Schedules unbonded funds for release
+type UnbondingWriter interface {
+	AddUnbonding(unbonding *exec.UnbondEvent) error
+}
+
+type UnbondContext struct {
+	Tip          bcm.BlockchainInfo
+	StateWriter  state.ReaderWriter
+	ValidatorSet validator.ReaderWriter
+	Unbondings   UnbondingWriter
+	Bonds        BondedAmounts
+	Logger       *logging.Logger
+	tx           *payload.UnbondTx
+}
+
+// UnbondTx removes all of the validator power of its input and schedules the release of the balance the input bonded as
+// balance to Address (or to the input if Address is not set) at the end of the block UnbondingPeriod blocks from now,
+// or at Height if that is later. Power that was not bonded (given by genesis or GovTx) is removed without release and
+// no more than the remaining power is released if the validator has been slashed.
+func (ctx *UnbondContext) Execute(txe *exec.TxExecution) error {
+	var ok bool
+	ctx.tx, ok = txe.Envelope.Tx.Payload.(*payload.UnbondTx)
+	if !ok {
+		return fmt.Errorf("payload must be UnbondTx, but is: %v", txe.Envelope.Tx.Payload)
+	}
+	input := ctx.tx.Input
+	if input == nil {
+		return fmt.Errorf("UnbondTx must have an Input from the validator that is unbonding")
+	}
+	if input.Amount != 0 {
+		return fmt.Errorf("UnbondTx unbonds all of a validator's power so its Input must not have an Amount "+
+			"but has Amount %v", input.Amount)
+	}
+	accounts, _, err := getInputs(ctx.StateWriter, []*payload.TxInput{input})
+	if err != nil {
+		return err
+	}
+	power := ctx.ValidatorSet.Power(input.Address)
+	if power.Sign() == 0 {
+		return fmt.Errorf("%v has no validator power to unbond", input.Address)
+	}
+	amount, err := ctx.Bonds.Bonded(input.Address)
+	if err != nil {
+		return err
+	}
+	if power.Cmp(new(big.Int).SetUint64(amount)) < 0 {
+		amount = power.Uint64()
+	}
+
+	to := ctx.tx.Address
+	if to == crypto.ZeroAddress {
+		to = input.Address
+	}
+	recipient, err := ctx.StateWriter.GetAccount(to)
+	if err != nil {
+		return err
+	}
+	// The account will be created on release
+	if amount > 0 && recipient == nil && !hasCreateAccountPermission(ctx.StateWriter, accounts, ctx.Logger) {
+		return errors.PermissionDenied{
+			Address: input.Address,
+			Perm:    permission.CreateAccount,
+		}
+	}
+
+	// Envelope.Verify has checked that the signatory matches the input
+	publicKey := txe.Envelope.Signatories[0].PublicKey
+	if publicKey == nil {
+		return fmt.Errorf("multisig account %v cannot unbond since it has no public key", input.Address)
+	}
+	// Unbonding counts towards the maximum flow of power so may be refused
+	_, err = ctx.ValidatorSet.AlterPower(*publicKey, new(big.Int))
+	if err != nil {
+		return err
+	}
+
+	releaseHeight := ctx.Tip.LastBlockHeight() + 1 + ctx.Tip.GenesisDoc().UnbondingPeriod
+	if ctx.tx.Height > releaseHeight {
+		releaseHeight = ctx.tx.Height
+	}
+	unbonding := &exec.UnbondEvent{
+		Validator:     input.Address,
+		To:            to,
+		Amount:        amount,
+		ReleaseHeight: releaseHeight,
+	}
+	err = ctx.Bonds.SetBonded(input.Address, 0)
+	if err != nil {
+		return err
+	}
+	if amount > 0 {
+		err = ctx.Unbondings.AddUnbonding(unbonding)
+		if err != nil {
+			return err
+		}
+	}
+	txe.Input(input.Address, nil)
+	txe.Unbond(unbonding)
+	return nil
+}
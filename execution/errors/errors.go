@@ -39,6 +39,8 @@ const (
 	ErrorCodeZeroPayment
 	ErrorCodeInvalidSequence
 	ErrorCodeReservedAddress
+	ErrorCodeIllegalWrite
+	ErrorCodeInsufficientFee
 )
 
 func (c Code) ErrorCode() Code {
@@ -113,6 +115,10 @@ func (c Code) String() string {
 		return "Invalid sequence number"
 	case ErrorCodeReservedAddress:
 		return "Address is reserved for SNative or internal use"
+	case ErrorCodeIllegalWrite:
+		return "Callee attempted to illegally modify state"
+	case ErrorCodeInsufficientFee:
+		return "Fee is less than the minimum required"
 	default:
 		return "Unknown error"
 	}
@@ -0,0 +1,179 @@
+// Copyright 2017 Monax Industries Limited
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package execution
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/storage"
+)
+
+// The event index lives under the refs prefix and is not part of the state tree (so nodes may choose whether to keep it)
+var (
+	// The heights of blocks with events that mention an address
+	addressIndexKeyFormat = storage.NewMustKeyFormat("ia", crypto.AddressLength, uint64Length)
+	// The heights of blocks with logs that have a topic (in any position)
+	topicIndexKeyFormat = storage.NewMustKeyFormat("it", Word256Length, uint64Length)
+	// The Bloom filter of the addresses and topics of a block (absent when there are none)
+	bloomKeyFormat = storage.NewMustKeyFormat("ib", uint64Length)
+	// The heights of the first and last blocks indexed, all blocks between them are indexed
+	indexStartKey = []byte("is")
+	indexEndKey   = []byte("ie")
+)
+
+// Adds the block to the event index. If blocks have gone unindexed since the last block indexed (for example because
+// indexing was turned off for a while) the index restarts at this block so that it never covers a gap.
+func (ws *writeState) IndexBlock(be *exec.BlockExecution) error {
+	end := ws.state.refs.Get(indexEndKey)
+	if len(end) == 0 || binary.BigEndian.Uint64(end)+1 < be.Height {
+		ws.state.refs.Set(indexStartKey, formatHeight(be.Height))
+	}
+	ws.state.refs.Set(indexEndKey, formatHeight(be.Height))
+	ws.state.indexBlock(be)
+	return nil
+}
+
+func (s *State) indexBlock(be *exec.BlockExecution) {
+	terms := be.IndexTerms()
+	if len(terms.Addresses) == 0 && len(terms.Topics) == 0 {
+		return
+	}
+	for _, address := range terms.Addresses {
+		s.refs.Set(addressIndexKeyFormat.Key(address, be.Height), []byte{})
+	}
+	for _, topic := range terms.Topics {
+		s.refs.Set(topicIndexKeyFormat.Key(topic, be.Height), []byte{})
+	}
+	s.refs.Set(bloomKeyFormat.Key(be.Height), terms.Bloom()[:])
+}
+
+// Discards any existing event index and indexes every stored block so that the index covers the entire chain
+func (s *State) RebuildEventIndex() error {
+	s.Lock()
+	defer s.Unlock()
+	for _, kf := range []*storage.MustKeyFormat{addressIndexKeyFormat, topicIndexKeyFormat, bloomKeyFormat} {
+		prefix := kf.Prefix()
+		var keys [][]byte
+		it := prefix.Iterator(s.refs.Iterator, nil, nil)
+		for it.Valid() {
+			keys = append(keys, prefix.Key(it.Key()))
+			it.Next()
+		}
+		it.Close()
+		for _, key := range keys {
+			s.refs.Delete(key)
+		}
+	}
+	// Blocks are stored from height 1
+	s.refs.Set(indexStartKey, formatHeight(1))
+	s.refs.Set(indexEndKey, formatHeight(s.height))
+	_, err := s.GetBlocks(0, s.height+1, func(be *exec.BlockExecution) (stop bool) {
+		s.indexBlock(be)
+		return false
+	})
+	if err != nil {
+		return fmt.Errorf("could not rebuild event index: %v", err)
+	}
+	batch := s.db.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+	return nil
+}
+
+// Like GetBlocks but may skip blocks for which the event index shows there can be no events having all of the
+// addresses and topics of terms. Blocks from outside the indexed range of heights are never skipped.
+func (s *State) GetBlocksIndexed(startHeight, endHeight uint64, terms *exec.IndexTerms,
+	consumer func(*exec.BlockExecution) (stop bool)) (stopped bool, err error) {
+
+	start, end := s.refs.Get(indexStartKey), s.refs.Get(indexEndKey)
+	if len(start) == 0 || len(end) == 0 || terms == nil || (len(terms.Addresses) == 0 && len(terms.Topics) == 0) {
+		return s.GetBlocks(startHeight, endHeight, consumer)
+	}
+	indexStart, indexEnd := binary.BigEndian.Uint64(start), binary.BigEndian.Uint64(end)+1
+	if startHeight < indexStart {
+		scanEnd := endHeight
+		if indexStart < scanEnd {
+			scanEnd = indexStart
+		}
+		stopped, err = s.GetBlocks(startHeight, scanEnd, consumer)
+		if stopped || err != nil {
+			return stopped, err
+		}
+		startHeight = scanEnd
+	}
+	if startHeight >= endHeight {
+		return false, nil
+	}
+	if indexEnd < endHeight {
+		stopped, err = s.getBlocksIndexed(startHeight, indexEnd, terms, consumer)
+		if stopped || err != nil {
+			return stopped, err
+		}
+		if indexEnd > startHeight {
+			startHeight = indexEnd
+		}
+		return s.GetBlocks(startHeight, endHeight, consumer)
+	}
+	return s.getBlocksIndexed(startHeight, endHeight, terms, consumer)
+}
+
+// Visits the blocks between startHeight and endHeight that may have events having all the terms, all of which must
+// be indexed
+func (s *State) getBlocksIndexed(startHeight, endHeight uint64, terms *exec.IndexTerms,
+	consumer func(*exec.BlockExecution) (stop bool)) (stopped bool, err error) {
+
+	if startHeight >= endHeight {
+		return false, nil
+	}
+	// Visit the blocks in which the first term appears and check the others against their Bloom filters
+	var kf *storage.MustKeyFormat
+	if len(terms.Addresses) > 0 {
+		kf = addressIndexKeyFormat.Fix(terms.Addresses[0])
+	} else {
+		kf = topicIndexKeyFormat.Fix(terms.Topics[0])
+	}
+	var heights []uint64
+	it := kf.Iterator(s.refs, kf.Suffix(startHeight), kf.Suffix(endHeight))
+	for it.Valid() {
+		heights = append(heights, binary.BigEndian.Uint64(it.Key()))
+		it.Next()
+	}
+	it.Close()
+	for _, height := range heights {
+		bloom := new(exec.Bloom)
+		copy(bloom[:], s.refs.Get(bloomKeyFormat.Key(height)))
+		if !bloom.TestAll(terms) {
+			continue
+		}
+		be, err := s.GetBlock(height)
+		if err != nil {
+			return true, err
+		}
+		if be != nil && consumer(be) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func formatHeight(height uint64) []byte {
+	bs := make([]byte, uint64Length)
+	binary.BigEndian.PutUint64(bs, height)
+	return bs
+}
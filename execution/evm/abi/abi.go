@@ -15,6 +15,7 @@ import (
 	burrow_binary "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/tmthrgd/go-hex"
 )
 
 // EVM Solidity calls and return values are packed into
@@ -482,16 +483,16 @@ func (e EVMAddress) pack(v interface{}) ([]byte, error) {
 			if err != nil {
 				return nil, err
 			}
-		}
-	} else {
-		b, ok := v.([]byte)
-		if !ok {
-			return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
-		}
+		} else {
+			b, ok := v.([]byte)
+			if !ok {
+				return nil, fmt.Errorf("cannot map to %s to EVM address", reflect.ValueOf(v).Kind().String())
+			}
 
-		a, err = crypto.AddressFromBytes(b)
-		if err != nil {
-			return nil, err
+			a, err = crypto.AddressFromBytes(b)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -717,8 +718,11 @@ type FunctionSpec struct {
 }
 
 type Event struct {
+	Name      string
 	Inputs    []Argument
 	Anonymous bool
+	// The hash of the event signature, which is the first topic of a log unless the event is anonymous
+	EventID burrow_binary.Word256
 }
 
 type AbiSpec struct {
@@ -726,6 +730,8 @@ type AbiSpec struct {
 	Fallback    FunctionSpec
 	Functions   map[string]FunctionSpec
 	Events      map[string]Event
+	// Non-anonymous events by EventID
+	EventsByID map[burrow_binary.Word256]Event
 }
 
 type ArgumentJSON struct {
@@ -853,8 +859,9 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 	}
 
 	abiSpec := AbiSpec{
-		Events:    make(map[string]Event),
-		Functions: make(map[string]FunctionSpec),
+		Events:     make(map[string]Event),
+		EventsByID: make(map[burrow_binary.Word256]Event),
+		Functions:  make(map[string]FunctionSpec),
 	}
 
 	for _, s := range specJ {
@@ -872,6 +879,8 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 			if err != nil {
 				return nil, err
 			}
+			// The signature uses the declared types so must be taken before any are replaced by their hash
+			ev := Event{Name: s.Name, Anonymous: s.Anonymous, EventID: GetEventID(Signature(s.Name, inputs))}
 			for i := range inputs {
 				if inputs[i].Indexed && inputs[i].EVM.isDynamic() {
 					// For Dynamic types, the hash is stored in stead
@@ -879,7 +888,11 @@ func ReadAbiSpec(specBytes []byte) (*AbiSpec, error) {
 					inputs[i].Hashed = true
 				}
 			}
-			abiSpec.Events[s.Name] = Event{Inputs: inputs, Anonymous: s.Anonymous}
+			ev.Inputs = inputs
+			abiSpec.Events[s.Name] = ev
+			if !ev.Anonymous {
+				abiSpec.EventsByID[ev.EventID] = ev
+			}
 		case "function":
 			inputs, err := readArgSpec(s.Inputs)
 			if err != nil {
@@ -990,22 +1003,39 @@ func SpecFromFunctionReflect(fname string, v reflect.Value, skipIn, skipOut int)
 }
 
 func (functionSpec *FunctionSpec) SetFunctionID(functionName string) {
-	sig := functionName + "("
-	for i, a := range functionSpec.Inputs {
+	functionSpec.FunctionID = GetFunctionID(Signature(functionName, functionSpec.Inputs))
+}
+
+// The canonical signature of a function or event, for example transfer(address,uint256)
+func Signature(name string, args []Argument) string {
+	sig := name + "("
+	for i, a := range args {
 		if i > 0 {
 			sig += ","
 		}
-		sig += a.EVM.GetSignature()
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				sig += fmt.Sprintf("[%d]", a.ArrayLength)
-			} else {
-				sig += "[]"
-			}
+		sig += a.TypeSignature()
+	}
+	return sig + ")"
+}
+
+// The type of the argument as it appears in signatures
+func (a Argument) TypeSignature() string {
+	sig := a.EVM.GetSignature()
+	if a.IsArray {
+		if a.ArrayLength > 0 {
+			sig += fmt.Sprintf("[%d]", a.ArrayLength)
+		} else {
+			sig += "[]"
 		}
 	}
-	sig += ")"
-	functionSpec.FunctionID = GetFunctionID(sig)
+	return sig
+}
+
+func GetEventID(signature string) (id burrow_binary.Word256) {
+	hash := sha3.NewKeccak256()
+	hash.Write([]byte(signature))
+	copy(id[:], hash.Sum(nil))
+	return
 }
 
 func (fs FunctionID) Bytes() []byte {
@@ -1056,6 +1086,55 @@ func UnpackEvent(eventSpec Event, topics []burrow_binary.Word256, data []byte, a
 	})
 }
 
+// Unpacks all the fields of an event as strings in the manner of Unpacker, except that fixed-length bytes are
+// hex-encoded
+func UnpackEventVariables(eventSpec Event, topics []burrow_binary.Word256, data []byte) ([]*Variable, error) {
+	args := make([]interface{}, len(eventSpec.Inputs))
+	for i, a := range eventSpec.Inputs {
+		if isFixedBytes(a) {
+			args[i] = new([]byte)
+		} else {
+			args[i] = new(string)
+		}
+	}
+	indexed := 0
+	for _, a := range eventSpec.Inputs {
+		if a.Indexed {
+			indexed++
+		}
+	}
+	if !eventSpec.Anonymous {
+		indexed++
+	}
+	if len(topics) < indexed {
+		return nil, fmt.Errorf("event %s has %d topics but %d are required", eventSpec.Name, len(topics), indexed)
+	}
+	err := UnpackEvent(eventSpec, topics, data, args...)
+	if err != nil {
+		return nil, err
+	}
+	vars := make([]*Variable, len(args))
+	for i, a := range eventSpec.Inputs {
+		name := a.Name
+		if name == "" {
+			name = fmt.Sprintf("%d", i)
+		}
+		vars[i] = &Variable{Name: name}
+		switch arg := args[i].(type) {
+		case *[]byte:
+			vars[i].Value = hex.EncodeUpperToString(*arg)
+		case *string:
+			vars[i].Value = *arg
+		}
+	}
+	return vars, nil
+}
+
+func isFixedBytes(a Argument) bool {
+	bs, ok := a.EVM.(EVMBytes)
+	return ok && bs.M > 0 && !a.IsArray
+}
+
 func (abiSpec *AbiSpec) Unpack(data []byte, fname string, args ...interface{}) error {
 	var funcSpec FunctionSpec
 	var argSpec []Argument
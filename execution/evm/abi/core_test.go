@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	burrow_binary "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tmthrgd/go-hex"
@@ -477,3 +479,34 @@ func hexToBytes(t testing.TB, hexString string) []byte {
 	require.NoError(t, err)
 	return bs
 }
+
+func TestUnpackEventVariables(t *testing.T) {
+	spec, err := ReadAbiSpec([]byte(`[{"anonymous":false,"inputs":[{"indexed":true,"name":"from","type":"address"},
+{"indexed":true,"name":"to","type":"address"},{"indexed":false,"name":"value","type":"uint256"},
+{"indexed":false,"name":"","type":"bytes4"}],"name":"Transfer","type":"event"}]`))
+	require.NoError(t, err)
+	transfer := spec.Events["Transfer"]
+	assert.Equal(t, "Transfer", transfer.Name)
+	assert.Equal(t, "Transfer(address,address,uint256,bytes4)", Signature(transfer.Name, transfer.Inputs))
+	assert.Equal(t, transfer, spec.EventsByID[GetEventID("Transfer(address,address,uint256,bytes4)")])
+	assert.Equal(t, "DDF252AD1BE2C89B69C2B068FC378DAA952BA7F163C4A11628F55A4DF523B3EF",
+		hex.EncodeUpperToString(GetEventID("Transfer(address,address,uint256)").Bytes()))
+
+	from := crypto.Address{1, 2, 3}
+	to := crypto.Address{4, 5, 6}
+	topics := []burrow_binary.Word256{transfer.EventID, burrow_binary.LeftPadWord256(from.Bytes()),
+		burrow_binary.LeftPadWord256(to.Bytes())}
+	data, err := Pack(transfer.Inputs[2:], uint64(1000), []byte{0xCA, 0xFE, 0, 1})
+	require.NoError(t, err)
+	vars, err := UnpackEventVariables(transfer, topics, data)
+	require.NoError(t, err)
+	assert.Equal(t, []*Variable{
+		{Name: "from", Value: from.String()},
+		{Name: "to", Value: to.String()},
+		{Name: "value", Value: "1000"},
+		{Name: "3", Value: "CAFE0001"},
+	}, vars)
+
+	_, err = UnpackEventVariables(transfer, topics[:2], data)
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package evm
 
 import (
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -30,3 +31,28 @@ func DeriveNewAccount(creator *acm.MutableAccount, permissions permission.Accoun
 		Permissions: permissions,
 	}.MutableAccount()
 }
+
+// Create a new account from a parent 'creator' account at the address given by CREATE2 (see EIP-1014) so that the
+// address depends only on the creator, the salt, and the init code. The creator account will have its sequence number
+// incremented as with DeriveNewAccount.
+func DeriveNewAccountWithSalt(creator *acm.MutableAccount, salt binary.Word256, initCode []byte,
+	permissions permission.AccountPermissions, logger *logging.Logger) *acm.MutableAccount {
+
+	logger.TraceMsg("Incrementing sequence number in DeriveNewAccountWithSalt()",
+		"tag", "sequence",
+		"account", creator.Address(),
+		"old_sequence", creator.Sequence(),
+		"new_sequence", creator.Sequence()+1)
+
+	creator.IncSequence()
+	addr := crypto.NewContractAddress2(creator.Address(), salt, initCode)
+
+	// Create account from address.
+	return acm.ConcreteAccount{
+		Address:     addr,
+		Balance:     0,
+		Code:        nil,
+		Sequence:    0,
+		Permissions: permissions,
+	}.MutableAccount()
+}
@@ -73,6 +73,7 @@ const (
 	EXTCODECOPY
 	RETURNDATASIZE
 	RETURNDATACOPY
+	EXTCODEHASH
 )
 
 const (
@@ -84,6 +85,8 @@ const (
 	BLOCKHEIGHT
 	DIFFICULTY_DEPRECATED
 	GASLIMIT
+	CHAINID
+	SELFBALANCE
 )
 
 const (
@@ -185,10 +188,10 @@ const (
 	CALLCODE
 	RETURN
 	DELEGATECALL
+	CREATE2
 
 	// 0x70 range - other
 	STATICCALL   = 0xfa
-	CREATE2      = 0xfb
 	REVERT       = 0xfd
 	INVALID      = 0xfe
 	SELFDESTRUCT = 0xff
@@ -248,10 +251,13 @@ var opCodeNames = map[OpCode]string{
 	BLOCKHEIGHT:           "BLOCKHEIGHT",
 	DIFFICULTY_DEPRECATED: "DIFFICULTY_DEPRECATED",
 	GASLIMIT:              "GASLIMIT",
+	CHAINID:               "CHAINID",
+	SELFBALANCE:           "SELFBALANCE",
 	EXTCODESIZE:           "EXTCODESIZE",
 	EXTCODECOPY:           "EXTCODECOPY",
 	RETURNDATASIZE:        "RETURNDATASIZE",
 	RETURNDATACOPY:        "RETURNDATACOPY",
+	EXTCODEHASH:           "EXTCODEHASH",
 
 	// 0x50 range - 'storage' and execution
 	POP:      "POP",
@@ -346,9 +352,9 @@ var opCodeNames = map[OpCode]string{
 	RETURN:       "RETURN",
 	CALLCODE:     "CALLCODE",
 	DELEGATECALL: "DELEGATECALL",
+	CREATE2:      "CREATE2",
 	STATICCALL:   "STATICCALL",
 	// 0x70 range - other
-	CREATE2:      "CREATE2",
 	REVERT:       "REVERT",
 	INVALID:      "INVALID",
 	SELFDESTRUCT: "SELFDESTRUCT",
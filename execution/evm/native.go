@@ -16,13 +16,19 @@ package evm
 
 import (
 	"crypto/sha256"
+	"fmt"
+	"math/big"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/state"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/logging"
+	bn256 "github.com/umbracle/go-eth-bn256"
 	"golang.org/x/crypto/ripemd160"
 )
 
@@ -48,23 +54,33 @@ func init() {
 }
 
 func registerNativeContracts() {
-	// registeredNativeContracts[Int64ToWord256(1)] = ecrecoverFunc
+	registeredNativeContracts[Int64ToWord256(1)] = ecrecoverFunc
 	registeredNativeContracts[Int64ToWord256(2)] = sha256Func
 	registeredNativeContracts[Int64ToWord256(3)] = ripemd160Func
 	registeredNativeContracts[Int64ToWord256(4)] = identityFunc
+	registeredNativeContracts[Int64ToWord256(5)] = modexpFunc
+	registeredNativeContracts[Int64ToWord256(6)] = bn256AddFunc
+	registeredNativeContracts[Int64ToWord256(7)] = bn256ScalarMulFunc
+	registeredNativeContracts[Int64ToWord256(8)] = bn256PairingFunc
 }
 
 //-----------------------------------------------------------------------------
 
 func ExecuteNativeContract(address Word256, state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
-	logger *logging.Logger) ([]byte, errors.CodedError) {
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) ([]byte, errors.CodedError) {
 
 	contract, ok := registeredNativeContracts[address]
 	if !ok {
 		return nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
 			"no native contract registered at address: %v", crypto.AddressFromWord256(address))
 	}
-	output, err := contract(state, caller, input, gas, logger)
+	return executeNativeContract(contract, state, caller, input, gas, gasSchedule, logger)
+}
+
+func executeNativeContract(contract NativeContract, state state.ReaderWriter, caller acm.Account, input []byte,
+	gas *uint64, gasSchedule *schedule.GasSchedule, logger *logging.Logger) ([]byte, errors.CodedError) {
+
+	output, err := contract(state, caller, input, gas, gasSchedule, logger)
 	if err != nil {
 		return nil, errors.NewException(errors.ErrorCodeNativeFunction, err.Error())
 	}
@@ -72,36 +88,74 @@ func ExecuteNativeContract(address Word256, state state.ReaderWriter, caller acm
 }
 
 type NativeContract func(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
-	logger *logging.Logger) (output []byte, err error)
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error)
+
+// Wraps the state passed to a native contract called from a read-only (STATICCALL) frame so that any attempt to modify
+// state fails
+type readOnlyState struct {
+	state.Reader
+}
+
+func (ros readOnlyState) UpdateAccount(updatedAccount acm.Account) error {
+	return errors.ErrorCodeIllegalWrite
+}
 
-/* Removed due to C dependency
-func ecrecoverFunc(state State, caller *acm.Account, input []byte, gas *int64) (output []byte, err error) {
+func (ros readOnlyState) RemoveAccount(address crypto.Address) error {
+	return errors.ErrorCodeIllegalWrite
+}
+
+func (ros readOnlyState) SetStorage(address crypto.Address, key, value Word256) error {
+	return errors.ErrorCodeIllegalWrite
+}
+
+func ecrecoverFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := GasEcRecover
+	gasRequired := gasSchedule.EcRecover
 	if *gas < gasRequired {
-		return nil, ErrInsufficientGas
+		return nil, errors.ErrorCodeInsufficientGas
 	} else {
 		*gas -= gasRequired
 	}
-	// Recover
+	// Input is hash (32 bytes) ++ v (32 bytes) ++ r (32 bytes) ++ s (32 bytes)
+	input = RightPadBytes(input, 128)
 	hash := input[:32]
-	v := byte(input[32] - 27) // ignore input[33:64], v is small.
-	sig := append(input[64:], v)
-
-	recovered, err := secp256k1.RecoverPubkey(hash, sig)
+	v := input[32:64]
+	r := new(big.Int).SetBytes(input[64:96])
+	s := new(big.Int).SetBytes(input[96:128])
+	// As with Ethereum an invalid signature is not an error but returns no output
+	if !allZero(v[:31]) || (v[31] != 27 && v[31] != 28) || !validSignatureValue(r) || !validSignatureValue(s) {
+		return nil, nil
+	}
+	// Recover using the compact format <v><r><s>
+	sig := make([]byte, 65)
+	sig[0] = v[31]
+	copy(sig[1:], input[64:128])
+	publicKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, hash)
 	if err != nil {
-		return nil, err
-OH NO STOCASTIC CAT CODING!!!!
+		return nil, nil
+	}
+	hashed := sha3.Sha3(publicKey.SerializeUncompressed()[1:])
+	return LeftPadBytes(hashed[12:], 32), nil
+}
+
+func validSignatureValue(value *big.Int) bool {
+	return value.Sign() > 0 && value.Cmp(btcec.S256().N) < 0
+}
+
+func allZero(bs []byte) bool {
+	for _, b := range bs {
+		if b != 0 {
+			return false
+		}
 	}
-	hashed := sha3.Sha3(recovered[1:])
-	return LeftPadBytes(hashed, 32), nil
+	return true
 }
-*/
 
 func sha256Func(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
-	logger *logging.Logger) (output []byte, err error) {
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := uint64((len(input)+31)/32)*GasSha256Word + GasSha256Base
+	gasRequired := uint64((len(input)+31)/32)*gasSchedule.Sha256Word + gasSchedule.Sha256Base
 	if *gas < gasRequired {
 		return nil, errors.ErrorCodeInsufficientGas
 	} else {
@@ -115,9 +169,9 @@ func sha256Func(state state.ReaderWriter, caller acm.Account, input []byte, gas
 }
 
 func ripemd160Func(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
-	logger *logging.Logger) (output []byte, err error) {
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := uint64((len(input)+31)/32)*GasRipemd160Word + GasRipemd160Base
+	gasRequired := uint64((len(input)+31)/32)*gasSchedule.Ripemd160Word + gasSchedule.Ripemd160Base
 	if *gas < gasRequired {
 		return nil, errors.ErrorCodeInsufficientGas
 	} else {
@@ -131,9 +185,9 @@ func ripemd160Func(state state.ReaderWriter, caller acm.Account, input []byte, g
 }
 
 func identityFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
-	logger *logging.Logger) (output []byte, err error) {
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
 	// Deduct gas
-	gasRequired := uint64((len(input)+31)/32)*GasIdentityWord + GasIdentityBase
+	gasRequired := uint64((len(input)+31)/32)*gasSchedule.IdentityWord + gasSchedule.IdentityBase
 	if *gas < gasRequired {
 		return nil, errors.ErrorCodeInsufficientGas
 	} else {
@@ -142,3 +196,184 @@ func identityFunc(state state.ReaderWriter, caller acm.Account, input []byte, ga
 	// Return identity
 	return input, nil
 }
+
+// See EIP-198: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-198.md
+func modexpFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
+	// Input is baseLength ++ expLength ++ modLength ++ base ++ exp ++ mod where the lengths are 32 bytes
+	baseLength := new(big.Int).SetBytes(paddedSlice(input, 0, 32))
+	expLength := new(big.Int).SetBytes(paddedSlice(input, 32, 32))
+	modLength := new(big.Int).SetBytes(paddedSlice(input, 64, 32))
+	// Lengths this large could never be paid for
+	maxLength := big.NewInt(1 << 24)
+	if baseLength.Cmp(maxLength) > 0 || expLength.Cmp(maxLength) > 0 || modLength.Cmp(maxLength) > 0 {
+		return nil, errors.ErrorCodeInsufficientGas
+	}
+	baseLen, expLen, modLen := int(baseLength.Int64()), int(expLength.Int64()), int(modLength.Int64())
+
+	// Deduct gas
+	expHeadLen := expLen
+	if expHeadLen > 32 {
+		expHeadLen = 32
+	}
+	expHead := new(big.Int).SetBytes(paddedSlice(input, 96+baseLen, expHeadLen))
+	maxLen := baseLen
+	if modLen > maxLen {
+		maxLen = modLen
+	}
+	bigGasRequired := new(big.Int).SetUint64(modexpMultComplexity(uint64(maxLen)))
+	bigGasRequired.Mul(bigGasRequired, new(big.Int).SetUint64(modexpAdjustedExpLength(uint64(expLen), expHead)))
+	bigGasRequired.Div(bigGasRequired, new(big.Int).SetUint64(gasSchedule.ModExpQuadDivisor))
+	if !bigGasRequired.IsUint64() {
+		return nil, errors.ErrorCodeInsufficientGas
+	}
+	gasRequired := bigGasRequired.Uint64()
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+
+	if modLen == 0 {
+		return []byte{}, nil
+	}
+	base := new(big.Int).SetBytes(paddedSlice(input, 96, baseLen))
+	exp := new(big.Int).SetBytes(paddedSlice(input, 96+baseLen, expLen))
+	mod := new(big.Int).SetBytes(paddedSlice(input, 96+baseLen+expLen, modLen))
+	if mod.Sign() == 0 {
+		return make([]byte, modLen), nil
+	}
+	return LeftPadBytes(new(big.Int).Exp(base, exp, mod).Bytes(), modLen), nil
+}
+
+// Returns the length bytes of data from offset treating data as if it were right-padded with infinite zeroes
+func paddedSlice(data []byte, offset, length int) []byte {
+	if offset > len(data) {
+		offset = len(data)
+	}
+	end := offset + length
+	if end > len(data) {
+		end = len(data)
+	}
+	return RightPadBytes(data[offset:end], length)
+}
+
+func modexpMultComplexity(x uint64) uint64 {
+	switch {
+	case x <= 64:
+		return x * x
+	case x <= 1024:
+		return x*x/4 + 96*x - 3072
+	default:
+		return x*x/16 + 480*x - 199680
+	}
+}
+
+// Returns the adjusted exponent length, or 1 if that would be zero
+func modexpAdjustedExpLength(expLength uint64, expHead *big.Int) uint64 {
+	var adjusted uint64
+	if bitLength := uint64(expHead.BitLen()); bitLength > 0 {
+		adjusted = bitLength - 1
+	}
+	if expLength > 32 {
+		adjusted += 8 * (expLength - 32)
+	}
+	if adjusted == 0 {
+		return 1
+	}
+	return adjusted
+}
+
+// See EIP-196: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-196.md
+func bn256AddFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := gasSchedule.Bn256Add
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	input = RightPadBytes(input, 128)
+	x, err := unmarshalG1(input[:64])
+	if err != nil {
+		return nil, err
+	}
+	y, err := unmarshalG1(input[64:128])
+	if err != nil {
+		return nil, err
+	}
+	return new(bn256.G1).Add(x, y).Marshal(), nil
+}
+
+// See EIP-196: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-196.md
+func bn256ScalarMulFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
+	// Deduct gas
+	gasRequired := gasSchedule.Bn256ScalarMul
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	input = RightPadBytes(input, 96)
+	p, err := unmarshalG1(input[:64])
+	if err != nil {
+		return nil, err
+	}
+	return new(bn256.G1).ScalarMult(p, new(big.Int).SetBytes(input[64:96])).Marshal(), nil
+}
+
+// See EIP-197: https://github.com/ethereum/EIPs/blob/master/EIPS/eip-197.md
+func bn256PairingFunc(state state.ReaderWriter, caller acm.Account, input []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
+	// Input is a sequence of (G1, G2) points which are 64 and 128 bytes respectively
+	const pairLength = 192
+	if len(input)%pairLength != 0 {
+		return nil, fmt.Errorf("bn256 pairing input must be a multiple of %d bytes but has length %d",
+			pairLength, len(input))
+	}
+	// Deduct gas
+	gasRequired := uint64(len(input)/pairLength)*gasSchedule.Bn256PairingPerPoint + gasSchedule.Bn256PairingBase
+	if *gas < gasRequired {
+		return nil, errors.ErrorCodeInsufficientGas
+	} else {
+		*gas -= gasRequired
+	}
+	var g1s []*bn256.G1
+	var g2s []*bn256.G2
+	for i := 0; i < len(input); i += pairLength {
+		g1, err := unmarshalG1(input[i : i+64])
+		if err != nil {
+			return nil, err
+		}
+		g2, err := unmarshalG2(input[i+64 : i+pairLength])
+		if err != nil {
+			return nil, err
+		}
+		g1s = append(g1s, g1)
+		g2s = append(g2s, g2)
+	}
+	if bn256.PairingCheck(g1s, g2s) {
+		return One256.Bytes(), nil
+	}
+	return Zero256.Bytes(), nil
+}
+
+func unmarshalG1(bs []byte) (*bn256.G1, error) {
+	p := new(bn256.G1)
+	_, err := p.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func unmarshalG2(bs []byte) (*bn256.G2, error) {
+	p := new(bn256.G2)
+	_, err := p.Unmarshal(bs)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,113 @@
+package evm
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	. "github.com/hyperledger/burrow/binary"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmthrgd/go-hex"
+	bn256 "github.com/umbracle/go-eth-bn256"
+)
+
+func TestEcrecover(t *testing.T) {
+	privateKey, err := btcec.NewPrivateKey(btcec.S256())
+	require.NoError(t, err)
+	expected := LeftPadBytes(sha3.Sha3(privateKey.PubKey().SerializeUncompressed()[1:])[12:], 32)
+
+	hash := sha3.Sha3([]byte("message"))
+	sig, err := btcec.SignCompact(btcec.S256(), privateKey, hash, false)
+	require.NoError(t, err)
+
+	input := MustSplice(hash, LeftPadBytes(sig[:1], 32), sig[1:])
+	gas := uint64(1000)
+	output, err := ecrecoverFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, expected, output)
+
+	// An invalid v returns nothing
+	input[63] = 29
+	output, err = ecrecoverFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Empty(t, output)
+}
+
+func TestModexp(t *testing.T) {
+	// Example from EIP-198 (Fermat's little theorem)
+	input := hex.MustDecodeString("0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"03" +
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e" +
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2f")
+	gas := uint64(100000)
+	output, err := modexpFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, One256.Bytes(), output)
+
+	// A zero modulus gives zero
+	input = hex.MustDecodeString("0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"fffffffffffffffffffffffffffffffffffffffffffffffffffffffefffffc2e")
+	output, err = modexpFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+
+	// Lengths that cannot be paid for
+	input = hex.MustDecodeString("0000000000000000000000000000000000000000000000000000000000000000" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff")
+	_, err = modexpFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	assert.Error(t, err)
+}
+
+func TestBn256Add(t *testing.T) {
+	// G1 generator
+	g := hex.MustDecodeString("0000000000000000000000000000000000000000000000000000000000000001" +
+		"0000000000000000000000000000000000000000000000000000000000000002")
+	gas := uint64(1000)
+	output, err := bn256AddFunc(nil, nil, MustSplice(g, g), &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	expected := hex.MustDecodeString("030644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd3" +
+		"15ed738c0e0a7c92e7845f96b2ae9c0a68a6a449e3538fc7ff3ebf7a5a18a2c4")
+	assert.Equal(t, expected, output)
+
+	output, err = bn256ScalarMulFunc(nil, nil, MustSplice(g, Int64ToWord256(2)), &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, expected, output)
+
+	// Not on the curve
+	_, err = bn256AddFunc(nil, nil, MustSplice(g, g[:63], 3), &gas, schedule.BurrowLegacy(), logger)
+	assert.Error(t, err)
+}
+
+func TestBn256Pairing(t *testing.T) {
+	gas := uint64(1000)
+	// Empty input trivially satisfies the pairing check
+	output, err := bn256PairingFunc(nil, nil, nil, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, One256.Bytes(), output)
+
+	g1 := new(bn256.G1).ScalarBaseMult(big.NewInt(3))
+	g2 := new(bn256.G2).ScalarBaseMult(big.NewInt(5))
+	// e(3G, 5H) * e(-3G, 5H) == 1
+	input := MustSplice(g1.Marshal(), g2.Marshal(), new(bn256.G1).Neg(g1).Marshal(), g2.Marshal())
+	output, err = bn256PairingFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, One256.Bytes(), output)
+
+	// e(3G, 5H) * e(3G, 5H) != 1
+	input = MustSplice(g1.Marshal(), g2.Marshal(), g1.Marshal(), g2.Marshal())
+	output, err = bn256PairingFunc(nil, nil, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+
+	_, err = bn256PairingFunc(nil, nil, input[1:], &gas, schedule.BurrowLegacy(), logger)
+	assert.Error(t, err)
+}
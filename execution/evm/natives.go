@@ -0,0 +1,241 @@
+package evm
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/permission"
+)
+
+//
+// Natives are contracts implemented in Go outside of this package that are made callable from the EVM by a particular
+// VM (see WithNatives) rather than being registered globally like the precompiles and SNatives
+//
+
+var nativeContextType = reflect.TypeOf(NativeContext{})
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Passed as the first argument to each call of a NativeFunction
+type NativeContext struct {
+	State state.ReaderWriter
+	// Set when the contract is called from a read-only (STATICCALL) frame, in which case any write to State fails
+	ReadOnly bool
+	Caller   acm.Account
+	// The gas remaining to the call, which the function should reduce with UseGas for any work it does beyond that
+	// covered by NativeFunction.Gas
+	Gas    *uint64
+	Logger *logging.Logger
+}
+
+// Deducts amount from the gas remaining or returns an error if there is not enough
+func (ctx NativeContext) UseGas(amount uint64) error {
+	if *ctx.Gas < amount {
+		return errors.ErrorCodeInsufficientGas
+	}
+	*ctx.Gas -= amount
+	return nil
+}
+
+type NativeFunction struct {
+	// Comment describing function's purpose, parameters, and return value
+	Comment string
+	// Function name (used to form signature)
+	Name string
+	// Permission the caller must have to call the function, or zero if none is required
+	PermFlag permission.PermFlag
+	// Gas charged for each call before the function is run
+	Gas uint64
+	// A Go function of the form func(NativeContext, args...) (rets..., error) whose arguments and return values have
+	// types supported by abi.EVMTypeFromReflect
+	F interface{}
+	// The abi, derived from F
+	Abi abi.FunctionSpec
+}
+
+// A contract implemented by a set of NativeFunctions
+type Native struct {
+	Comment string
+	// Name of the contract from which its address is derived as for SNatives
+	Name          string
+	functionsByID map[abi.FunctionID]*NativeFunction
+	functions     []*NativeFunction
+}
+
+// Create a native contract from a comment, name, and its functions
+func NewNative(comment, name string, functions ...*NativeFunction) (*Native, error) {
+	functionsByID := make(map[abi.FunctionID]*NativeFunction, len(functions))
+	for _, f := range functions {
+		spec, err := nativeFunctionSpec(f)
+		if err != nil {
+			return nil, fmt.Errorf("could not register function %s of native contract %s: %v", f.Name, name, err)
+		}
+		f.Abi = *spec
+		if otherF, ok := functionsByID[f.Abi.FunctionID]; ok {
+			return nil, fmt.Errorf("function with ID %x already defined: %s", f.Abi.FunctionID, otherF.Name)
+		}
+		functionsByID[f.Abi.FunctionID] = f
+	}
+	return &Native{
+		Comment:       comment,
+		Name:          name,
+		functionsByID: functionsByID,
+		functions:     functions,
+	}, nil
+}
+
+func nativeFunctionSpec(f *NativeFunction) (spec *abi.FunctionSpec, err error) {
+	v := reflect.ValueOf(f.F)
+	t := v.Type()
+	if t.Kind() != reflect.Func {
+		return nil, fmt.Errorf("F must be a function but is %v", t)
+	}
+	if t.NumIn() == 0 || t.In(0) != nativeContextType {
+		return nil, fmt.Errorf("first argument of F must be %v", nativeContextType)
+	}
+	if t.NumOut() == 0 || t.Out(t.NumOut()-1) != errorType {
+		return nil, fmt.Errorf("last return value of F must be %v", errorType)
+	}
+	// SpecFromFunctionReflect panics on types it cannot map
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return abi.SpecFromFunctionReflect(f.Name, v, 1, 1), nil
+}
+
+// The address of the contract is the last 20 bytes of the sha3 hash of its name
+func (native *Native) Address() crypto.Address {
+	return addressFromName(native.Name)
+}
+
+// Get functions in order of declaration
+func (native *Native) Functions() []*NativeFunction {
+	functions := make([]*NativeFunction, len(native.functions))
+	copy(functions, native.functions)
+	return functions
+}
+
+// Dispatches a call from the EVM to the function selected by the first 4 bytes of args. Has the signature of
+// NativeContract.
+func (native *Native) Dispatch(state state.ReaderWriter, caller acm.Account, args []byte, gas *uint64,
+	gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
+
+	logger = logger.With(structure.ScopeKey, "Dispatch", "contract_name", native.Name)
+
+	if len(args) < abi.FunctionIDSize {
+		return nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
+			"native contract dispatch requires a 4-byte function identifier but arguments are only %v bytes long",
+			len(args))
+	}
+	var id abi.FunctionID
+	copy(id[:], args)
+	function, ok := native.functionsByID[id]
+	if !ok {
+		return nil, errors.ErrorCodef(errors.ErrorCodeNativeFunction,
+			"unknown function with ID %x in native contract %s", id, native.Name)
+	}
+
+	logger.TraceMsg("Dispatching to function",
+		"caller", caller.Address(),
+		"function_name", function.Name)
+
+	if function.PermFlag != 0 && !HasPermission(state, caller, function.PermFlag) {
+		return nil, errors.LacksSNativePermission{Address: caller.Address(), SNative: function.Name}
+	}
+	_, readOnly := state.(readOnlyState)
+	ctx := NativeContext{
+		State:    state,
+		ReadOnly: readOnly,
+		Caller:   caller,
+		Gas:      gas,
+		Logger:   logger,
+	}
+	err = ctx.UseGas(function.Gas)
+	if err != nil {
+		return nil, err
+	}
+
+	fv := reflect.ValueOf(function.F)
+	in := make([]reflect.Value, len(function.Abi.Inputs)+1)
+	in[0] = reflect.ValueOf(ctx)
+	ptrs := make([]interface{}, len(function.Abi.Inputs))
+	for i := range ptrs {
+		ptr := reflect.New(fv.Type().In(i + 1))
+		ptrs[i] = ptr.Interface()
+		in[i+1] = ptr.Elem()
+	}
+	err = abi.Unpack(function.Abi.Inputs, args[abi.FunctionIDSize:], ptrs...)
+	if err != nil {
+		return nil, err
+	}
+
+	out := fv.Call(in)
+	if errOut := out[len(out)-1]; !errOut.IsNil() {
+		return nil, errOut.Interface().(error)
+	}
+	rets := make([]interface{}, len(out)-1)
+	for i := range rets {
+		rets[i] = out[i].Interface()
+	}
+	return abi.Pack(function.Abi.Outputs, rets...)
+}
+
+// A set of native contracts indexed by address
+type Natives struct {
+	byAddress map[crypto.Address]*Native
+}
+
+// Collects natives, which must have distinct addresses that are not taken by the precompiles or SNatives
+func NewNatives(natives ...*Native) (*Natives, error) {
+	ns := &Natives{
+		byAddress: make(map[crypto.Address]*Native, len(natives)),
+	}
+	for _, native := range natives {
+		address := native.Address()
+		if IsRegisteredNativeContract(address.Word256()) {
+			return nil, fmt.Errorf("cannot add native contract %s because its address %v is reserved",
+				native.Name, address)
+		}
+		if other, ok := ns.byAddress[address]; ok {
+			return nil, fmt.Errorf("cannot add native contract %s because its address %v is taken by %s",
+				native.Name, address, other.Name)
+		}
+		ns.byAddress[address] = native
+	}
+	return ns, nil
+}
+
+// Returns the native contract at address or nil if there is none
+func (ns *Natives) Get(address crypto.Address) *Native {
+	if ns == nil {
+		return nil
+	}
+	return ns.byAddress[address]
+}
+
+// The native contracts in address order
+func (ns *Natives) Natives() []*Native {
+	if ns == nil {
+		return nil
+	}
+	addresses := make(crypto.Addresses, 0, len(ns.byAddress))
+	for address := range ns.byAddress {
+		addresses = append(addresses, address)
+	}
+	sort.Sort(addresses)
+	natives := make([]*Native, len(addresses))
+	for i, address := range addresses {
+		natives[i] = ns.byAddress[address]
+	}
+	return natives
+}
@@ -0,0 +1,169 @@
+package evm
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNative(t *testing.T) {
+	_, err := NewNative("", "Bad", &NativeFunction{Name: "notAFunction", F: 1})
+	assert.Error(t, err)
+	_, err = NewNative("", "Bad", &NativeFunction{Name: "noContext", F: func(x uint64) error { return nil }})
+	assert.Error(t, err)
+	_, err = NewNative("", "Bad", &NativeFunction{Name: "noError", F: func(ctx NativeContext) {}})
+	assert.Error(t, err)
+	_, err = NewNative("", "Bad", &NativeFunction{Name: "unsupported",
+		F: func(ctx NativeContext, x float64) error { return nil }})
+	assert.Error(t, err)
+
+	native, err := NewNative("A comment", "Good", &NativeFunction{Name: "double",
+		F: func(ctx NativeContext, x uint64, who crypto.Address) (uint64, error) { return 2 * x, nil }})
+	require.NoError(t, err)
+	require.Len(t, native.Functions(), 1)
+	assert.Equal(t, "double(uint64,address)", abi.Signature("double", native.Functions()[0].Abi.Inputs))
+	assert.Equal(t, addressFromName("Good"), native.Address())
+
+	_, err = NewNatives(native, native)
+	assert.Error(t, err)
+}
+
+func TestNative_Dispatch(t *testing.T) {
+	st := newAppState()
+	caller := acm.ConcreteAccount{Address: crypto.Address{1, 1, 1}}.MutableAccount()
+	native, err := NewNative("", "Registry", &NativeFunction{
+		Name:     "register",
+		PermFlag: permission.Root,
+		Gas:      10,
+		F: func(ctx NativeContext, who crypto.Address, n uint64) (bool, uint64, error) {
+			err := ctx.UseGas(n)
+			if err != nil {
+				return false, 0, err
+			}
+			return who == ctx.Caller.Address(), 2 * n, nil
+		},
+	})
+	require.NoError(t, err)
+	function := native.Functions()[0]
+	input, err := abi.Pack(function.Abi.Inputs, caller.Address(), uint64(3))
+	require.NoError(t, err)
+	input = append(function.Abi.FunctionID[:], input...)
+
+	gas := uint64(100)
+	_, err = native.Dispatch(st, caller, input, &gas, schedule.BurrowLegacy(), logger)
+	assert.IsType(t, errors.LacksSNativePermission{}, err)
+
+	caller.SetPermissions(allAccountPermissions())
+	output, err := native.Dispatch(st, caller, input, &gas, schedule.BurrowLegacy(), logger)
+	require.NoError(t, err)
+	expected, err := abi.Pack(function.Abi.Outputs, true, uint64(6))
+	require.NoError(t, err)
+	assert.Equal(t, expected, output)
+	assert.Equal(t, uint64(100-10-3), gas)
+
+	gas = 12
+	_, err = native.Dispatch(st, caller, input, &gas, schedule.BurrowLegacy(), logger)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, err)
+}
+
+func TestVM_WithNatives(t *testing.T) {
+	native, err := NewNative("", "Adder", &NativeFunction{
+		Name: "add",
+		F: func(ctx NativeContext, x, y uint64) (uint64, error) {
+			return x + y, nil
+		},
+	})
+	require.NoError(t, err)
+	natives, err := NewNatives(native)
+	require.NoError(t, err)
+	function := native.Functions()[0]
+	input, err := abi.Pack(function.Abi.Inputs, uint64(2), uint64(3))
+	require.NoError(t, err)
+	input = append(function.Abi.FunctionID[:], input...)
+
+	// Forward the call data to the native contract and return its output
+	code := MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+		PUSH1, 32, PUSH1, 0, CALLDATASIZE, PUSH1, 0, PUSH1, 0, PUSH20, native.Address(), GAS, CALL,
+		POP, PUSH1, 32, PUSH1, 0, RETURN)
+	call := func(vm *VM) []byte {
+		cache := state.NewCache(newAppState())
+		caller, callee := newAccount(1), newAccount(2)
+		require.NoError(t, cache.UpdateAccount(caller))
+		require.NoError(t, cache.UpdateAccount(callee))
+		gas := uint64(100000)
+		output, err := vm.Call(cache, caller, callee, code, input, 0, &gas)
+		require.NoError(t, err)
+		return output
+	}
+
+	expected, err := abi.Pack(function.Abi.Outputs, uint64(5))
+	require.NoError(t, err)
+	assert.Equal(t, expected, call(NewVM(newParams(), crypto.ZeroAddress, nil, logger, WithNatives(natives))))
+	assert.NotEqual(t, expected, call(NewVM(newParams(), crypto.ZeroAddress, nil, logger)))
+}
+
+func TestVM_WithNatives_StaticCall(t *testing.T) {
+	var readOnly bool
+	native, err := NewNative("", "Counter", &NativeFunction{
+		Name: "increment",
+		F: func(ctx NativeContext, key uint64) (uint64, error) {
+			readOnly = ctx.ReadOnly
+			value, err := ctx.State.GetStorage(ctx.Caller.Address(), Uint64ToWord256(key))
+			if err != nil {
+				return 0, err
+			}
+			n := Uint64FromWord256(value) + 1
+			err = ctx.State.SetStorage(ctx.Caller.Address(), Uint64ToWord256(key), Uint64ToWord256(n))
+			if err != nil {
+				return 0, err
+			}
+			return n, nil
+		},
+	})
+	require.NoError(t, err)
+	natives, err := NewNatives(native)
+	require.NoError(t, err)
+	function := native.Functions()[0]
+	input, err := abi.Pack(function.Abi.Inputs, uint64(7))
+	require.NoError(t, err)
+	input = append(function.Abi.FunctionID[:], input...)
+
+	st := newAppState()
+	caller, callee := newAccount(1), newAccount(2)
+	require.NoError(t, st.UpdateAccount(caller))
+	require.NoError(t, st.UpdateAccount(callee))
+	cache := state.NewCache(st)
+	vm := NewVM(newParams(), crypto.ZeroAddress, nil, logger, WithNatives(natives))
+	// Forward the call data to the native contract and return the success flag
+	call := func(callOp []byte) []byte {
+		gas := uint64(100000)
+		output, err := vm.Call(cache, caller, callee, MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY,
+			PUSH1, 32, PUSH1, 0, CALLDATASIZE, PUSH1, 0, callOp, PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN),
+			input, 0, &gas)
+		require.NoError(t, err)
+		return output
+	}
+
+	assert.Equal(t, Zero256.Bytes(), call(MustSplice(PUSH20, native.Address(), GAS, STATICCALL)))
+	assert.True(t, readOnly)
+	value, err := cache.GetStorage(callee.Address(), Uint64ToWord256(7))
+	require.NoError(t, err)
+	assert.Equal(t, Zero256, value)
+
+	assert.Equal(t, One256.Bytes(), call(MustSplice(PUSH1, 0, PUSH20, native.Address(), GAS, CALL)))
+	assert.False(t, readOnly)
+	value, err = cache.GetStorage(callee.Address(), Uint64ToWord256(7))
+	require.NoError(t, err)
+	assert.Equal(t, One256, value)
+}
@@ -13,3 +13,16 @@ func DebugOpcodes(vm *VM) {
 func DumpTokens(vm *VM) {
 	vm.dumpTokens = true
 }
+
+func TraceWith(tracer Tracer) func(*VM) {
+	return func(vm *VM) {
+		vm.tracer = tracer
+	}
+}
+
+// Make natives callable from the VM in addition to the globally registered native contracts
+func WithNatives(natives *Natives) func(*VM) {
+	return func(vm *VM) {
+		vm.natives = natives
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2017 Monax Industries Limited
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule defines the gas costs charged by the EVM. It has no dependencies on the rest of execution so that
+// a schedule can be recorded in the GenesisDoc, which is what all validators must agree on.
+package schedule
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// The flat costs burrow has always charged, kept for existing chains
+	BurrowLegacyName = "burrow-legacy"
+	// An approximation of the Ethereum Istanbul costs for the operations that burrow meters
+	EthereumIstanbulName = "ethereum-istanbul"
+)
+
+// The gas cost of each metered operation of the EVM and its native contracts. Costs that are zero are not charged.
+type GasSchedule struct {
+	// Charged for every instruction executed
+	BaseOp uint64
+	// Charged for every push to or pop from the data stack
+	StackOp uint64
+	// SHA3 and hashing of init code by CREATE2
+	Sha3     uint64
+	Sha3Word uint64
+	// Charged whenever another account is loaded: BALANCE, EXTCODE*, CALL targets, and SELFDESTRUCT beneficiaries
+	GetAccount uint64
+	// SLOAD
+	StorageRead uint64
+	// SSTORE
+	StorageUpdate uint64
+	// CREATE, CREATE2, and implicit account creation by SELFDESTRUCT
+	CreateAccount uint64
+	// Charged per byte of code returned by init code
+	CreateByte uint64
+	// The CALL family of instructions
+	Call uint64
+	// Additional cost of a CALL that transfers value
+	CallValue uint64
+	// LOG0-4
+	Log      uint64
+	LogTopic uint64
+	LogByte  uint64
+
+	// Native contracts
+	EcRecover            uint64
+	Sha256Base           uint64
+	Sha256Word           uint64
+	Ripemd160Base        uint64
+	Ripemd160Word        uint64
+	IdentityBase         uint64
+	IdentityWord         uint64
+	ModExpQuadDivisor    uint64
+	Bn256Add             uint64
+	Bn256ScalarMul       uint64
+	Bn256PairingBase     uint64
+	Bn256PairingPerPoint uint64
+}
+
+var presets = map[string]func() *GasSchedule{
+	BurrowLegacyName:     BurrowLegacy,
+	EthereumIstanbulName: EthereumIstanbul,
+}
+
+func BurrowLegacy() *GasSchedule {
+	return &GasSchedule{
+		BaseOp:        0,
+		StackOp:       1,
+		Sha3:          1,
+		GetAccount:    1,
+		StorageUpdate: 1,
+		CreateAccount: 1,
+
+		EcRecover:            1,
+		Sha256Base:           1,
+		Sha256Word:           1,
+		Ripemd160Base:        1,
+		Ripemd160Word:        1,
+		IdentityBase:         1,
+		IdentityWord:         1,
+		ModExpQuadDivisor:    20,
+		Bn256Add:             1,
+		Bn256ScalarMul:       1,
+		Bn256PairingBase:     1,
+		Bn256PairingPerPoint: 1,
+	}
+}
+
+// We do not distinguish between Ethereum's instruction tiers so BaseOp charges the 'very low' tier for everything, and
+// storage updates are always charged at the cost of setting a fresh slot (there are no refunds)
+func EthereumIstanbul() *GasSchedule {
+	return &GasSchedule{
+		BaseOp:        3,
+		StackOp:       0,
+		Sha3:          30,
+		Sha3Word:      6,
+		GetAccount:    700,
+		StorageRead:   800,
+		StorageUpdate: 20000,
+		CreateAccount: 32000,
+		CreateByte:    200,
+		Call:          700,
+		CallValue:     9000,
+		Log:           375,
+		LogTopic:      375,
+		LogByte:       8,
+
+		EcRecover:            3000,
+		Sha256Base:           60,
+		Sha256Word:           12,
+		Ripemd160Base:        600,
+		Ripemd160Word:        120,
+		IdentityBase:         15,
+		IdentityWord:         3,
+		ModExpQuadDivisor:    20,
+		Bn256Add:             150,
+		Bn256ScalarMul:       6000,
+		Bn256PairingBase:     45000,
+		Bn256PairingPerPoint: 34000,
+	}
+}
+
+// Returns a fresh copy of the preset schedule called name
+func Named(name string) (*GasSchedule, error) {
+	preset, ok := presets[name]
+	if !ok {
+		return nil, fmt.Errorf("gas schedule '%s' not recognised, expected one of: %v", name, Names())
+	}
+	return preset(), nil
+}
+
+// Names of the preset schedules
+func Names() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Checks the schedule can be used by the EVM
+func (gs *GasSchedule) Validate() error {
+	if gs.ModExpQuadDivisor == 0 {
+		return fmt.Errorf("gas schedule must have a non-zero ModExpQuadDivisor")
+	}
+	return nil
+}
+
+// Returns gasSchedule or the legacy schedule if it is nil (as it will be for chains whose genesis predates gas
+// schedules)
+func OrLegacy(gasSchedule *GasSchedule) *GasSchedule {
+	if gasSchedule == nil {
+		return BurrowLegacy()
+	}
+	return gasSchedule
+}
+
+// Returns the cost of hashing or copying size bytes at perWord per 32-byte word plus base
+func WordCost(base, perWord, size uint64) uint64 {
+	return base + (size+31)/32*perWord
+}
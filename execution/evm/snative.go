@@ -25,6 +25,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
@@ -214,7 +215,7 @@ func NewSNativeContract(comment, name string,
 // has been selected. It is also placed in a registry by registerSNativeContracts
 // So it can be looked up by SNative address
 func (contract *SNativeContractDescription) Dispatch(state state.ReaderWriter, caller acm.Account,
-	args []byte, gas *uint64, logger *logging.Logger) (output []byte, err error) {
+	args []byte, gas *uint64, gasSchedule *schedule.GasSchedule, logger *logging.Logger) (output []byte, err error) {
 
 	logger = logger.With(structure.ScopeKey, "Dispatch", "contract_name", contract.Name)
 
@@ -258,8 +259,12 @@ func (contract *SNativeContractDescription) Dispatch(state state.ReaderWriter, c
 
 // We define the address of an SNative contact as the last 20 bytes of the sha3
 // hash of its name
-func (contract *SNativeContractDescription) Address() (address crypto.Address) {
-	hash := sha3.Sha3([]byte(contract.Name))
+func (contract *SNativeContractDescription) Address() crypto.Address {
+	return addressFromName(contract.Name)
+}
+
+func addressFromName(name string) (address crypto.Address) {
+	hash := sha3.Sha3([]byte(name))
 	copy(address[:], hash[len(hash)-crypto.AddressLength:])
 	return
 }
@@ -374,7 +379,10 @@ func setBase(stateWriter state.ReaderWriter, caller acm.Account, gas *uint64,
 	if err = acc.MutablePermissions().Base.Set(permN, args.Set); err != nil {
 		return 0, err
 	}
-	stateWriter.UpdateAccount(acc)
+	err = stateWriter.UpdateAccount(acc)
+	if err != nil {
+		return 0, err
+	}
 	logger.Trace.Log("function", "setBase", "address", args.Account.String(),
 		"permission_flag", fmt.Sprintf("%b", permN),
 		"permission_value", args.Permission)
@@ -408,7 +416,10 @@ func unsetBase(stateWriter state.ReaderWriter, caller acm.Account, gas *uint64,
 	if err = acc.MutablePermissions().Base.Unset(permN); err != nil {
 		return 0, err
 	}
-	stateWriter.UpdateAccount(acc)
+	err = stateWriter.UpdateAccount(acc)
+	if err != nil {
+		return 0, err
+	}
 	logger.Trace.Log("function", "unsetBase", "address", args.Account.String(),
 		"perm_flag", fmt.Sprintf("%b", permN),
 		"permission_flag", fmt.Sprintf("%b", permN))
@@ -444,7 +455,10 @@ func setGlobal(stateWriter state.ReaderWriter, caller acm.Account, gas *uint64,
 	if err = acc.MutablePermissions().Base.Set(permN, args.Set); err != nil {
 		return 0, err
 	}
-	stateWriter.UpdateAccount(acc)
+	err = stateWriter.UpdateAccount(acc)
+	if err != nil {
+		return 0, err
+	}
 	logger.Trace.Log("function", "setGlobal",
 		"permission_flag", fmt.Sprintf("%b", permN),
 		"permission_value", args.Set)
@@ -498,7 +512,10 @@ func addRole(stateWriter state.ReaderWriter, caller acm.Account, gas *uint64,
 		return nil, fmt.Errorf("unknown account %s", args.Account)
 	}
 	roleAdded := acc.MutablePermissions().AddRole(args.Role)
-	stateWriter.UpdateAccount(acc)
+	err = stateWriter.UpdateAccount(acc)
+	if err != nil {
+		return nil, err
+	}
 	logger.Trace.Log("function", "addRole", "address", args.Account.String(),
 		"role", args.Role,
 		"role_added", roleAdded)
@@ -526,7 +543,10 @@ func removeRole(stateWriter state.ReaderWriter, caller acm.Account, gas *uint64,
 		return false, fmt.Errorf("unknown account %s", args.Account)
 	}
 	roleRemoved := acc.MutablePermissions().RmRole(args.Role)
-	stateWriter.UpdateAccount(acc)
+	err = stateWriter.UpdateAccount(acc)
+	if err != nil {
+		return false, err
+	}
 	logger.Trace.Log("function", "removeRole", "address", args.Account.String(),
 		"role", args.Role,
 		"role_removed", roleRemoved)
@@ -26,6 +26,7 @@ import (
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/stretchr/testify/assert"
@@ -83,7 +84,7 @@ func TestSNativeContractDescription_Dispatch(t *testing.T) {
 
 	// Should fail since we have no permissions
 	retValue, err := contract.Dispatch(state, caller, bc.MustSplice(funcID[:],
-		grantee.Address(), permFlagToWord256(permission.CreateAccount)), &gas, logger)
+		grantee.Address(), permFlagToWord256(permission.CreateAccount)), &gas, schedule.BurrowLegacy(), logger)
 	if !assert.Error(t, err, "Should fail due to lack of permissions") {
 		return
 	}
@@ -92,7 +93,7 @@ func TestSNativeContractDescription_Dispatch(t *testing.T) {
 	// Grant all permissions and dispatch should success
 	caller.SetPermissions(allAccountPermissions())
 	retValue, err = contract.Dispatch(state, caller, bc.MustSplice(funcID[:],
-		grantee.Address().Word256(), permFlagToWord256(permission.CreateAccount)), &gas, logger)
+		grantee.Address().Word256(), permFlagToWord256(permission.CreateAccount)), &gas, schedule.BurrowLegacy(), logger)
 	assert.NoError(t, err)
 	assert.Equal(t, retValue, LeftPadBytes([]byte{1}, 32))
 }
@@ -28,20 +28,26 @@ type Stack struct {
 	data []Word256
 	ptr  int
 
-	gas *uint64
-	err *errors.CodedError
+	gasPerOp uint64
+	gas      *uint64
+	err      *errors.CodedError
 }
 
-func NewStack(capacity int, gas *uint64, err *errors.CodedError) *Stack {
+// gasPerOp is charged to gas for each push or pop
+func NewStack(capacity int, gasPerOp uint64, gas *uint64, err *errors.CodedError) *Stack {
 	return &Stack{
-		data: make([]Word256, capacity),
-		ptr:  0,
-		gas:  gas,
-		err:  err,
+		data:     make([]Word256, capacity),
+		ptr:      0,
+		gasPerOp: gasPerOp,
+		gas:      gas,
+		err:      err,
 	}
 }
 
 func (st *Stack) useGas(gasToUse uint64) {
+	if gasToUse == 0 {
+		return
+	}
 	if *st.gas > gasToUse {
 		*st.gas -= gasToUse
 	} else {
@@ -56,7 +62,7 @@ func (st *Stack) setErr(err errors.CodedError) {
 }
 
 func (st *Stack) Push(d Word256) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr == cap(st.data) {
 		st.setErr(errors.ErrorCodeDataStackOverflow)
 		return
@@ -91,7 +97,7 @@ func (st *Stack) PushBigInt(bigInt *big.Int) Word256 {
 // Pops
 
 func (st *Stack) Pop() Word256 {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr == 0 {
 		st.setErr(errors.ErrorCodeDataStackUnderflow)
 		return Zero256
@@ -134,7 +140,7 @@ func (st *Stack) Len() int {
 }
 
 func (st *Stack) Swap(n int) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.setErr(errors.ErrorCodeDataStackUnderflow)
 		return
@@ -143,7 +149,7 @@ func (st *Stack) Swap(n int) {
 }
 
 func (st *Stack) Dup(n int) {
-	st.useGas(GasStackOp)
+	st.useGas(st.gasPerOp)
 	if st.ptr < n {
 		st.setErr(errors.ErrorCodeDataStackUnderflow)
 		return
@@ -151,6 +157,13 @@ func (st *Stack) Dup(n int) {
 	st.Push(st.data[st.ptr-n])
 }
 
+// Returns a copy of the words on the stack from bottom to top. Not an opcode, costs no gas.
+func (st *Stack) Words() []Word256 {
+	words := make([]Word256, st.ptr)
+	copy(words, st.data[:st.ptr])
+	return words
+}
+
 // Not an opcode, costs no gas.
 func (st *Stack) Peek() Word256 {
 	if st.ptr == 0 {
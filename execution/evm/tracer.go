@@ -0,0 +1,172 @@
+package evm
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// Receives callbacks from the VM as it executes. The stack and memory passed to Step belong to the VM and must not be
+// retained beyond the call.
+type Tracer interface {
+	// Called before each instruction is executed (and before any gas is charged for it)
+	Step(depth uint64, address crypto.Address, pc int64, op OpCode, gas uint64, stack *Stack, memory Memory)
+	// Called when a call frame begins executing code
+	CallEnter(depth uint64, caller, callee crypto.Address, input []byte, value, gas uint64)
+	// Called when a call frame returns with the gas it has remaining
+	CallExit(depth uint64, output []byte, gas uint64, err errors.CodedError)
+	StorageRead(address crypto.Address, key, value Word256)
+	StorageWrite(address crypto.Address, key, value Word256)
+	Log(log *exec.LogEvent)
+}
+
+// Implements Tracer by recording the machine state at each step in the format of geth's struct logger
+type StructLogger struct {
+	config *exec.TraceConfig
+	trace  *exec.Trace
+	// The depth of the most recent step
+	depth uint64
+	// Index into StructLogs of the most recent step at each depth of the call stack (to fill in its GasCost)
+	lastSteps map[uint64]int
+	// The gas available to the outermost call
+	startGas uint64
+	storage  map[crypto.Address]map[Word256]Word256
+}
+
+var _ Tracer = &StructLogger{}
+
+// config may be nil to trace everything
+func NewStructLogger(config *exec.TraceConfig) *StructLogger {
+	if config == nil {
+		config = new(exec.TraceConfig)
+	}
+	return &StructLogger{
+		config:    config,
+		trace:     new(exec.Trace),
+		lastSteps: make(map[uint64]int),
+		storage:   make(map[crypto.Address]map[Word256]Word256),
+	}
+}
+
+// Returns the trace recorded so far
+func (sl *StructLogger) Trace() *exec.Trace {
+	return sl.trace
+}
+
+func (sl *StructLogger) Step(depth uint64, address crypto.Address, pc int64, op OpCode, gas uint64, stack *Stack,
+	memory Memory) {
+
+	sl.setGasCost(depth, gas)
+	structLog := &exec.StructLog{
+		PC:    uint64(pc),
+		Op:    op.Name(),
+		Gas:   gas,
+		Depth: depth,
+	}
+	if !sl.config.DisableStack {
+		words := stack.Words()
+		structLog.Stack = make([]string, len(words))
+		for i, word := range words {
+			structLog.Stack[i] = hex.EncodeToString(word.Bytes())
+		}
+	}
+	if !sl.config.DisableMemory {
+		structLog.Memory = memoryWords(memory)
+	}
+	sl.depth = depth
+	sl.lastSteps[depth] = len(sl.trace.StructLogs)
+	sl.trace.StructLogs = append(sl.trace.StructLogs, structLog)
+}
+
+func (sl *StructLogger) CallEnter(depth uint64, caller, callee crypto.Address, input []byte, value, gas uint64) {
+	if depth == 1 {
+		sl.startGas = gas
+	}
+}
+
+func (sl *StructLogger) CallExit(depth uint64, output []byte, gas uint64, err errors.CodedError) {
+	if i, ok := sl.lastSteps[depth]; ok && err != nil {
+		sl.trace.StructLogs[i].Error = err.Error()
+	}
+	sl.setGasCost(depth, gas)
+	delete(sl.lastSteps, depth)
+	if depth == 1 {
+		sl.trace.Gas = sl.startGas - gas
+		sl.trace.Failed = err != nil
+		sl.trace.ReturnValue = hex.EncodeToString(output)
+	}
+}
+
+func (sl *StructLogger) StorageRead(address crypto.Address, key, value Word256) {
+	sl.recordStorage(address, key, value)
+}
+
+func (sl *StructLogger) StorageWrite(address crypto.Address, key, value Word256) {
+	sl.recordStorage(address, key, value)
+}
+
+func (sl *StructLogger) Log(log *exec.LogEvent) {
+}
+
+// The gas cost of the previous step at depth is the difference between the gas it had and the gas we have now
+func (sl *StructLogger) setGasCost(depth, gas uint64) {
+	if i, ok := sl.lastSteps[depth]; ok {
+		sl.trace.StructLogs[i].GasCost = sl.trace.StructLogs[i].Gas - gas
+	}
+}
+
+// Attaches the storage of address accessed so far to the current (SLOAD or SSTORE) step
+func (sl *StructLogger) recordStorage(address crypto.Address, key, value Word256) {
+	if sl.config.DisableStorage {
+		return
+	}
+	storage, ok := sl.storage[address]
+	if !ok {
+		storage = make(map[Word256]Word256)
+		sl.storage[address] = storage
+	}
+	storage[key] = value
+	i, ok := sl.lastSteps[sl.depth]
+	if !ok {
+		return
+	}
+	structLog := sl.trace.StructLogs[i]
+	structLog.Storage = make(map[string]string, len(storage))
+	for k, v := range storage {
+		structLog.Storage[hex.EncodeToString(k.Bytes())] = hex.EncodeToString(v.Bytes())
+	}
+}
+
+// Returns memory as hex-encoded 32-byte words omitting any trailing words of zeroes (since our memory is allocated
+// up front there is no equivalent of Ethereum's active memory size)
+func memoryWords(memory Memory) []string {
+	var data []byte
+	if dm, ok := memory.(*dynamicMemory); ok {
+		// Avoid copying the entire allocation on every step
+		data = dm.slice
+	} else {
+		var err error
+		data, err = memory.Read(big.NewInt(0), memory.Capacity())
+		if err != nil {
+			return nil
+		}
+	}
+	end := len(data)
+	for end > 0 && data[end-1] == 0 {
+		end--
+	}
+	words := make([]string, 0, (end+31)/32)
+	for i := 0; i < end; i += 32 {
+		j := i + 32
+		if j > len(data) {
+			j = len(data)
+		}
+		words = append(words, hex.EncodeToString(RightPadBytes(data[i:j], 32)))
+	}
+	return words
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
@@ -37,6 +38,8 @@ import (
 const (
 	dataStackCapacity = 1024
 	callStackCapacity = 100 // TODO ensure usage.
+	// BLOCKHASH can only see this many blocks back from the current block
+	maxBlockHashDepth = 256
 )
 
 type EventSink interface {
@@ -49,16 +52,27 @@ type noopEventSink struct{}
 func (*noopEventSink) Call(call *exec.CallEvent, exception *errors.Exception) {}
 func (*noopEventSink) Log(log *exec.LogEvent)                                 {}
 
+// Access to the chain the VM is executing against, satisfied by bcm.BlockchainInfo
+type Blockchain interface {
+	ChainID() string
+	BlockHash(height uint64) ([]byte, error)
+}
+
 type Params struct {
 	BlockHeight uint64
 	BlockHash   Word256
 	BlockTime   int64
 	GasLimit    uint64
+	// May be nil, in which case BLOCKHASH and CHAINID return zero
+	Blockchain Blockchain
+	// May be nil, in which case the burrow-legacy schedule is used
+	GasSchedule *schedule.GasSchedule
 }
 
 type VM struct {
 	memoryProvider   func() Memory
 	params           Params
+	gasSchedule      *schedule.GasSchedule
 	origin           crypto.Address
 	tx               *txs.Tx
 	stackDepth       uint64
@@ -68,12 +82,18 @@ type VM struct {
 	returnData       []byte
 	debugOpcodes     bool
 	dumpTokens       bool
+	tracer           Tracer
+	// Set while executing a STATICCALL frame (and any frames nested within it)
+	readOnly bool
+	// Native contracts callable from this VM in addition to those registered globally
+	natives *Natives
 }
 
 func NewVM(params Params, origin crypto.Address, tx *txs.Tx, logger *logging.Logger, options ...func(*VM)) *VM {
 	vm := &VM{
 		memoryProvider: DefaultDynamicMemoryProvider,
 		params:         params,
+		gasSchedule:    schedule.OrLegacy(params.GasSchedule),
 		origin:         origin,
 		stackDepth:     0,
 		tx:             tx,
@@ -86,6 +106,17 @@ func NewVM(params Params, origin crypto.Address, tx *txs.Tx, logger *logging.Log
 	return vm
 }
 
+// Returns the native contract at address, either registered globally or with this VM, or nil if there is none
+func (vm *VM) nativeContract(address Word256) NativeContract {
+	if contract, ok := registeredNativeContracts[address]; ok {
+		return contract
+	}
+	if native := vm.natives.Get(crypto.AddressFromWord256(address)); native != nil {
+		return native.Dispatch
+	}
+	return nil
+}
+
 func (vm *VM) Debugf(format string, a ...interface{}) {
 	if vm.debugOpcodes {
 		vm.logger.TraceMsg(fmt.Sprintf(format, a...), "tag", "DebugOpcodes")
@@ -204,6 +235,21 @@ func (vm *VM) DelegateCall(callState *state.Cache, caller acm.Account, callee *a
 	return
 }
 
+// StaticCall is executed by the STATICCALL opcode, introduced in Ethereum Byzantium (EIP-214).
+// It behaves like a CALL with zero value except that the callee and any frames it calls are read-only:
+// any attempt to modify state (SSTORE, LOG*, CREATE, SELFDESTRUCT, or CALL with non-zero value) fails
+// with ErrorCodeIllegalWrite.
+func (vm *VM) StaticCall(callState *state.Cache, caller, callee *acm.MutableAccount, code, input []byte,
+	gas *uint64) (output []byte, err errors.CodedError) {
+
+	readOnly := vm.readOnly
+	vm.readOnly = true
+	defer func() {
+		vm.readOnly = readOnly
+	}()
+	return vm.Call(callState, caller, callee, code, input, 0, gas)
+}
+
 // Try to deduct gasToUse from gasLeft.  If ok return false, otherwise
 // set err and return true.
 func useGasNegative(gasLeft *uint64, gasToUse uint64, err *errors.CodedError) bool {
@@ -229,13 +275,23 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 
 	var (
 		pc     int64 = 0
-		stack        = NewStack(dataStackCapacity, gas, &err)
+		stack        = NewStack(dataStackCapacity, vm.gasSchedule.StackOp, gas, &err)
 		memory       = vm.memoryProvider()
 	)
 
+	if vm.tracer != nil {
+		vm.tracer.CallEnter(vm.stackDepth, caller.Address(), callee.Address(), input, value, *gas)
+		defer func() {
+			vm.tracer.CallExit(vm.stackDepth, output, *gas, err)
+		}()
+	}
+
 	for {
+		if vm.tracer != nil {
+			vm.tracer.Step(vm.stackDepth, callee.Address(), pc, codeGetOp(code, pc), *gas, stack, memory)
+		}
 		// Use BaseOp gas.
-		if useGasNegative(gas, GasBaseOp, &err) {
+		if useGasNegative(gas, vm.gasSchedule.BaseOp, &err) {
 			return nil, err
 		}
 
@@ -497,7 +553,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			}
 
 		case SHA3: // 0x20
-			if useGasNegative(gas, GasSha3, &err) {
+			if useGasNegative(gas, vm.gasSchedule.Sha3, &err) {
 				return nil, err
 			}
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
@@ -506,6 +562,9 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				vm.Debugf(" => Memory err: %s", memErr)
 				return nil, firstErr(err, errors.ErrorCodeMemoryOutOfBounds)
 			}
+			if useGasNegative(gas, schedule.WordCost(0, vm.gasSchedule.Sha3Word, uint64(len(data))), &err) {
+				return nil, err
+			}
 			data = sha3.Sha3(data)
 			stack.PushBytes(data)
 			vm.Debugf(" => (%v) %X\n", size, data)
@@ -516,7 +575,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 
 		case BALANCE: // 0x31
 			addr := stack.Pop()
-			if useGasNegative(gas, GasGetAccount, &err) {
+			if useGasNegative(gas, vm.gasSchedule.GetAccount, &err) {
 				return nil, err
 			}
 			acc, errAcc := callState.GetAccount(crypto.AddressFromWord256(addr))
@@ -612,7 +671,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 
 		case EXTCODESIZE: // 0x3B
 			addr := stack.Pop()
-			if useGasNegative(gas, GasGetAccount, &err) {
+			if useGasNegative(gas, vm.gasSchedule.GetAccount, &err) {
 				return nil, err
 			}
 			acc, errAcc := callState.GetAccount(crypto.AddressFromWord256(addr))
@@ -620,7 +679,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				return nil, firstErr(err, errAcc)
 			}
 			if acc == nil {
-				if _, ok := registeredNativeContracts[addr]; !ok {
+				if vm.nativeContract(addr) == nil {
 					return nil, firstErr(err, errors.ErrorCodeUnknownAddress)
 				}
 				vm.Debugf(" => returning code size of 1 to indicated existence of native contract at %X\n", addr)
@@ -633,7 +692,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			}
 		case EXTCODECOPY: // 0x3C
 			addr := stack.Pop()
-			if useGasNegative(gas, GasGetAccount, &err) {
+			if useGasNegative(gas, vm.gasSchedule.GetAccount, &err) {
 				return nil, err
 			}
 			acc, errAcc := callState.GetAccount(crypto.AddressFromWord256(addr))
@@ -641,7 +700,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				return nil, firstErr(err, errAcc)
 			}
 			if acc == nil {
-				if _, ok := registeredNativeContracts[addr]; ok {
+				if vm.nativeContract(addr) != nil {
 					vm.Debugf(" => attempted to copy native contract at %X but this is not supported\n", addr)
 					return nil, firstErr(err, errors.ErrorCodeNativeContractCodeCopy)
 				}
@@ -690,9 +749,27 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			}
 			vm.Debugf(" => [%v, %v, %v] %X\n", memOff, outputOff, length, data)
 
+		case EXTCODEHASH: // 0x3F
+			addr := stack.Pop()
+			if useGasNegative(gas, vm.gasSchedule.GetAccount, &err) {
+				return nil, err
+			}
+			acc, errAcc := callState.GetAccount(crypto.AddressFromWord256(addr))
+			if errAcc != nil {
+				return nil, firstErr(err, errAcc)
+			}
+			if acc == nil {
+				// Non-existent accounts hash to zero (EIP-1052)
+				stack.Push(Zero256)
+			} else {
+				stack.PushBytes(sha3.Sha3(acc.Code()))
+			}
+			vm.Debugf(" => 0x%X\n", stack.Peek().Bytes())
+
 		case BLOCKHASH: // 0x40
-			stack.Push(Zero256)
-			vm.Debugf(" => 0x%X (NOT SUPPORTED)\n", stack.Peek().Bytes())
+			blockNumber := stack.PopBigInt()
+			stack.Push(vm.blockHash(blockNumber))
+			vm.Debugf(" => 0x%X\n", stack.Peek().Bytes())
 
 		case COINBASE: // 0x41
 			stack.Push(Zero256)
@@ -712,6 +789,19 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			stack.PushU64(vm.params.GasLimit)
 			vm.Debugf(" => %v\n", vm.params.GasLimit)
 
+		case CHAINID: // 0x46
+			chainID := Zero256
+			if vm.params.Blockchain != nil {
+				chainID = LeftPadWord256(sha3.Sha3([]byte(vm.params.Blockchain.ChainID())))
+			}
+			stack.Push(chainID)
+			vm.Debugf(" => 0x%X\n", chainID.Bytes())
+
+		case SELFBALANCE: // 0x47
+			balance := callee.Balance()
+			stack.PushU64(balance)
+			vm.Debugf(" => %v (%v)\n", balance, callee.Address())
+
 		case POP: // 0x50
 			popped := stack.Pop()
 			vm.Debugf(" => 0x%X\n", popped)
@@ -751,19 +841,31 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 
 		case SLOAD: // 0x54
 			loc := stack.Pop()
+			if useGasNegative(gas, vm.gasSchedule.StorageRead, &err) {
+				return nil, err
+			}
 			data, errSto := callState.GetStorage(callee.Address(), loc)
 			if errSto != nil {
 				return nil, firstErr(err, errSto)
 			}
 			stack.Push(data)
+			if vm.tracer != nil {
+				vm.tracer.StorageRead(callee.Address(), loc, data)
+			}
 			vm.Debugf("%s {0x%X = 0x%X}\n", callee.Address(), loc, data)
 
 		case SSTORE: // 0x55
+			if vm.readOnly {
+				return nil, firstErr(err, errors.ErrorCodeIllegalWrite)
+			}
 			loc, data := stack.Pop(), stack.Pop()
-			if useGasNegative(gas, GasStorageUpdate, &err) {
+			if useGasNegative(gas, vm.gasSchedule.StorageUpdate, &err) {
 				return nil, err
 			}
 			callState.SetStorage(callee.Address(), loc, data)
+			if vm.tracer != nil {
+				vm.tracer.StorageWrite(callee.Address(), loc, data)
+			}
 			vm.Debugf("%s {0x%X := 0x%X}\n", callee.Address(), loc, data)
 
 		case JUMP: // 0x56
@@ -836,6 +938,9 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			//stack.Print(10)
 
 		case LOG0, LOG1, LOG2, LOG3, LOG4:
+			if vm.readOnly {
+				return nil, firstErr(err, errors.ErrorCodeIllegalWrite)
+			}
 			n := int(op - LOG0)
 			topics := make([]Word256, n)
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
@@ -847,16 +952,27 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				vm.Debugf(" => Memory err: %s", memErr)
 				return nil, firstErr(err, errors.ErrorCodeMemoryOutOfBounds)
 			}
-			vm.eventSink.Log(&exec.LogEvent{
+			logGas := vm.gasSchedule.Log + uint64(n)*vm.gasSchedule.LogTopic + uint64(len(data))*vm.gasSchedule.LogByte
+			if useGasNegative(gas, logGas, &err) {
+				return nil, err
+			}
+			log := &exec.LogEvent{
 				Address: callee.Address(),
 				Topics:  topics,
 				Data:    data,
-			})
+			}
+			if vm.tracer != nil {
+				vm.tracer.Log(log)
+			}
+			vm.eventSink.Log(log)
 			vm.Debugf(" => T:%X D:%X\n", topics, data)
 
-		case CREATE: // 0xF0
+		case CREATE, CREATE2: // 0xF0, 0xF5
 			vm.returnData = nil
 
+			if vm.readOnly {
+				return nil, firstErr(err, errors.ErrorCodeIllegalWrite)
+			}
 			if !HasPermission(callState, callee, permission.CreateContract) {
 				return nil, errors.PermissionDenied{
 					Address: callee.Address(),
@@ -868,6 +984,10 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				return nil, firstErr(err, popErr)
 			}
 			offset, size := stack.PopBigInt(), stack.PopBigInt()
+			var salt Word256
+			if op == CREATE2 {
+				salt = stack.Pop()
+			}
 			input, memErr := memory.Read(offset, size)
 			if memErr != nil {
 				vm.Debugf(" => Memory err: %s", memErr)
@@ -879,12 +999,28 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				return nil, firstErr(err, errors.ErrorCodeInsufficientBalance)
 			}
 
-			// TODO charge for gas to create account _ the code length * GasCreateByte
 			var gasErr errors.CodedError
-			if useGasNegative(gas, GasCreateAccount, &gasErr) {
+			if useGasNegative(gas, vm.gasSchedule.CreateAccount, &gasErr) {
 				return nil, firstErr(err, gasErr)
 			}
-			newAccount, createErr := vm.createAccount(callState, callee, logger)
+			var newAccount *acm.MutableAccount
+			var createErr errors.CodedError
+			if op == CREATE2 {
+				// CREATE2 must hash the init code to find the new address
+				sha3Gas := schedule.WordCost(vm.gasSchedule.Sha3, vm.gasSchedule.Sha3Word, uint64(len(input)))
+				if useGasNegative(gas, sha3Gas, &gasErr) {
+					return nil, firstErr(err, gasErr)
+				}
+				newAccount, createErr = vm.createAccountWithSalt(callState, callee, salt, input, logger)
+				if createErr != nil && createErr.ErrorCode() == errors.ErrorCodeDuplicateAddress {
+					// As per EIP-684 a collision fails the creation but not the calling frame
+					vm.Debugf(" => %s\n", createErr)
+					stack.Push(Zero256)
+					break
+				}
+			} else {
+				newAccount, createErr = vm.createAccount(callState, callee, logger)
+			}
 			if createErr != nil {
 				return nil, firstErr(err, createErr)
 			}
@@ -900,11 +1036,14 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 					return ret, callErr
 				}
 			} else {
+				if useGasNegative(gas, uint64(len(ret))*vm.gasSchedule.CreateByte, &gasErr) {
+					return nil, firstErr(err, gasErr)
+				}
 				newAccount.SetCode(ret) // Set the code (ret need not be copied as per Call contract)
 				stack.Push(newAccount.Address().Word256())
 			}
 
-		case CALL, CALLCODE, DELEGATECALL: // 0xF1, 0xF2, 0xF4
+		case CALL, CALLCODE, DELEGATECALL, STATICCALL: // 0xF1, 0xF2, 0xF4, 0xFA
 			vm.returnData = nil
 
 			if !HasPermission(callState, callee, permission.Call) {
@@ -923,12 +1062,19 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			// for DELEGATECALL and should not be popped.  Instead previous
 			// caller value is used.  for CALL and CALLCODE value is stored
 			// on stack and needs to be overwritten from the given value.
-			if op != DELEGATECALL {
+			// STATICCALL takes no value and never transfers any.
+			if op == STATICCALL {
+				value = 0
+			} else if op != DELEGATECALL {
 				value, popErr = stack.PopU64()
 				if popErr != nil {
 					return nil, firstErr(err, popErr)
 				}
 			}
+			// A CALL that transfers value would modify state so is forbidden in a static context
+			if vm.readOnly && op == CALL && value > 0 {
+				return nil, firstErr(err, errors.ErrorCodeIllegalWrite)
+			}
 			// inputs
 			inOffset, inSize := stack.PopBigInt(), stack.PopBigInt()
 			// outputs
@@ -946,6 +1092,14 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 				return nil, firstErr(err, errors.ErrorCodeMemoryOutOfBounds)
 			}
 
+			callGas := vm.gasSchedule.Call
+			if value > 0 && (op == CALL || op == CALLCODE) {
+				callGas += vm.gasSchedule.CallValue
+			}
+			if useGasNegative(gas, callGas, &err) {
+				return nil, err
+			}
+
 			// Ensure that gasLimit is reasonable
 			if *gas < gasLimit {
 				// EIP150 - the 63/64 rule - rather than errors.CodedError we pass this specified fraction of the total available gas
@@ -958,15 +1112,20 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			var ret []byte
 			var callErr errors.CodedError
 
-			if IsRegisteredNativeContract(addr) {
+			if contract := vm.nativeContract(addr); contract != nil {
 				// Native contract
-				ret, callErr = ExecuteNativeContract(addr, callState, callee, args, &gasLimit, logger)
+				var nativeState state.ReaderWriter = callState
+				if vm.readOnly || op == STATICCALL {
+					nativeState = readOnlyState{callState}
+				}
+				ret, callErr = executeNativeContract(contract, nativeState, callee, args, &gasLimit, vm.gasSchedule,
+					logger)
 				// for now we fire the Call event. maybe later we'll fire more particulars
 				// NOTE: these fire call go_events and not particular go_events for eg name reg or permissions
 				vm.fireCallEvent(&callErr, &ret, callee.Address(), crypto.AddressFromWord256(addr), args, value, &gasLimit)
 			} else {
 				// EVM contract
-				if useGasNegative(gas, GasGetAccount, &callErr) {
+				if useGasNegative(gas, vm.gasSchedule.GetAccount, &callErr) {
 					return nil, callErr
 				}
 				acc, errAcc := state.GetMutableAccount(callState, crypto.AddressFromWord256(addr))
@@ -987,6 +1146,12 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 						return nil, firstErr(callErr, errors.ErrorCodeUnknownAddress)
 					}
 					ret, callErr = vm.DelegateCall(callState, caller, callee, acc.Code(), args, value, &gasLimit)
+				} else if op == STATICCALL {
+					// A non-existent account has no code so a static call to it succeeds trivially
+					if acc == nil {
+						acc = acm.ConcreteAccount{Address: crypto.AddressFromWord256(addr)}.MutableAccount()
+					}
+					ret, callErr = vm.StaticCall(callState, callee, acc, acc.Code(), args, &gasLimit)
 				} else {
 					// nil account means we're sending funds to a new account
 					if acc == nil {
@@ -1075,8 +1240,11 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			return nil, errors.ErrorCodeExecutionAborted
 
 		case SELFDESTRUCT: // 0xFF
+			if vm.readOnly {
+				return nil, firstErr(err, errors.ErrorCodeIllegalWrite)
+			}
 			addr := stack.Pop()
-			if useGasNegative(gas, GasGetAccount, &err) {
+			if useGasNegative(gas, vm.gasSchedule.GetAccount, &err) {
 				return nil, err
 			}
 			receiver, errAcc := state.GetMutableAccount(callState, crypto.AddressFromWord256(addr))
@@ -1085,7 +1253,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 			}
 			if receiver == nil {
 				var gasErr errors.CodedError
-				if useGasNegative(gas, GasCreateAccount, &gasErr) {
+				if useGasNegative(gas, vm.gasSchedule.CreateAccount, &gasErr) {
 					return nil, firstErr(err, gasErr)
 				}
 				if !HasPermission(callState, callee, permission.CreateContract) {
@@ -1114,9 +1282,6 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 		case STOP: // 0x00
 			return nil, nil
 
-		case STATICCALL, CREATE2:
-			return nil, errors.Errorf("%v not yet implemented", op)
-
 		default:
 			vm.Debugf("(pc) %-3v Unknown opcode %v\n", pc, op)
 			return nil, errors.Errorf("unknown opcode %v", op)
@@ -1127,7 +1292,7 @@ func (vm *VM) call(callState *state.Cache, caller acm.Account, callee *acm.Mutab
 
 func (vm *VM) createAccount(callState *state.Cache, callee *acm.MutableAccount, logger *logging.Logger) (*acm.MutableAccount, errors.CodedError) {
 	newAccount := DeriveNewAccount(callee, state.GlobalAccountPermissions(callState), logger)
-	if IsRegisteredNativeContract(newAccount.Address().Word256()) {
+	if vm.nativeContract(newAccount.Address().Word256()) != nil {
 		return nil, errors.ErrorCodef(errors.ErrorCodeReservedAddress,
 			"cannot create account at %v because that address is reserved for a native contract",
 			newAccount.Address())
@@ -1143,6 +1308,62 @@ func (vm *VM) createAccount(callState *state.Cache, callee *acm.MutableAccount,
 	return newAccount, nil
 }
 
+// Like createAccount but derives the address from the salt and init code as per CREATE2. An account may already exist
+// at that address, for example if funds were sent to it before the contract was deployed, in which case its balance is
+// kept. It is an error for that account to already hold code or to have been used to sign transactions.
+func (vm *VM) createAccountWithSalt(callState *state.Cache, callee *acm.MutableAccount, salt Word256, initCode []byte,
+	logger *logging.Logger) (*acm.MutableAccount, errors.CodedError) {
+
+	newAccount := DeriveNewAccountWithSalt(callee, salt, initCode, state.GlobalAccountPermissions(callState), logger)
+	if vm.nativeContract(newAccount.Address().Word256()) != nil {
+		return nil, errors.ErrorCodef(errors.ErrorCodeReservedAddress,
+			"cannot create account at %v because that address is reserved for a native contract",
+			newAccount.Address())
+	}
+	existing, err := callState.GetAccount(newAccount.Address())
+	if err != nil {
+		return nil, errors.AsException(err)
+	}
+	if existing != nil {
+		if len(existing.Code()) > 0 || existing.Sequence() > 0 {
+			return nil, errors.ErrorCodef(errors.ErrorCodeDuplicateAddress,
+				"cannot create account at %v because an account already exists at that address",
+				newAccount.Address())
+		}
+		err = newAccount.AddToBalance(existing.Balance())
+		if err != nil {
+			return nil, errors.AsException(err)
+		}
+	}
+	err = callState.UpdateAccount(newAccount)
+	if err != nil {
+		return nil, errors.AsException(err)
+	}
+	err = callState.UpdateAccount(callee)
+	if err != nil {
+		return nil, errors.AsException(err)
+	}
+	return newAccount, nil
+}
+
+// Returns the hash of the block at blockNumber if it is one of the maxBlockHashDepth blocks preceding the
+// block currently being executed (at height BlockHeight + 1), otherwise zero
+func (vm *VM) blockHash(blockNumber *big.Int) Word256 {
+	if vm.params.Blockchain == nil || !blockNumber.IsUint64() {
+		return Zero256
+	}
+	height := blockNumber.Uint64()
+	if height > vm.params.BlockHeight || height+maxBlockHashDepth <= vm.params.BlockHeight {
+		return Zero256
+	}
+	hash, err := vm.params.Blockchain.BlockHash(height)
+	if err != nil {
+		vm.Debugf(" => could not get block hash for height %v: %v\n", height, err)
+		return Zero256
+	}
+	return LeftPadWord256(hash)
+}
+
 // TODO: [Silas] this function seems extremely dubious to me. It was being used
 // in circumstances where its behaviour did not match the intention. It's bounds
 // check is strange (treats a read at data length as a zero read of arbitrary length)
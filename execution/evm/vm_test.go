@@ -26,8 +26,11 @@ import (
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
 	. "github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
@@ -726,12 +729,13 @@ func TestDelegateCallGas(t *testing.T) {
 
 	// DELEGATECALL(retSize, refOffset, inSize, inOffset, addr, gasLimit)
 	// 6 pops
-	delegateCallCost := GasStackOp * 6
+	stackOpCost := schedule.BurrowLegacy().StackOp
+	delegateCallCost := stackOpCost * 6
 	// 1 push
-	gasCost := GasStackOp
+	gasCost := stackOpCost
 	// 2 pops, 1 push
-	subCost := GasStackOp * 3
-	pushCost := GasStackOp
+	subCost := stackOpCost * 3
+	pushCost := stackOpCost
 
 	costBetweenGasAndDelegateCall := gasCost + subCost + delegateCallCost + pushCost
 
@@ -966,6 +970,261 @@ func TestReturnDataCopy(t *testing.T) {
 	}
 }
 
+func TestStaticCall(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+
+	var gas uint64 = 100000
+	caller := newAccount(1)
+	callee := newAccount(2)
+	cache.UpdateAccount(caller)
+	cache.UpdateAccount(callee)
+
+	// A contract that only reads may be called statically
+	_, readerAddress := makeAccountWithCode(cache, "reader", MustSplice(PUSH1, 20, return1()))
+	output, err := ourVm.Call(cache, caller, callee, MustSplice(staticCallContractCode(readerAddress), POP,
+		returnWord()), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Int64ToWord256(20).Bytes(), output)
+
+	// Writing to storage from a static frame fails the call
+	writer, writerAddress := makeAccountWithCode(cache, "writer", MustSplice(PUSH1, 1, PUSH1, 0, SSTORE, STOP))
+	output, err = ourVm.Call(cache, caller, callee, MustSplice(staticCallContractCode(writerAddress), return1()),
+		[]byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+
+	_, err = ourVm.StaticCall(cache, caller, writer, writer.Code(), []byte{}, &gas)
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeIllegalWrite, err.ErrorCode())
+
+	// Read-only mode is inherited by nested calls, so a plain CALL to a contract that logs fails
+	_, loggerAddress := makeAccountWithCode(cache, "logger", MustSplice(PUSH1, 0, PUSH1, 0, LOG0, STOP))
+	proxy, _ := makeAccountWithCode(cache, "proxy", MustSplice(PUSH1, 0, PUSH1, 0, PUSH1, 0, PUSH1, 0,
+		PUSH1, 0, PUSH20, loggerAddress, PUSH2, 0x10, 0x00, CALL, return1()))
+	output, err = ourVm.StaticCall(cache, caller, proxy, proxy.Code(), []byte{}, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+
+	// Outside of the static frame the same call succeeds
+	output, err = ourVm.Call(cache, caller, proxy, proxy.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, One256.Bytes(), output)
+
+	// Sending value from a static frame is not allowed
+	sender, _ := makeAccountWithCode(cache, "sender", callContractCode(readerAddress))
+	_, err = ourVm.StaticCall(cache, caller, sender, sender.Code(), []byte{}, &gas)
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeIllegalWrite, err.ErrorCode())
+
+	// SNatives that modify state fail when called statically and leave state unchanged
+	callee.SetPermissions(allAccountPermissions())
+	require.NoError(t, cache.UpdateAccount(callee))
+	permissions := SNativeContracts()["Permissions"]
+	addRole, funcErr := permissions.FunctionByName("addRole")
+	require.NoError(t, funcErr)
+	input, packErr := abi.Pack(addRole.Abi.Inputs, caller.Address(), "static")
+	require.NoError(t, packErr)
+	input = append(addRole.Abi.FunctionID[:], input...)
+	// Forward the call data to the Permissions contract and return the success flag
+	forward := func(callOp []byte) []byte {
+		return MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY, PUSH1, 32, PUSH1, 0, CALLDATASIZE, PUSH1, 0,
+			callOp, return1())
+	}
+	staticCaller := forward(MustSplice(PUSH20, permissions.Address(), GAS, STATICCALL))
+	output, err = ourVm.Call(cache, caller, callee, staticCaller, input, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+	acc, accErr := cache.GetAccount(caller.Address())
+	require.NoError(t, accErr)
+	assert.False(t, acc.Permissions().HasRole("static"))
+
+	plainCaller := forward(MustSplice(PUSH1, 0, PUSH20, permissions.Address(), GAS, CALL))
+	output, err = ourVm.Call(cache, caller, callee, plainCaller, input, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, One256.Bytes(), output)
+	acc, accErr = cache.GetAccount(caller.Address())
+	require.NoError(t, accErr)
+	assert.True(t, acc.Permissions().HasRole("static"))
+}
+
+func TestCreate2(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+
+	var gas uint64 = 100000
+	caller := newAccount(1)
+	cache.UpdateAccount(caller)
+
+	// Init code that deploys the single byte 0x01 as the contract code
+	initCode := MustSplice(PUSH1, 0x01, PUSH1, 0x00, MSTORE8, PUSH1, 0x01, PUSH1, 0x00, RETURN)
+	salt := Int64ToWord256(0xcafe)
+	factory, factoryAddress := makeAccountWithCode(cache, "factory", MustSplice(PUSH10, initCode, PUSH1, 0,
+		MSTORE, pushWord(salt), PUSH1, len(initCode), PUSH1, 32-len(initCode), PUSH1, 0, CREATE2, return1()))
+
+	expectedAddress := crypto.NewContractAddress2(factoryAddress, salt, initCode)
+
+	// Send some funds to the address before the contract is deployed
+	cache.UpdateAccount(acm.ConcreteAccount{Address: expectedAddress, Balance: 1337}.MutableAccount())
+
+	output, err := ourVm.Call(cache, caller, factory, factory.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, expectedAddress.Word256().Bytes(), output)
+
+	created, getErr := cache.GetAccount(expectedAddress)
+	require.NoError(t, getErr)
+	assert.Equal(t, []byte{0x01}, created.Code().Bytes())
+	assert.Equal(t, uint64(1337), created.Balance())
+
+	// Deploying to the same address again collides so CREATE2 pushes zero
+	factory, getErr = state.GetMutableAccount(cache, factoryAddress)
+	require.NoError(t, getErr)
+	output, err = ourVm.Call(cache, caller, factory, factory.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+}
+
+func TestBlockOpcodes(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	params := newParams()
+	params.BlockHeight = 300
+	params.Blockchain = &blockchain{chainID: "burrow-chain"}
+	ourVm := NewVM(params, crypto.ZeroAddress, nil, logger)
+
+	var gas uint64 = 100000
+	caller := newAccount(1)
+	cache.UpdateAccount(caller)
+
+	callBlockHash := func(height int) []byte {
+		account, _ := makeAccountWithCode(cache, "blockhash", MustSplice(PUSH2, Uint64ToWord256(uint64(height)).Bytes()[30:],
+			BLOCKHASH, return1()))
+		output, err := ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+		require.NoError(t, err)
+		return output
+	}
+	assert.Equal(t, Int64ToWord256(300).Bytes(), callBlockHash(300))
+	assert.Equal(t, Int64ToWord256(45).Bytes(), callBlockHash(45))
+	// Outside of the 256 block window or in the future
+	assert.Equal(t, Zero256.Bytes(), callBlockHash(44))
+	assert.Equal(t, Zero256.Bytes(), callBlockHash(301))
+
+	account, _ := makeAccountWithCode(cache, "chainid", MustSplice(CHAINID, return1()))
+	output, err := ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, sha3.Sha3([]byte("burrow-chain")), output)
+
+	account, _ = makeAccountWithCode(cache, "selfbalance", MustSplice(SELFBALANCE, return1()))
+	output, err = ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Uint64ToWord256(account.Balance()).Bytes(), output)
+}
+
+func TestExtCodeHash(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger)
+
+	var gas uint64 = 100000
+	caller := newAccount(1)
+	cache.UpdateAccount(caller)
+
+	code := MustSplice(PUSH1, 0x01, PUSH1, 0x02, ADD)
+	_, target := makeAccountWithCode(cache, "target", code)
+	account, _ := makeAccountWithCode(cache, "extcodehash", MustSplice(PUSH20, target, EXTCODEHASH, return1()))
+	output, err := ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, sha3.Sha3(code), output)
+
+	// Accounts that do not exist hash to zero
+	account, _ = makeAccountWithCode(cache, "extcodehash", MustSplice(PUSH20, newAccount(2).Address(), EXTCODEHASH,
+		return1()))
+	output, err = ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	assert.Equal(t, Zero256.Bytes(), output)
+}
+
+func TestGasSchedule(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	params := newParams()
+	params.GasSchedule = schedule.EthereumIstanbul()
+	ourVm := NewVM(params, crypto.ZeroAddress, nil, logger)
+
+	caller := newAccount(1)
+	cache.UpdateAccount(caller)
+	account, _ := makeAccountWithCode(cache, "sstore", MustSplice(PUSH1, 1, PUSH1, 0, SSTORE, STOP))
+
+	var gas uint64 = 100000
+	_, err := ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	// Four instructions and a storage update
+	assert.Equal(t, uint64(100000-4*3-20000), gas)
+
+	// Not enough to pay for the storage update
+	gas = 20000
+	_, err = ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeInsufficientGas, err.ErrorCode())
+}
+
+// Blockchain whose block hashes are just their height
+func TestStructLogger(t *testing.T) {
+	cache := state.NewCache(newAppState())
+	tracer := NewStructLogger(nil)
+	ourVm := NewVM(newParams(), crypto.ZeroAddress, nil, logger, TraceWith(tracer))
+
+	var gas uint64 = 100000
+	caller := newAccount(1)
+	cache.UpdateAccount(caller)
+	account, _ := makeAccountWithCode(cache, "traced",
+		MustSplice(PUSH1, 0x2a, PUSH1, 0x01, SSTORE, PUSH1, 0x01, SLOAD, return1()))
+	output, err := ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+
+	trace := tracer.Trace()
+	assert.False(t, trace.Failed)
+	assert.Equal(t, 100000-gas, trace.Gas)
+	assert.Equal(t, hex.EncodeToString(output), trace.ReturnValue)
+
+	var ops []string
+	for _, structLog := range trace.StructLogs {
+		assert.Equal(t, uint64(1), structLog.Depth)
+		ops = append(ops, structLog.Op)
+	}
+	assert.Equal(t, []string{"PUSH1", "PUSH1", "SSTORE", "PUSH1", "SLOAD", "PUSH1", "MSTORE", "PUSH1", "PUSH1",
+		"RETURN"}, ops)
+
+	sstore := trace.StructLogs[2]
+	assert.Equal(t, uint64(4), sstore.PC)
+	assert.Equal(t, []string{hex.EncodeToString(Int64ToWord256(0x2a).Bytes()),
+		hex.EncodeToString(One256.Bytes())}, sstore.Stack)
+	assert.Equal(t, map[string]string{hex.EncodeToString(One256.Bytes()): hex.EncodeToString(
+		Int64ToWord256(0x2a).Bytes())}, sstore.Storage)
+	// Two pops and the storage update
+	assert.Equal(t, uint64(3), sstore.GasCost)
+	assert.Equal(t, []string{hex.EncodeToString(Int64ToWord256(0x2a).Bytes())}, trace.StructLogs[9].Memory)
+
+	tracer = NewStructLogger(&exec.TraceConfig{DisableStack: true, DisableMemory: true, DisableStorage: true})
+	ourVm = NewVM(newParams(), crypto.ZeroAddress, nil, logger, TraceWith(tracer))
+	_, err = ourVm.Call(cache, caller, account, account.Code(), []byte{}, 0, &gas)
+	require.NoError(t, err)
+	for _, structLog := range tracer.Trace().StructLogs {
+		assert.Empty(t, structLog.Stack)
+		assert.Empty(t, structLog.Memory)
+		assert.Empty(t, structLog.Storage)
+	}
+}
+
+type blockchain struct {
+	chainID string
+}
+
+func (b *blockchain) ChainID() string {
+	return b.chainID
+}
+
+func (b *blockchain) BlockHash(height uint64) ([]byte, error) {
+	return Uint64ToWord256(height).Bytes(), nil
+}
+
 // These code segment helpers exercise the MSTORE MLOAD MSTORE cycle to test
 // both of the memory operations. Each MSTORE is done on the memory boundary
 // (at MSIZE) which Solidity uses to find guaranteed unallocated memory.
@@ -1052,6 +1311,16 @@ func callContractCode(addr crypto.Address) []byte {
 		PUSH1, retOff, RETURN)
 }
 
+// this is code to STATICCALL another contract (hardcoded as addr) leaving the success flag on the stack and the
+// first word of output in memory at offset 0
+func staticCallContractCode(addr crypto.Address) []byte {
+	gas1, gas2 := byte(0x10), byte(0x0)
+	inOff, inSize := byte(0x0), byte(0x0) // no call data
+	retOff, retSize := byte(0x0), byte(0x20)
+	return MustSplice(PUSH1, retSize, PUSH1, retOff, PUSH1, inSize, PUSH1, inOff, PUSH20, addr,
+		PUSH2, gas1, gas2, STATICCALL)
+}
+
 // Produce bytecode for a PUSH<N>, b_1, ..., b_N where the N is number of bytes
 // contained in the unpadded word
 func pushWord(word Word256) []byte {
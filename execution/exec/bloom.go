@@ -0,0 +1,102 @@
+package exec
+
+import (
+	. "github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+)
+
+// The length in bytes of a Bloom filter
+const BloomLength = 256
+
+// A 2048-bit Bloom filter laid out as Ethereum's logsBloom, each entry sets three bits taken from its Keccak hash
+type Bloom [BloomLength]byte
+
+func (b *Bloom) Add(data []byte) {
+	hash := sha3.Sha3(data)
+	for i := 0; i < 6; i += 2 {
+		bit := (uint(hash[i])<<8 | uint(hash[i+1])) & (BloomLength*8 - 1)
+		b[BloomLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Returns false if data has definitely not been added to the filter
+func (b *Bloom) Test(data []byte) bool {
+	var probe Bloom
+	probe.Add(data)
+	for i := range probe {
+		if b[i]&probe[i] != probe[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// The terms by which the events of a block are indexed. Only the events of successful transactions are included.
+type IndexTerms struct {
+	// The Address of each input, output, and log event
+	Addresses []crypto.Address
+	// The topics of each log event
+	Topics []Word256
+}
+
+func (be *BlockExecution) IndexTerms() *IndexTerms {
+	terms := new(IndexTerms)
+	addresses := make(map[crypto.Address]struct{})
+	topics := make(map[Word256]struct{})
+	addAddress := func(address crypto.Address) {
+		if _, ok := addresses[address]; !ok {
+			addresses[address] = struct{}{}
+			terms.Addresses = append(terms.Addresses, address)
+		}
+	}
+	for _, txe := range be.TxExecutions {
+		if txe.Exception != nil {
+			continue
+		}
+		for _, ev := range txe.Events {
+			switch {
+			case ev.Input != nil:
+				addAddress(ev.Input.Address)
+			case ev.Output != nil:
+				addAddress(ev.Output.Address)
+			case ev.Log != nil:
+				addAddress(ev.Log.Address)
+				for _, topic := range ev.Log.Topics {
+					if _, ok := topics[topic]; !ok {
+						topics[topic] = struct{}{}
+						terms.Topics = append(terms.Topics, topic)
+					}
+				}
+			}
+		}
+	}
+	return terms
+}
+
+// Returns a Bloom filter containing the terms
+func (terms *IndexTerms) Bloom() *Bloom {
+	bloom := new(Bloom)
+	for _, address := range terms.Addresses {
+		bloom.Add(address.Bytes())
+	}
+	for _, topic := range terms.Topics {
+		bloom.Add(topic.Bytes())
+	}
+	return bloom
+}
+
+// Returns false if the filter definitely does not contain all of the terms
+func (b *Bloom) TestAll(terms *IndexTerms) bool {
+	for _, address := range terms.Addresses {
+		if !b.Test(address.Bytes()) {
+			return false
+		}
+	}
+	for _, topic := range terms.Topics {
+		if !b.Test(topic.Bytes()) {
+			return false
+		}
+	}
+	return true
+}
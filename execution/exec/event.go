@@ -22,6 +22,8 @@ const (
 	TypeTxExecution    = EventType(0x04)
 	TypeBlockExecution = EventType(0x05)
 	TypeGovernAccount  = EventType(0x06)
+	TypeBond           = EventType(0x07)
+	TypeUnbond         = EventType(0x08)
 )
 
 var nameFromType = map[EventType]string{
@@ -32,6 +34,8 @@ var nameFromType = map[EventType]string{
 	TypeTxExecution:    "TxExecutionEvent",
 	TypeBlockExecution: "BlockExecutionEvent",
 	TypeGovernAccount:  "GovernAccountEvent",
+	TypeBond:           "BondEvent",
+	TypeUnbond:         "UnbondEvent",
 }
 
 var typeFromName = make(map[string]EventType)
@@ -86,6 +90,12 @@ func (ev *Event) Body() string {
 	if ev.Call != nil {
 		return ev.Call.String()
 	}
+	if ev.Bond != nil {
+		return ev.Bond.String()
+	}
+	if ev.Unbond != nil {
+		return ev.Unbond.String()
+	}
 	return "<empty>"
 }
 
@@ -105,6 +115,8 @@ func (ev *Event) Tagged() *TaggedEvent {
 			query.MustReflectTags(ev.Input),
 			query.MustReflectTags(ev.Output),
 			query.MustReflectTags(ev.Call),
+			query.MustReflectTags(ev.Bond),
+			query.MustReflectTags(ev.Unbond),
 			ev.Log,
 		),
 		Event: ev,
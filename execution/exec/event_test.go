@@ -70,3 +70,24 @@ func TestEventTagQueries(t *testing.T) {
 	t.Logf("Query: %v", qry)
 	t.Logf("Keys: %v", tev.Keys())
 }
+
+func TestDecodedLogTags(t *testing.T) {
+	ev := &Event{
+		Header: &Header{EventType: TypeLog},
+		Log: &LogEvent{
+			Decoded: &DecodedLog{
+				EventName: "Transfer",
+				Arguments: []*EventArgument{
+					{Name: "to", Type: "address", Value: "DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF"},
+					{Name: "value", Type: "uint256", Value: "1000"},
+				},
+			},
+		},
+	}
+	qry, err := query.New("EventName = 'Transfer' AND to = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF' AND value = '1000'")
+	require.NoError(t, err)
+	assert.True(t, qry.Matches(ev.Tagged()))
+
+	ev.Log.Decoded = nil
+	assert.False(t, qry.Matches(ev.Tagged()))
+}
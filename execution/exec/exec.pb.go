@@ -15,11 +15,23 @@
 		Event
 		Result
 		LogEvent
+		DecodedLog
+		EventArgument
 		CallEvent
 		GovernAccountEvent
+		BondEvent
+		FeeEvent
+		SlashEvent
+		ReleaseEvent
+		Jailing
+		MissedBlocks
+		UnbondEvent
 		InputEvent
 		OutputEvent
 		CallData
+		TraceConfig
+		Trace
+		StructLog
 */
 package exec
 
@@ -33,6 +45,7 @@ import names "github.com/hyperledger/burrow/execution/names"
 import txs "github.com/hyperledger/burrow/txs"
 import permission "github.com/hyperledger/burrow/permission"
 import spec "github.com/hyperledger/burrow/genesis/spec"
+import crypto "github.com/hyperledger/burrow/crypto"
 
 import github_com_hyperledger_burrow_txs_payload "github.com/hyperledger/burrow/txs/payload"
 import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
@@ -60,6 +73,12 @@ type BlockExecution struct {
 	//    types.Header BlockHeader = 2;
 	BlockHeader  *BlockHeader   `protobuf:"bytes,2,opt,name=BlockHeader" json:"BlockHeader,omitempty"`
 	TxExecutions []*TxExecution `protobuf:"bytes,3,rep,name=TxExecutions" json:"TxExecutions,omitempty"`
+	// The payments of the fees collected from the transactions in this block
+	FeeEvents []*FeeEvent `protobuf:"bytes,4,rep,name=FeeEvents" json:"FeeEvents,omitempty"`
+	// The penalties applied to misbehaving validators at the beginning of this block
+	SlashEvents []*SlashEvent `protobuf:"bytes,5,rep,name=SlashEvents" json:"SlashEvents,omitempty"`
+	// The validators whose jailed power was restored at the beginning of this block
+	ReleaseEvents []*ReleaseEvent `protobuf:"bytes,6,rep,name=ReleaseEvents" json:"ReleaseEvents,omitempty"`
 }
 
 func (m *BlockExecution) Reset()                    { *m = BlockExecution{} }
@@ -88,6 +107,27 @@ func (m *BlockExecution) GetTxExecutions() []*TxExecution {
 	return nil
 }
 
+func (m *BlockExecution) GetFeeEvents() []*FeeEvent {
+	if m != nil {
+		return m.FeeEvents
+	}
+	return nil
+}
+
+func (m *BlockExecution) GetSlashEvents() []*SlashEvent {
+	if m != nil {
+		return m.SlashEvents
+	}
+	return nil
+}
+
+func (m *BlockExecution) GetReleaseEvents() []*ReleaseEvent {
+	if m != nil {
+		return m.ReleaseEvents
+	}
+	return nil
+}
+
 func (*BlockExecution) XXX_MessageName() string {
 	return "exec.BlockExecution"
 }
@@ -273,6 +313,8 @@ type Event struct {
 	Call          *CallEvent          `protobuf:"bytes,4,opt,name=Call" json:"Call,omitempty"`
 	Log           *LogEvent           `protobuf:"bytes,5,opt,name=Log" json:"Log,omitempty"`
 	GovernAccount *GovernAccountEvent `protobuf:"bytes,6,opt,name=GovernAccount" json:"GovernAccount,omitempty"`
+	Bond          *BondEvent          `protobuf:"bytes,7,opt,name=Bond" json:"Bond,omitempty"`
+	Unbond        *UnbondEvent        `protobuf:"bytes,8,opt,name=Unbond" json:"Unbond,omitempty"`
 }
 
 func (m *Event) Reset()                    { *m = Event{} }
@@ -321,6 +363,20 @@ func (m *Event) GetGovernAccount() *GovernAccountEvent {
 	return nil
 }
 
+func (m *Event) GetBond() *BondEvent {
+	if m != nil {
+		return m.Bond
+	}
+	return nil
+}
+
+func (m *Event) GetUnbond() *UnbondEvent {
+	if m != nil {
+		return m.Unbond
+	}
+	return nil
+}
+
 func (*Event) XXX_MessageName() string {
 	return "exec.Event"
 }
@@ -378,6 +434,8 @@ type LogEvent struct {
 	Address github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 	Data    github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
 	Topics  []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	// Set when serving the log if the ABI of the contract at Address is known, not stored with the log
+	Decoded *DecodedLog `protobuf:"bytes,4,opt,name=Decoded" json:"Decoded,omitempty"`
 }
 
 func (m *LogEvent) Reset()                    { *m = LogEvent{} }
@@ -385,10 +443,84 @@ func (m *LogEvent) String() string            { return proto.CompactTextString(m
 func (*LogEvent) ProtoMessage()               {}
 func (*LogEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{6} }
 
+func (m *LogEvent) GetDecoded() *DecodedLog {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
 func (*LogEvent) XXX_MessageName() string {
 	return "exec.LogEvent"
 }
 
+// A LogEvent decoded with the ABI of the contract that emitted it
+type DecodedLog struct {
+	EventName string           `protobuf:"bytes,1,opt,name=EventName,proto3" json:"EventName,omitempty"`
+	Arguments []*EventArgument `protobuf:"bytes,2,rep,name=Arguments" json:"Arguments,omitempty"`
+}
+
+func (m *DecodedLog) Reset()                    { *m = DecodedLog{} }
+func (m *DecodedLog) String() string            { return proto.CompactTextString(m) }
+func (*DecodedLog) ProtoMessage()               {}
+func (*DecodedLog) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{7} }
+
+func (m *DecodedLog) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *DecodedLog) GetArguments() []*EventArgument {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+func (*DecodedLog) XXX_MessageName() string {
+	return "exec.DecodedLog"
+}
+
+type EventArgument struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// The Solidity type of the argument
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// The value formatted as a string (fixed-length bytes are hex)
+	Value string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *EventArgument) Reset()                    { *m = EventArgument{} }
+func (m *EventArgument) String() string            { return proto.CompactTextString(m) }
+func (*EventArgument) ProtoMessage()               {}
+func (*EventArgument) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{8} }
+
+func (m *EventArgument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventArgument) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EventArgument) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (*EventArgument) XXX_MessageName() string {
+	return "exec.EventArgument"
+}
+
 type CallEvent struct {
 	CallData   *CallData                                     `protobuf:"bytes,1,opt,name=CallData" json:"CallData,omitempty"`
 	Origin     github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
@@ -399,7 +531,7 @@ type CallEvent struct {
 func (m *CallEvent) Reset()                    { *m = CallEvent{} }
 func (m *CallEvent) String() string            { return proto.CompactTextString(m) }
 func (*CallEvent) ProtoMessage()               {}
-func (*CallEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{7} }
+func (*CallEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{9} }
 
 func (m *CallEvent) GetCallData() *CallData {
 	if m != nil {
@@ -426,7 +558,7 @@ type GovernAccountEvent struct {
 func (m *GovernAccountEvent) Reset()                    { *m = GovernAccountEvent{} }
 func (m *GovernAccountEvent) String() string            { return proto.CompactTextString(m) }
 func (*GovernAccountEvent) ProtoMessage()               {}
-func (*GovernAccountEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{8} }
+func (*GovernAccountEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{10} }
 
 func (m *GovernAccountEvent) GetAccountUpdate() *spec.TemplateAccount {
 	if m != nil {
@@ -439,6 +571,237 @@ func (*GovernAccountEvent) XXX_MessageName() string {
 	return "exec.GovernAccountEvent"
 }
 
+type BondEvent struct {
+	// The validator whose power was increased
+	Validator github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	// The amount of balance locked into validator power
+	Amount uint64 `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+}
+
+func (m *BondEvent) Reset()                    { *m = BondEvent{} }
+func (m *BondEvent) String() string            { return proto.CompactTextString(m) }
+func (*BondEvent) ProtoMessage()               {}
+func (*BondEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{11} }
+
+func (m *BondEvent) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (*BondEvent) XXX_MessageName() string {
+	return "exec.BondEvent"
+}
+
+type FeeEvent struct {
+	// The account credited with its share of the fees collected in a block
+	Recipient github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Recipient,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Recipient"`
+	Amount    uint64                                       `protobuf:"varint,2,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// Whether Amount includes the share paid to the block proposer
+	Proposer bool `protobuf:"varint,3,opt,name=Proposer,proto3" json:"Proposer,omitempty"`
+}
+
+func (m *FeeEvent) Reset()                    { *m = FeeEvent{} }
+func (m *FeeEvent) String() string            { return proto.CompactTextString(m) }
+func (*FeeEvent) ProtoMessage()               {}
+func (*FeeEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{12} }
+
+func (m *FeeEvent) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FeeEvent) GetProposer() bool {
+	if m != nil {
+		return m.Proposer
+	}
+	return false
+}
+
+func (*FeeEvent) XXX_MessageName() string {
+	return "exec.FeeEvent"
+}
+
+type SlashEvent struct {
+	// The validator that was penalised
+	Validator github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	// The misbehaviour penalised, either DoubleSign or Downtime
+	Reason string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	// The amount of validator power destroyed
+	Slashed uint64 `protobuf:"varint,3,opt,name=Slashed,proto3" json:"Slashed,omitempty"`
+	// The amount of validator power withheld while the validator is jailed
+	Jailed uint64 `protobuf:"varint,4,opt,name=Jailed,proto3" json:"Jailed,omitempty"`
+	// The height of the block at the beginning of which the withheld power will be restored, zero if never
+	ReleaseHeight uint64 `protobuf:"varint,5,opt,name=ReleaseHeight,proto3" json:"ReleaseHeight,omitempty"`
+}
+
+func (m *SlashEvent) Reset()                    { *m = SlashEvent{} }
+func (m *SlashEvent) String() string            { return proto.CompactTextString(m) }
+func (*SlashEvent) ProtoMessage()               {}
+func (*SlashEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{13} }
+
+func (m *SlashEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *SlashEvent) GetSlashed() uint64 {
+	if m != nil {
+		return m.Slashed
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetJailed() uint64 {
+	if m != nil {
+		return m.Jailed
+	}
+	return 0
+}
+
+func (m *SlashEvent) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (*SlashEvent) XXX_MessageName() string {
+	return "exec.SlashEvent"
+}
+
+type ReleaseEvent struct {
+	// The validator released from jail
+	Validator github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	// The amount of validator power restored
+	Power uint64 `protobuf:"varint,2,opt,name=Power,proto3" json:"Power,omitempty"`
+}
+
+func (m *ReleaseEvent) Reset()                    { *m = ReleaseEvent{} }
+func (m *ReleaseEvent) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseEvent) ProtoMessage()               {}
+func (*ReleaseEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{14} }
+
+func (m *ReleaseEvent) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (*ReleaseEvent) XXX_MessageName() string {
+	return "exec.ReleaseEvent"
+}
+
+// A validator whose power has been withheld as a penalty for misbehaviour
+type Jailing struct {
+	PublicKey crypto.PublicKey `protobuf:"bytes,1,opt,name=PublicKey" json:"PublicKey"`
+	// The power withheld, which is restored to the validator on release
+	Power uint64 `protobuf:"varint,2,opt,name=Power,proto3" json:"Power,omitempty"`
+	// The height of the block at the beginning of which the validator was jailed
+	Height uint64 `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	// The height of the block at the beginning of which the validator is released, zero if it is never released
+	ReleaseHeight uint64 `protobuf:"varint,4,opt,name=ReleaseHeight,proto3" json:"ReleaseHeight,omitempty"`
+}
+
+func (m *Jailing) Reset()                    { *m = Jailing{} }
+func (m *Jailing) String() string            { return proto.CompactTextString(m) }
+func (*Jailing) ProtoMessage()               {}
+func (*Jailing) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{15} }
+
+func (m *Jailing) GetPublicKey() crypto.PublicKey {
+	if m != nil {
+		return m.PublicKey
+	}
+	return crypto.PublicKey{}
+}
+
+func (m *Jailing) GetPower() uint64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+func (m *Jailing) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Jailing) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (*Jailing) XXX_MessageName() string {
+	return "exec.Jailing"
+}
+
+// The heights of the recent blocks a validator failed to sign
+type MissedBlocks struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Heights []uint64                                     `protobuf:"varint,2,rep,packed,name=Heights" json:"Heights,omitempty"`
+}
+
+func (m *MissedBlocks) Reset()                    { *m = MissedBlocks{} }
+func (m *MissedBlocks) String() string            { return proto.CompactTextString(m) }
+func (*MissedBlocks) ProtoMessage()               {}
+func (*MissedBlocks) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{16} }
+
+func (m *MissedBlocks) GetHeights() []uint64 {
+	if m != nil {
+		return m.Heights
+	}
+	return nil
+}
+
+func (*MissedBlocks) XXX_MessageName() string {
+	return "exec.MissedBlocks"
+}
+
+type UnbondEvent struct {
+	// The validator whose power was decreased
+	Validator github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Validator,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Validator"`
+	// The account to which the unbonded funds will be released
+	To github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=To,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"To"`
+	// The amount of validator power released as balance
+	Amount uint64 `protobuf:"varint,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	// The height of the block at the end of which the funds will be released
+	ReleaseHeight uint64 `protobuf:"varint,4,opt,name=ReleaseHeight,proto3" json:"ReleaseHeight,omitempty"`
+}
+
+func (m *UnbondEvent) Reset()                    { *m = UnbondEvent{} }
+func (m *UnbondEvent) String() string            { return proto.CompactTextString(m) }
+func (*UnbondEvent) ProtoMessage()               {}
+func (*UnbondEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{17} }
+
+func (m *UnbondEvent) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *UnbondEvent) GetReleaseHeight() uint64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (*UnbondEvent) XXX_MessageName() string {
+	return "exec.UnbondEvent"
+}
+
 type InputEvent struct {
 	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
 }
@@ -446,7 +809,7 @@ type InputEvent struct {
 func (m *InputEvent) Reset()                    { *m = InputEvent{} }
 func (m *InputEvent) String() string            { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()               {}
-func (*InputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{9} }
+func (*InputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{18} }
 
 func (*InputEvent) XXX_MessageName() string {
 	return "exec.InputEvent"
@@ -459,7 +822,7 @@ type OutputEvent struct {
 func (m *OutputEvent) Reset()                    { *m = OutputEvent{} }
 func (m *OutputEvent) String() string            { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()               {}
-func (*OutputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{10} }
+func (*OutputEvent) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{19} }
 
 func (*OutputEvent) XXX_MessageName() string {
 	return "exec.OutputEvent"
@@ -476,7 +839,7 @@ type CallData struct {
 func (m *CallData) Reset()                    { *m = CallData{} }
 func (m *CallData) String() string            { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()               {}
-func (*CallData) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{11} }
+func (*CallData) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{20} }
 
 func (m *CallData) GetValue() uint64 {
 	if m != nil {
@@ -495,90 +858,327 @@ func (m *CallData) GetGas() uint64 {
 func (*CallData) XXX_MessageName() string {
 	return "exec.CallData"
 }
-func init() {
-	proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
-	golang_proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
-	proto.RegisterType((*BlockHeader)(nil), "exec.BlockHeader")
-	golang_proto.RegisterType((*BlockHeader)(nil), "exec.BlockHeader")
-	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
-	golang_proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
-	proto.RegisterType((*Header)(nil), "exec.Header")
-	golang_proto.RegisterType((*Header)(nil), "exec.Header")
-	proto.RegisterType((*Event)(nil), "exec.Event")
-	golang_proto.RegisterType((*Event)(nil), "exec.Event")
-	proto.RegisterType((*Result)(nil), "exec.Result")
-	golang_proto.RegisterType((*Result)(nil), "exec.Result")
-	proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
-	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
-	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
-	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
-	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
-	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
-	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
-	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
-	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
-	golang_proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
-	proto.RegisterType((*CallData)(nil), "exec.CallData")
-	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
+
+// Options for the struct log tracer
+type TraceConfig struct {
+	// Omit the stack from each step
+	DisableStack bool `protobuf:"varint,1,opt,name=DisableStack,proto3" json:"DisableStack,omitempty"`
+	// Omit memory from each step
+	DisableMemory bool `protobuf:"varint,2,opt,name=DisableMemory,proto3" json:"DisableMemory,omitempty"`
+	// Omit the storage accessed by SLOAD and SSTORE steps
+	DisableStorage bool `protobuf:"varint,3,opt,name=DisableStorage,proto3" json:"DisableStorage,omitempty"`
 }
-func (m *BlockExecution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *TraceConfig) Reset()                    { *m = TraceConfig{} }
+func (m *TraceConfig) String() string            { return proto.CompactTextString(m) }
+func (*TraceConfig) ProtoMessage()               {}
+func (*TraceConfig) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{21} }
+
+func (m *TraceConfig) GetDisableStack() bool {
+	if m != nil {
+		return m.DisableStack
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BlockExecution) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Height != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.Height))
-	}
-	if m.BlockHeader != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.BlockHeader.Size()))
-		n1, err := m.BlockHeader.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.TxExecutions) > 0 {
-		for _, msg := range m.TxExecutions {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func (m *TraceConfig) GetDisableMemory() bool {
+	if m != nil {
+		return m.DisableMemory
 	}
-	return i, nil
+	return false
 }
 
-func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *TraceConfig) GetDisableStorage() bool {
+	if m != nil {
+		return m.DisableStorage
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
+func (*TraceConfig) XXX_MessageName() string {
+	return "exec.TraceConfig"
+}
+
+// A trace of EVM execution in the struct log format of geth's debug_traceTransaction
+type Trace struct {
+	// Gas used by the outermost call
+	Gas    uint64 `protobuf:"varint,1,opt,name=Gas,proto3" json:"gas"`
+	Failed bool   `protobuf:"varint,2,opt,name=Failed,proto3" json:"failed"`
+	// Hex-encoded return (or revert) data of the outermost call
+	ReturnValue string       `protobuf:"bytes,3,opt,name=ReturnValue,proto3" json:"returnValue"`
+	StructLogs  []*StructLog `protobuf:"bytes,4,rep,name=StructLogs" json:"structLogs"`
+}
+
+func (m *Trace) Reset()                    { *m = Trace{} }
+func (m *Trace) String() string            { return proto.CompactTextString(m) }
+func (*Trace) ProtoMessage()               {}
+func (*Trace) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{22} }
+
+func (m *Trace) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *Trace) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *Trace) GetReturnValue() string {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return ""
+}
+
+func (m *Trace) GetStructLogs() []*StructLog {
+	if m != nil {
+		return m.StructLogs
+	}
+	return nil
+}
+
+func (*Trace) XXX_MessageName() string {
+	return "exec.Trace"
+}
+
+// The machine state before an instruction was executed, words are hex-encoded without a prefix
+type StructLog struct {
+	PC uint64 `protobuf:"varint,1,opt,name=PC,proto3" json:"pc"`
+	Op string `protobuf:"bytes,2,opt,name=Op,proto3" json:"op"`
+	// Gas remaining before the instruction
+	Gas uint64 `protobuf:"varint,3,opt,name=Gas,proto3" json:"gas"`
+	// Gas used by the instruction including any calls it made
+	GasCost uint64   `protobuf:"varint,4,opt,name=GasCost,proto3" json:"gasCost"`
+	Depth   uint64   `protobuf:"varint,5,opt,name=Depth,proto3" json:"depth"`
+	Error   string   `protobuf:"bytes,6,opt,name=Error,proto3" json:"error,omitempty"`
+	Stack   []string `protobuf:"bytes,7,rep,name=Stack" json:"stack,omitempty"`
+	// Memory in 32-byte words
+	Memory  []string          `protobuf:"bytes,8,rep,name=Memory" json:"memory,omitempty"`
+	Storage map[string]string `protobuf:"bytes,9,rep,name=Storage" json:"storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *StructLog) Reset()                    { *m = StructLog{} }
+func (m *StructLog) String() string            { return proto.CompactTextString(m) }
+func (*StructLog) ProtoMessage()               {}
+func (*StructLog) Descriptor() ([]byte, []int) { return fileDescriptorExec, []int{23} }
+
+func (m *StructLog) GetPC() uint64 {
+	if m != nil {
+		return m.PC
+	}
+	return 0
+}
+
+func (m *StructLog) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *StructLog) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *StructLog) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func (m *StructLog) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *StructLog) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *StructLog) GetStack() []string {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *StructLog) GetMemory() []string {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *StructLog) GetStorage() map[string]string {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (*StructLog) XXX_MessageName() string {
+	return "exec.StructLog"
+}
+func init() {
+	proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
+	golang_proto.RegisterType((*BlockExecution)(nil), "exec.BlockExecution")
+	proto.RegisterType((*BlockHeader)(nil), "exec.BlockHeader")
+	golang_proto.RegisterType((*BlockHeader)(nil), "exec.BlockHeader")
+	proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
+	golang_proto.RegisterType((*TxExecution)(nil), "exec.TxExecution")
+	proto.RegisterType((*Header)(nil), "exec.Header")
+	golang_proto.RegisterType((*Header)(nil), "exec.Header")
+	proto.RegisterType((*Event)(nil), "exec.Event")
+	golang_proto.RegisterType((*Event)(nil), "exec.Event")
+	proto.RegisterType((*Result)(nil), "exec.Result")
+	golang_proto.RegisterType((*Result)(nil), "exec.Result")
+	proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
+	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
+	proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	golang_proto.RegisterType((*DecodedLog)(nil), "exec.DecodedLog")
+	proto.RegisterType((*EventArgument)(nil), "exec.EventArgument")
+	golang_proto.RegisterType((*EventArgument)(nil), "exec.EventArgument")
+	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
+	proto.RegisterType((*BondEvent)(nil), "exec.BondEvent")
+	golang_proto.RegisterType((*BondEvent)(nil), "exec.BondEvent")
+	proto.RegisterType((*FeeEvent)(nil), "exec.FeeEvent")
+	golang_proto.RegisterType((*FeeEvent)(nil), "exec.FeeEvent")
+	proto.RegisterType((*SlashEvent)(nil), "exec.SlashEvent")
+	golang_proto.RegisterType((*SlashEvent)(nil), "exec.SlashEvent")
+	proto.RegisterType((*ReleaseEvent)(nil), "exec.ReleaseEvent")
+	golang_proto.RegisterType((*ReleaseEvent)(nil), "exec.ReleaseEvent")
+	proto.RegisterType((*Jailing)(nil), "exec.Jailing")
+	golang_proto.RegisterType((*Jailing)(nil), "exec.Jailing")
+	proto.RegisterType((*MissedBlocks)(nil), "exec.MissedBlocks")
+	golang_proto.RegisterType((*MissedBlocks)(nil), "exec.MissedBlocks")
+	proto.RegisterType((*UnbondEvent)(nil), "exec.UnbondEvent")
+	golang_proto.RegisterType((*UnbondEvent)(nil), "exec.UnbondEvent")
+	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
+	golang_proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
+	proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
+	golang_proto.RegisterType((*OutputEvent)(nil), "exec.OutputEvent")
+	proto.RegisterType((*CallData)(nil), "exec.CallData")
+	golang_proto.RegisterType((*CallData)(nil), "exec.CallData")
+	proto.RegisterType((*TraceConfig)(nil), "exec.TraceConfig")
+	golang_proto.RegisterType((*TraceConfig)(nil), "exec.TraceConfig")
+	proto.RegisterType((*Trace)(nil), "exec.Trace")
+	golang_proto.RegisterType((*Trace)(nil), "exec.Trace")
+	proto.RegisterType((*StructLog)(nil), "exec.StructLog")
+	golang_proto.RegisterType((*StructLog)(nil), "exec.StructLog")
+	proto.RegisterMapType((map[string]string)(nil), "exec.StructLog.StorageEntry")
+	golang_proto.RegisterMapType((map[string]string)(nil), "exec.StructLog.StorageEntry")
+}
+func (m *BlockExecution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockExecution) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Height))
+	}
+	if m.BlockHeader != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.BlockHeader.Size()))
+		n1, err := m.BlockHeader.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.TxExecutions) > 0 {
+		for _, msg := range m.TxExecutions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.FeeEvents) > 0 {
+		for _, msg := range m.FeeEvents {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SlashEvents) > 0 {
+		for _, msg := range m.SlashEvents {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.ReleaseEvents) > 0 {
+		for _, msg := range m.ReleaseEvents {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *BlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if len(m.JSON) > 0 {
@@ -825,6 +1425,26 @@ func (m *Event) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n14
 	}
+	if m.Bond != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Bond.Size()))
+		n15, err := m.Bond.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.Unbond != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Unbond.Size()))
+		n16, err := m.Unbond.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
+	}
 	return i, nil
 }
 
@@ -858,21 +1478,21 @@ func (m *Result) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.NameEntry.Size()))
-		n15, err := m.NameEntry.MarshalTo(dAtA[i:])
+		n17, err := m.NameEntry.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	if m.PermArgs != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintExec(dAtA, i, uint64(m.PermArgs.Size()))
-		n16, err := m.PermArgs.MarshalTo(dAtA[i:])
+		n18, err := m.PermArgs.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	return i, nil
 }
@@ -895,19 +1515,19 @@ func (m *LogEvent) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n17, err := m.Address.MarshalTo(dAtA[i:])
+	n19, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n18, err := m.Data.MarshalTo(dAtA[i:])
+	n20, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n20
 	if len(m.Topics) > 0 {
 		for _, msg := range m.Topics {
 			dAtA[i] = 0x1a
@@ -920,10 +1540,20 @@ func (m *LogEvent) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Decoded != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Decoded.Size()))
+		n21, err := m.Decoded.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
 	return i, nil
 }
 
-func (m *CallEvent) Marshal() (dAtA []byte, err error) {
+func (m *DecodedLog) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -933,46 +1563,33 @@ func (m *CallEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *DecodedLog) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.CallData != nil {
+	if len(m.EventName) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.CallData.Size()))
-		n19, err := m.CallData.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Origin.Size()))
-	n20, err := m.Origin.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n20
-	if m.StackDepth != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.StackDepth))
+		i = encodeVarintExec(dAtA, i, uint64(len(m.EventName)))
+		i += copy(dAtA[i:], m.EventName)
 	}
-	dAtA[i] = 0x22
-	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Return.Size()))
-	n21, err := m.Return.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if len(m.Arguments) > 0 {
+		for _, msg := range m.Arguments {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	i += n21
 	return i, nil
 }
 
-func (m *GovernAccountEvent) Marshal() (dAtA []byte, err error) {
+func (m *EventArgument) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -982,26 +1599,34 @@ func (m *GovernAccountEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GovernAccountEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventArgument) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.AccountUpdate != nil {
+	if len(m.Name) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.AccountUpdate.Size()))
-		n22, err := m.AccountUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return i, nil
-}
-
-func (m *InputEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if len(m.Type) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i += copy(dAtA[i:], m.Type)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *CallEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
@@ -1010,23 +1635,46 @@ func (m *InputEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InputEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
+	if m.CallData != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.CallData.Size()))
+		n22, err := m.CallData.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	dAtA[i] = 0x12
 	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n23, err := m.Address.MarshalTo(dAtA[i:])
+	i = encodeVarintExec(dAtA, i, uint64(m.Origin.Size()))
+	n23, err := m.Origin.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
 	i += n23
+	if m.StackDepth != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.StackDepth))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Return.Size()))
+	n24, err := m.Return.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n24
 	return i, nil
 }
 
-func (m *OutputEvent) Marshal() (dAtA []byte, err error) {
+func (m *GovernAccountEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1036,23 +1684,25 @@ func (m *OutputEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OutputEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *GovernAccountEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	dAtA[i] = 0xa
-	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
-	n24, err := m.Address.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.AccountUpdate != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.AccountUpdate.Size()))
+		n25, err := m.AccountUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
-	i += n24
 	return i, nil
 }
 
-func (m *CallData) Marshal() (dAtA []byte, err error) {
+func (m *BondEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1062,298 +1712,2398 @@ func (m *CallData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CallData) MarshalTo(dAtA []byte) (int, error) {
+func (m *BondEvent) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	dAtA[i] = 0xa
 	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Caller.Size()))
-	n25, err := m.Caller.MarshalTo(dAtA[i:])
+	i = encodeVarintExec(dAtA, i, uint64(m.Validator.Size()))
+	n26, err := m.Validator.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Callee.Size()))
-	n26, err := m.Callee.MarshalTo(dAtA[i:])
+	i += n26
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Amount))
+	}
+	return i, nil
+}
+
+func (m *FeeEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	i += n26
-	dAtA[i] = 0x1a
+	return dAtA[:n], nil
+}
+
+func (m *FeeEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
 	i++
-	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
-	n27, err := m.Data.MarshalTo(dAtA[i:])
+	i = encodeVarintExec(dAtA, i, uint64(m.Recipient.Size()))
+	n27, err := m.Recipient.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
 	i += n27
-	if m.Value != 0 {
-		dAtA[i] = 0x20
+	if m.Amount != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.Value))
+		i = encodeVarintExec(dAtA, i, uint64(m.Amount))
 	}
-	if m.Gas != 0 {
-		dAtA[i] = 0x28
+	if m.Proposer {
+		dAtA[i] = 0x18
+		i++
+		if m.Proposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintExec(dAtA, i, uint64(m.Gas))
 	}
 	return i, nil
 }
 
-func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SlashEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *BlockExecution) Size() (n int) {
+
+func (m *SlashEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Validator.Size()))
+	n28, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n28
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
 	}
-	if len(m.TxExecutions) > 0 {
-		for _, e := range m.TxExecutions {
-			l = e.Size()
-			n += 1 + l + sovExec(uint64(l))
-		}
+	if m.Slashed != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Slashed))
 	}
-	return n
+	if m.Jailed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Jailed))
+	}
+	if m.ReleaseHeight != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.ReleaseHeight))
+	}
+	return i, nil
 }
 
-func (m *BlockHeader) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.JSON)
-	if l > 0 {
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if m.NumTxs != 0 {
-		n += 1 + sovExec(uint64(m.NumTxs))
+func (m *ReleaseEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *TxExecution) Size() (n int) {
+func (m *ReleaseEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.TxType != 0 {
-		n += 1 + sovExec(uint64(m.TxType))
-	}
-	l = m.TxHash.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
-	}
-	if m.Index != 0 {
-		n += 1 + sovExec(uint64(m.Index))
-	}
-	if m.Envelope != nil {
-		l = m.Envelope.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovExec(uint64(l))
-		}
-	}
-	if m.Result != nil {
-		l = m.Result.Size()
-		n += 1 + l + sovExec(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Validator.Size()))
+	n29, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Receipt != nil {
-		l = m.Receipt.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n29
+	if m.Power != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Power))
 	}
-	if m.Exception != nil {
-		l = m.Exception.Size()
-		n += 1 + l + sovExec(uint64(l))
+	return i, nil
+}
+
+func (m *Jailing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Header) Size() (n int) {
+func (m *Jailing) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.TxType != 0 {
-		n += 1 + sovExec(uint64(m.TxType))
-	}
-	l = m.TxHash.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if m.EventType != 0 {
-		n += 1 + sovExec(uint64(m.EventType))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.PublicKey.Size()))
+	n30, err := m.PublicKey.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	l = len(m.EventID)
-	if l > 0 {
-		n += 1 + l + sovExec(uint64(l))
+	i += n30
+	if m.Power != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Power))
 	}
 	if m.Height != 0 {
-		n += 1 + sovExec(uint64(m.Height))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Height))
 	}
-	if m.Index != 0 {
-		n += 1 + sovExec(uint64(m.Index))
+	if m.ReleaseHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.ReleaseHeight))
 	}
-	if m.Exception != nil {
-		l = m.Exception.Size()
-		n += 1 + l + sovExec(uint64(l))
+	return i, nil
+}
+
+func (m *MissedBlocks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Event) Size() (n int) {
+func (m *MissedBlocks) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Header != nil {
-		l = m.Header.Size()
-		n += 1 + l + sovExec(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
+	n31, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Input != nil {
-		l = m.Input.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n31
+	if len(m.Heights) > 0 {
+		dAtA33 := make([]byte, len(m.Heights)*10)
+		var j32 int
+		for _, num := range m.Heights {
+			for num >= 1<<7 {
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j32++
+			}
+			dAtA33[j32] = uint8(num)
+			j32++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(j32))
+		i += copy(dAtA[i:], dAtA33[:j32])
 	}
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovExec(uint64(l))
+	return i, nil
+}
+
+func (m *UnbondEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Call != nil {
-		l = m.Call.Size()
-		n += 1 + l + sovExec(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *UnbondEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Validator.Size()))
+	n34, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Log != nil {
-		l = m.Log.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n34
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.To.Size()))
+	n35, err := m.To.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.GovernAccount != nil {
-		l = m.GovernAccount.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n35
+	if m.Amount != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Amount))
 	}
-	return n
+	if m.ReleaseHeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.ReleaseHeight))
+	}
+	return i, nil
 }
 
-func (m *Result) Size() (n int) {
+func (m *InputEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InputEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Return)
-	if l > 0 {
-		n += 1 + l + sovExec(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
+	n36, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.GasUsed != 0 {
-		n += 1 + sovExec(uint64(m.GasUsed))
+	i += n36
+	return i, nil
+}
+
+func (m *OutputEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.NameEntry != nil {
-		l = m.NameEntry.Size()
-		n += 1 + l + sovExec(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *OutputEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Address.Size()))
+	n37, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.PermArgs != nil {
-		l = m.PermArgs.Size()
-		n += 1 + l + sovExec(uint64(l))
+	i += n37
+	return i, nil
+}
+
+func (m *CallData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LogEvent) Size() (n int) {
+func (m *CallData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovExec(uint64(l))
-	l = m.Data.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if len(m.Topics) > 0 {
-		for _, e := range m.Topics {
-			l = e.Size()
-			n += 1 + l + sovExec(uint64(l))
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Caller.Size()))
+	n38, err := m.Caller.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n38
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Callee.Size()))
+	n39, err := m.Callee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n39
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintExec(dAtA, i, uint64(m.Data.Size()))
+	n40, err := m.Data.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n40
+	if m.Value != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Value))
+	}
+	if m.Gas != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Gas))
+	}
+	return i, nil
+}
+
+func (m *TraceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceConfig) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.DisableStack {
+		dAtA[i] = 0x8
+		i++
+		if m.DisableStack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DisableMemory {
+		dAtA[i] = 0x10
+		i++
+		if m.DisableMemory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DisableStorage {
+		dAtA[i] = 0x18
+		i++
+		if m.DisableStorage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Trace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trace) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Gas))
+	}
+	if m.Failed {
+		dAtA[i] = 0x10
+		i++
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.ReturnValue) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.ReturnValue)))
+		i += copy(dAtA[i:], m.ReturnValue)
+	}
+	if len(m.StructLogs) > 0 {
+		for _, msg := range m.StructLogs {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *StructLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StructLog) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PC != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.PC))
+	}
+	if len(m.Op) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Op)))
+		i += copy(dAtA[i:], m.Op)
+	}
+	if m.Gas != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Gas))
+	}
+	if m.GasCost != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.GasCost))
+	}
+	if m.Depth != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(m.Depth))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Memory) > 0 {
+		for _, s := range m.Memory {
+			dAtA[i] = 0x42
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Storage) > 0 {
+		for k, _ := range m.Storage {
+			dAtA[i] = 0x4a
+			i++
+			v := m.Storage[k]
+			mapSize := 1 + len(k) + sovExec(uint64(len(k))) + 1 + len(v) + sovExec(uint64(len(v)))
+			i = encodeVarintExec(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintExec(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func encodeVarintExec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BlockExecution) Size() (n int) {
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.TxExecutions) > 0 {
+		for _, e := range m.TxExecutions {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.FeeEvents) > 0 {
+		for _, e := range m.FeeEvents {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.SlashEvents) > 0 {
+		for _, e := range m.SlashEvents {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.ReleaseEvents) > 0 {
+		for _, e := range m.ReleaseEvents {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockHeader) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.JSON)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovExec(uint64(m.NumTxs))
+	}
+	return n
+}
+
+func (m *TxExecution) Size() (n int) {
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovExec(uint64(m.TxType))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovExec(uint64(m.Index))
+	}
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *Header) Size() (n int) {
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovExec(uint64(m.TxType))
+	}
+	l = m.TxHash.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.EventType != 0 {
+		n += 1 + sovExec(uint64(m.EventType))
+	}
+	l = len(m.EventID)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovExec(uint64(m.Index))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Call != nil {
+		l = m.Call.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Log != nil {
+		l = m.Log.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.GovernAccount != nil {
+		l = m.GovernAccount.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Bond != nil {
+		l = m.Bond.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Unbond != nil {
+		l = m.Unbond.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *Result) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Return)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovExec(uint64(m.GasUsed))
+	}
+	if m.NameEntry != nil {
+		l = m.NameEntry.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.PermArgs != nil {
+		l = m.PermArgs.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *LogEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Data.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if len(m.Topics) > 0 {
+		for _, e := range m.Topics {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *DecodedLog) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.EventName)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, e := range m.Arguments {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventArgument) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *CallEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.CallData != nil {
+		l = m.CallData.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = m.Origin.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.StackDepth != 0 {
+		n += 1 + sovExec(uint64(m.StackDepth))
+	}
+	l = m.Return.Size()
+	n += 1 + l + sovExec(uint64(l))
+	return n
+}
+
+func (m *GovernAccountEvent) Size() (n int) {
+	var l int
+	_ = l
+	if m.AccountUpdate != nil {
+		l = m.AccountUpdate.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	return n
+}
+
+func (m *BondEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovExec(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *FeeEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Recipient.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovExec(uint64(m.Amount))
+	}
+	if m.Proposer {
+		n += 2
+	}
+	return n
+}
+
+func (m *SlashEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Slashed != 0 {
+		n += 1 + sovExec(uint64(m.Slashed))
+	}
+	if m.Jailed != 0 {
+		n += 1 + sovExec(uint64(m.Jailed))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovExec(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *ReleaseEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovExec(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *Jailing) Size() (n int) {
+	var l int
+	_ = l
+	l = m.PublicKey.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovExec(uint64(m.Power))
+	}
+	if m.Height != 0 {
+		n += 1 + sovExec(uint64(m.Height))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovExec(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *MissedBlocks) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovExec(uint64(e))
+		}
+		n += 1 + sovExec(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *UnbondEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.To.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Amount != 0 {
+		n += 1 + sovExec(uint64(m.Amount))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovExec(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *InputEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	return n
+}
+
+func (m *OutputEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovExec(uint64(l))
+	return n
+}
+
+func (m *CallData) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Caller.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Callee.Size()
+	n += 1 + l + sovExec(uint64(l))
+	l = m.Data.Size()
+	n += 1 + l + sovExec(uint64(l))
+	if m.Value != 0 {
+		n += 1 + sovExec(uint64(m.Value))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovExec(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *TraceConfig) Size() (n int) {
+	var l int
+	_ = l
+	if m.DisableStack {
+		n += 2
+	}
+	if m.DisableMemory {
+		n += 2
+	}
+	if m.DisableStorage {
+		n += 2
+	}
+	return n
+}
+
+func (m *Trace) Size() (n int) {
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovExec(uint64(m.Gas))
+	}
+	if m.Failed {
+		n += 2
+	}
+	l = len(m.ReturnValue)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.StructLogs) > 0 {
+		for _, e := range m.StructLogs {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StructLog) Size() (n int) {
+	var l int
+	_ = l
+	if m.PC != 0 {
+		n += 1 + sovExec(uint64(m.PC))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovExec(uint64(m.Gas))
+	}
+	if m.GasCost != 0 {
+		n += 1 + sovExec(uint64(m.GasCost))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovExec(uint64(m.Depth))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Stack) > 0 {
+		for _, s := range m.Stack {
+			l = len(s)
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.Memory) > 0 {
+		for _, s := range m.Memory {
+			l = len(s)
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if len(m.Storage) > 0 {
+		for k, v := range m.Storage {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovExec(uint64(len(k))) + 1 + len(v) + sovExec(uint64(len(v)))
+			n += mapEntrySize + 1 + sovExec(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func sovExec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozExec(x uint64) (n int) {
+	return sovExec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeader == nil {
+				m.BlockHeader = &BlockHeader{}
+			}
+			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeEvents = append(m.FeeEvents, &FeeEvent{})
+			if err := m.FeeEvents[len(m.FeeEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashEvents = append(m.SlashEvents, &SlashEvent{})
+			if err := m.SlashEvents[len(m.SlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEvents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseEvents = append(m.ReleaseEvents, &ReleaseEvent{})
+			if err := m.ReleaseEvents[len(m.ReleaseEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JSON = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &Result{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &txs.Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+			}
+			m.EventType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventType |= (EventType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &Header{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Input == nil {
+				m.Input = &InputEvent{}
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &OutputEvent{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Call == nil {
+				m.Call = &CallEvent{}
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Log == nil {
+				m.Log = &LogEvent{}
+			}
+			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GovernAccount == nil {
+				m.GovernAccount = &GovernAccountEvent{}
+			}
+			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bond == nil {
+				m.Bond = &BondEvent{}
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Unbond == nil {
+				m.Unbond = &UnbondEvent{}
+			}
+			if err := m.Unbond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
+			if m.Return == nil {
+				m.Return = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NameEntry == nil {
+				m.NameEntry = &names.Entry{}
+			}
+			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PermArgs == nil {
+				m.PermArgs = &permission.PermArgs{}
+			}
+			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *CallEvent) Size() (n int) {
-	var l int
-	_ = l
-	if m.CallData != nil {
-		l = m.CallData.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	l = m.Origin.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if m.StackDepth != 0 {
-		n += 1 + sovExec(uint64(m.StackDepth))
-	}
-	l = m.Return.Size()
-	n += 1 + l + sovExec(uint64(l))
-	return n
-}
-
-func (m *GovernAccountEvent) Size() (n int) {
-	var l int
-	_ = l
-	if m.AccountUpdate != nil {
-		l = m.AccountUpdate.Size()
-		n += 1 + l + sovExec(uint64(l))
-	}
-	return n
-}
-
-func (m *InputEvent) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovExec(uint64(l))
-	return n
-}
-
-func (m *OutputEvent) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Address.Size()
-	n += 1 + l + sovExec(uint64(l))
-	return n
-}
-
-func (m *CallData) Size() (n int) {
-	var l int
-	_ = l
-	l = m.Caller.Size()
-	n += 1 + l + sovExec(uint64(l))
-	l = m.Callee.Size()
-	n += 1 + l + sovExec(uint64(l))
-	l = m.Data.Size()
-	n += 1 + l + sovExec(uint64(l))
-	if m.Value != 0 {
-		n += 1 + sovExec(uint64(m.Value))
-	}
-	if m.Gas != 0 {
-		n += 1 + sovExec(uint64(m.Gas))
-	}
-	return n
-}
 
-func sovExec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozExec(x uint64) (n int) {
-	return sovExec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockExecution) Unmarshal(dAtA []byte) error {
+func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1376,17 +4126,17 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1396,14 +4146,87 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_binary.Word256
+			m.Topics = append(m.Topics, v)
+			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1415,28 +4238,107 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decoded == nil {
+				m.Decoded = &DecodedLog{}
+			}
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DecodedLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DecodedLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DecodedLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeader == nil {
-				m.BlockHeader = &BlockHeader{}
-			}
-			if err := m.BlockHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EventName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1460,8 +4362,8 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxExecutions = append(m.TxExecutions, &TxExecution{})
-			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Arguments = append(m.Arguments, &EventArgument{})
+			if err := m.Arguments[len(m.Arguments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1486,7 +4388,7 @@ func (m *BlockExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeader) Unmarshal(dAtA []byte) error {
+func (m *EventArgument) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1509,15 +4411,15 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventArgument: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventArgument: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JSON", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1542,13 +4444,13 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JSON = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.NumTxs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1558,11 +4460,50 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumTxs |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -1584,7 +4525,7 @@ func (m *BlockHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxExecution) Unmarshal(dAtA []byte) error {
+func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,17 +4548,17 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxExecution: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
 			}
-			m.TxType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1627,14 +4568,28 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallData == nil {
+				m.CallData = &CallData{}
+			}
+			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1658,15 +4613,15 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
 			}
-			m.Height = 0
+			m.StackDepth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1676,16 +4631,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.StackDepth |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
 			}
-			m.Index = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1695,16 +4650,160 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AccountUpdate == nil {
+				m.AccountUpdate = &spec.TemplateAccount{}
+			}
+			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1714,30 +4813,27 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
-			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1747,28 +4843,66 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Amount |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Events = append(m.Events, &Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1778,30 +4912,27 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Result == nil {
-				m.Result = &Result{}
-			}
-			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Recipient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1811,30 +4942,16 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Amount |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Receipt == nil {
-				m.Receipt = &txs.Receipt{}
-			}
-			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1844,25 +4961,12 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Proposer = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -1884,7 +4988,7 @@ func (m *TxExecution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *SlashEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1907,17 +5011,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.TxType = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1927,16 +5031,27 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxType |= (github_com_hyperledger_burrow_txs_payload.Type(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1946,27 +5061,26 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
-			m.EventType = 0
+			m.Slashed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1976,16 +5090,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventType |= (EventType(b) & 0x7F) << shift
+				m.Slashed |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventID", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var stringLen uint64
+			m.Jailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -1995,26 +5109,16 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Jailed |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EventID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			m.Height = 0
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2024,16 +5128,66 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= (uint64(b) & 0x7F) << shift
+				m.ReleaseHeight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Index = 0
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2043,16 +5197,27 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2062,25 +5227,11 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Power |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Exception == nil {
-				m.Exception = &errors.Exception{}
-			}
-			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2102,7 +5253,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *Jailing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2125,15 +5276,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: Jailing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Jailing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2157,18 +5308,15 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Header == nil {
-				m.Header = &Header{}
-			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2178,30 +5326,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Power |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Input == nil {
-				m.Input = &InputEvent{}
-			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var msglen int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2211,30 +5345,16 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Height |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Output == nil {
-				m.Output = &OutputEvent{}
-			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var msglen int
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2244,30 +5364,66 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ReleaseHeight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Call == nil {
-				m.Call = &CallEvent{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissedBlocks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissedBlocks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissedBlocks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2277,58 +5433,95 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Log == nil {
-				m.Log = &LogEvent{}
-			}
-			if err := m.Log.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovernAccount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthExec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.GovernAccount == nil {
-				m.GovernAccount = &GovernAccountEvent{}
-			}
-			if err := m.GovernAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2350,7 +5543,7 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *UnbondEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +5566,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnbondEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnbondEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2405,16 +5598,15 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Return = append(m.Return[:0], dAtA[iNdEx:postIndex]...)
-			if m.Return == nil {
-				m.Return = []byte{}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
-			m.GasUsed = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2424,16 +5616,27 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasUsed |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NameEntry", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2443,30 +5646,16 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Amount |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.NameEntry == nil {
-				m.NameEntry = &names.Entry{}
-			}
-			if err := m.NameEntry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PermArgs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
 			}
-			var msglen int
+			m.ReleaseHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2476,25 +5665,11 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ReleaseHeight |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PermArgs == nil {
-				m.PermArgs = &permission.PermArgs{}
-			}
-			if err := m.PermArgs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2516,7 +5691,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LogEvent) Unmarshal(dAtA []byte) error {
+func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,10 +5714,10 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LogEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LogEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2575,39 +5750,59 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowExec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2631,9 +5826,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_hyperledger_burrow_binary.Word256
-			m.Topics = append(m.Topics, v)
-			if err := m.Topics[len(m.Topics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2658,7 +5851,7 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallEvent) Unmarshal(dAtA []byte) error {
+func (m *CallData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,17 +5874,17 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CallData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2701,28 +5894,25 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CallData == nil {
-				m.CallData = &CallData{}
-			}
-			if err := m.CallData.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2746,15 +5936,15 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StackDepth", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			m.StackDepth = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2764,16 +5954,27 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StackDepth |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Return", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var byteLen int
+			m.Value = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2783,22 +5984,30 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Value |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
 			}
-			if err := m.Return.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2820,7 +6029,7 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
+func (m *TraceConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2843,17 +6052,17 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GovernAccountEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GovernAccountEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountUpdate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStack", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2863,80 +6072,17 @@ func (m *GovernAccountEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.AccountUpdate == nil {
-				m.AccountUpdate = &spec.TemplateAccount{}
-			}
-			if err := m.AccountUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthExec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InputEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowExec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InputEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			m.DisableStack = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableMemory", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -2946,22 +6092,32 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthExec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DisableMemory = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableStorage", wireType)
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.DisableStorage = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -2983,7 +6139,7 @@ func (m *InputEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutputEvent) Unmarshal(dAtA []byte) error {
+func (m *Trace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,17 +6162,56 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutputEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutputEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnValue", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3026,19 +6221,49 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReturnValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructLogs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StructLogs = append(m.StructLogs, &StructLog{})
+			if err := m.StructLogs[len(m.StructLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3063,7 +6288,7 @@ func (m *OutputEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CallData) Unmarshal(dAtA []byte) error {
+func (m *StructLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3086,17 +6311,36 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallData: wiretype end group for non-group")
+			return fmt.Errorf("proto: StructLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StructLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PC", wireType)
+			}
+			m.PC = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PC |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3106,27 +6350,83 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Caller.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Op = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCost", wireType)
+			}
+			m.GasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCost |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Callee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3136,27 +6436,26 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Callee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stack", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3166,27 +6465,26 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthExec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Stack = append(m.Stack, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			m.Value = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3196,16 +6494,26 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
 			}
-			m.Gas = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memory = append(m.Memory, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowExec
@@ -3215,11 +6523,116 @@ func (m *CallData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Gas |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Storage == nil {
+				m.Storage = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthExec
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthExec
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthExec
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthExec
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipExec(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthExec
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Storage[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -3350,67 +6763,112 @@ func init() { proto.RegisterFile("exec.proto", fileDescriptorExec) }
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptorExec) }
 
 var fileDescriptorExec = []byte{
-	// 979 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x5f, 0x37, 0xce, 0xbf, 0x97, 0x64, 0x29, 0xa3, 0x82, 0xac, 0x3d, 0x24, 0x91, 0x17, 0xad,
-	0x4a, 0x61, 0x1d, 0x94, 0xa5, 0x48, 0x80, 0x84, 0xd4, 0xd0, 0xa8, 0xed, 0xaa, 0xb4, 0x30, 0x9b,
-	0x05, 0x81, 0xe0, 0xe0, 0x38, 0x83, 0x6b, 0xad, 0xe3, 0xb1, 0xc6, 0xe3, 0xe2, 0x7c, 0x08, 0x6e,
-	0x1c, 0x96, 0x1b, 0x1f, 0x85, 0x63, 0x6f, 0x70, 0xe1, 0xb2, 0x87, 0x08, 0x75, 0x3f, 0x02, 0x9c,
-	0xf6, 0x84, 0xe6, 0x8f, 0x1d, 0x47, 0xa0, 0x2d, 0xda, 0xf6, 0x36, 0xef, 0xbd, 0xdf, 0xfc, 0xe6,
-	0xcd, 0x7b, 0xbf, 0x37, 0x36, 0x00, 0xc9, 0x88, 0xe7, 0xc4, 0x8c, 0x72, 0x8a, 0x4c, 0xb1, 0xbe,
-	0x73, 0xdf, 0x0f, 0xf8, 0x59, 0x3a, 0x75, 0x3c, 0x3a, 0x1f, 0xf8, 0xd4, 0xa7, 0x03, 0x19, 0x9c,
-	0xa6, 0xdf, 0x4b, 0x4b, 0x1a, 0x72, 0xa5, 0x36, 0xdd, 0x69, 0x13, 0xc6, 0x28, 0x4b, 0xb4, 0xd5,
-	0x8a, 0xdc, 0x39, 0xc9, 0x8d, 0x26, 0xcf, 0xf2, 0xe5, 0x66, 0x4c, 0xd8, 0x3c, 0x48, 0x92, 0x80,
-	0x46, 0xda, 0x03, 0x49, 0x9c, 0x1f, 0x6c, 0xff, 0x64, 0xc0, 0xed, 0x51, 0x48, 0xbd, 0x27, 0xe3,
-	0x8c, 0x78, 0x29, 0x0f, 0x68, 0x84, 0xde, 0x84, 0xda, 0x21, 0x09, 0xfc, 0x33, 0x6e, 0x19, 0x7d,
-	0x63, 0xdb, 0xc4, 0xda, 0x42, 0x0f, 0xa0, 0x25, 0x91, 0x87, 0xc4, 0x9d, 0x11, 0x66, 0x6d, 0xf4,
-	0x8d, 0xed, 0xd6, 0xf0, 0x75, 0x47, 0xde, 0xa2, 0x14, 0xc0, 0x65, 0x14, 0xda, 0x85, 0xf6, 0x24,
-	0x2b, 0xb8, 0x13, 0xab, 0xd2, 0xaf, 0xac, 0x76, 0x95, 0x22, 0x78, 0x0d, 0x66, 0x7f, 0xb8, 0x76,
-	0x16, 0x42, 0x60, 0x3e, 0x7c, 0x74, 0x7a, 0x22, 0x13, 0x6a, 0x62, 0xb9, 0x16, 0x69, 0x9e, 0xa4,
-	0xf3, 0x49, 0x96, 0xc8, 0x4c, 0xaa, 0x58, 0x5b, 0xf6, 0x1f, 0x15, 0x68, 0x95, 0xb8, 0xd0, 0x43,
-	0xa8, 0x4d, 0xb2, 0xc9, 0x22, 0x26, 0x12, 0xd7, 0x19, 0x0d, 0x5f, 0x2c, 0x7b, 0x4e, 0xa9, 0xd0,
-	0x67, 0x8b, 0x98, 0xb0, 0x90, 0xcc, 0x7c, 0xc2, 0x06, 0xd3, 0x94, 0x31, 0xfa, 0xc3, 0x80, 0x67,
-	0xc9, 0x20, 0x76, 0x17, 0x21, 0x75, 0x67, 0x8e, 0xd8, 0x89, 0x35, 0x03, 0xfa, 0x4c, 0x70, 0x1d,
-	0xba, 0xc9, 0x99, 0x55, 0xe9, 0x1b, 0xdb, 0xed, 0xd1, 0xee, 0xc5, 0xb2, 0x77, 0xeb, 0xd9, 0xb2,
-	0x77, 0xff, 0xe5, 0x7c, 0xd3, 0x20, 0x72, 0xd9, 0xc2, 0x39, 0x24, 0xd9, 0x68, 0xc1, 0x49, 0x82,
-	0x35, 0x49, 0xa9, 0xd2, 0xe6, 0x5a, 0xa5, 0xb7, 0xa0, 0x7a, 0x14, 0xcd, 0x48, 0x66, 0x55, 0xa5,
-	0x5b, 0x19, 0xe8, 0x6b, 0x68, 0x8c, 0xa3, 0x73, 0x12, 0xd2, 0x98, 0x58, 0x35, 0x59, 0xfc, 0x8e,
-	0x23, 0xda, 0x9c, 0x3b, 0x47, 0xce, 0xb3, 0x65, 0x6f, 0xe7, 0xca, 0x9b, 0x15, 0x78, 0x5c, 0xd0,
-	0xa1, 0xbb, 0x50, 0x1b, 0x9f, 0x93, 0x88, 0x27, 0x56, 0x5d, 0xf6, 0xa7, 0xa5, 0xfa, 0x23, 0x7d,
-	0x58, 0x87, 0xd0, 0x5b, 0x50, 0xc3, 0x24, 0x49, 0x43, 0x6e, 0x35, 0xe4, 0xe9, 0x6d, 0x05, 0x52,
-	0x3e, 0xac, 0x63, 0xe8, 0x1e, 0xd4, 0x31, 0xf1, 0x48, 0x10, 0x73, 0xab, 0xa9, 0x61, 0xe2, 0x50,
-	0xed, 0xc3, 0x79, 0x10, 0x0d, 0xa0, 0x39, 0xce, 0x3c, 0x12, 0x8b, 0x1e, 0x59, 0x90, 0x6b, 0x49,
-	0x09, 0xba, 0x08, 0xe0, 0x15, 0xc6, 0xfe, 0x6d, 0x43, 0x54, 0x4b, 0xca, 0x61, 0xd5, 0x52, 0xe3,
-	0x06, 0x5b, 0xba, 0x71, 0x13, 0x2d, 0x7d, 0x07, 0x9a, 0xb2, 0x5c, 0x32, 0xbb, 0x8a, 0xcc, 0xae,
-	0xf3, 0x62, 0xd9, 0x5b, 0x39, 0xf1, 0x6a, 0x89, 0x2c, 0xa8, 0x4b, 0xe3, 0x68, 0x5f, 0x0a, 0xa0,
-	0x89, 0x73, 0xb3, 0xa4, 0x8c, 0xea, 0x7f, 0x2b, 0xa3, 0x56, 0x56, 0xc6, 0x5a, 0x2d, 0xeb, 0x57,
-	0xd7, 0xf2, 0x23, 0xf3, 0xe9, 0x2f, 0xbd, 0x5b, 0xf6, 0x8f, 0x1b, 0x50, 0x95, 0x07, 0x8a, 0xd6,
-	0xea, 0xa9, 0x36, 0xca, 0xad, 0xd5, 0x03, 0x9d, 0x97, 0xfd, 0x9e, 0x38, 0x3c, 0x4e, 0xb9, 0x1e,
-	0xfd, 0x4d, 0x05, 0x92, 0x2e, 0xa5, 0x14, 0x15, 0x46, 0x6f, 0x43, 0xed, 0x34, 0xe5, 0x02, 0x58,
-	0x29, 0xbf, 0x11, 0xca, 0xa7, 0x35, 0xa5, 0x0c, 0x74, 0x17, 0xcc, 0x4f, 0xdd, 0x30, 0x94, 0xd7,
-	0x6f, 0x0d, 0x5f, 0x53, 0x40, 0xe1, 0x51, 0x30, 0x19, 0x44, 0x7d, 0xa8, 0x1c, 0x53, 0x5f, 0x56,
-	0xa2, 0x35, 0xbc, 0xad, 0x30, 0xc7, 0xd4, 0x57, 0x10, 0x11, 0x42, 0x9f, 0x40, 0xe7, 0x80, 0x9e,
-	0x13, 0x16, 0xed, 0x79, 0x1e, 0x4d, 0x23, 0xae, 0xe7, 0xc3, 0x52, 0xd8, 0xb5, 0x90, 0xda, 0xb5,
-	0x0e, 0xd7, 0xf5, 0x78, 0x6a, 0xe4, 0x0a, 0x17, 0xf5, 0xc7, 0x84, 0xa7, 0x2c, 0x92, 0x05, 0x69,
-	0x63, 0x6d, 0x89, 0x8e, 0x1d, 0xb8, 0xc9, 0xe3, 0x84, 0xcc, 0x64, 0x11, 0x4c, 0x9c, 0x9b, 0x68,
-	0x07, 0x9a, 0x27, 0xee, 0x9c, 0x8c, 0x23, 0xce, 0x16, 0xfa, 0xde, 0x6d, 0x47, 0x3d, 0xc9, 0xd2,
-	0x87, 0x57, 0x61, 0xf4, 0x1e, 0x34, 0x3e, 0x27, 0x6c, 0xbe, 0xc7, 0xfc, 0x44, 0xdf, 0x7c, 0xcb,
-	0x29, 0xbd, 0xd2, 0x79, 0x0c, 0x17, 0x28, 0xfb, 0x2f, 0x03, 0x1a, 0xf9, 0x95, 0xd1, 0x09, 0xd4,
-	0xf7, 0x66, 0x33, 0x46, 0x92, 0x44, 0x65, 0x37, 0x7a, 0x5f, 0x6b, 0xf6, 0xdd, 0x97, 0x6b, 0xd6,
-	0x63, 0x8b, 0x98, 0x53, 0x47, 0xef, 0xc5, 0x39, 0x09, 0x3a, 0x02, 0x73, 0xdf, 0xe5, 0xee, 0xf5,
-	0x06, 0x40, 0x52, 0xa0, 0x63, 0xa8, 0x4d, 0x68, 0x1c, 0x78, 0xea, 0xa1, 0xff, 0xdf, 0x99, 0x69,
-	0xb2, 0xaf, 0x28, 0x9b, 0x0d, 0x77, 0x3f, 0xc0, 0x9a, 0xc3, 0xfe, 0xdb, 0x80, 0x66, 0x21, 0x06,
-	0xb4, 0x03, 0x0d, 0x61, 0xc8, 0x54, 0x8d, 0xb2, 0x16, 0x72, 0x2f, 0x2e, 0xe2, 0x22, 0x8f, 0x53,
-	0x16, 0xf8, 0x41, 0xa4, 0x2f, 0xf5, 0x6a, 0x15, 0xd2, 0x1c, 0xa8, 0x0b, 0xf0, 0x88, 0xbb, 0xde,
-	0x93, 0x7d, 0x12, 0x73, 0xf5, 0xf4, 0x9b, 0xb8, 0xe4, 0x11, 0x6f, 0x88, 0x56, 0x8b, 0x79, 0xad,
-	0x37, 0x44, 0x91, 0xd8, 0x5f, 0x00, 0xfa, 0xb7, 0x64, 0xd1, 0xc7, 0xd0, 0xd1, 0xf6, 0xe3, 0x78,
-	0xe6, 0x72, 0xa2, 0x6b, 0xf0, 0x86, 0x23, 0xbf, 0xe6, 0x13, 0x32, 0x8f, 0x43, 0x97, 0x13, 0x0d,
-	0xc1, 0xeb, 0x58, 0xfb, 0x5b, 0x80, 0xd5, 0x9c, 0xde, 0xb4, 0x80, 0xec, 0xef, 0xa0, 0x55, 0x1a,
-	0xee, 0x1b, 0xa7, 0xff, 0x79, 0x03, 0xd6, 0x3a, 0x2b, 0xd6, 0xfa, 0xa9, 0x7a, 0xe5, 0xce, 0x2a,
-	0x8e, 0x82, 0x8d, 0x5c, 0x4f, 0x27, 0x8a, 0xa3, 0x18, 0xa4, 0xca, 0xf5, 0x07, 0x69, 0x0b, 0xaa,
-	0x5f, 0xba, 0x61, 0x4a, 0xf4, 0x9f, 0x81, 0x32, 0xd0, 0x26, 0x54, 0x0e, 0xdc, 0x44, 0x7f, 0x13,
-	0xc4, 0x72, 0x34, 0xba, 0xb8, 0xec, 0x1a, 0xbf, 0x5f, 0x76, 0x8d, 0x3f, 0x2f, 0xbb, 0xc6, 0xaf,
-	0xcf, 0xbb, 0xc6, 0xc5, 0xf3, 0xae, 0xf1, 0xcd, 0x15, 0xe9, 0x93, 0xfc, 0xe7, 0x48, 0xae, 0xa6,
-	0x35, 0xf9, 0x2b, 0xf8, 0xe0, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xaa, 0x48, 0xa0, 0x91,
-	0x0a, 0x00, 0x00,
+	// 1710 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcb, 0x6f, 0x1b, 0xc7,
+	0x19, 0xcf, 0x92, 0xcb, 0xc7, 0x7e, 0xa4, 0x64, 0x79, 0xa2, 0x04, 0x5b, 0x21, 0xd0, 0x0a, 0x1b,
+	0xd7, 0x70, 0x54, 0x85, 0x6a, 0x94, 0xaa, 0x48, 0x5d, 0xa0, 0x85, 0x56, 0x52, 0x2c, 0xbb, 0x7a,
+	0x75, 0x4c, 0xa7, 0x68, 0xd1, 0x1c, 0x96, 0xbb, 0xe3, 0xd5, 0xc2, 0xe4, 0xce, 0x62, 0x76, 0x29,
+	0x93, 0xa7, 0xfe, 0x0f, 0x45, 0x0f, 0xe9, 0xa9, 0xfd, 0x07, 0x0a, 0xf4, 0xdc, 0x53, 0x8f, 0xbe,
+	0x35, 0x97, 0x5e, 0x02, 0x94, 0x28, 0x1c, 0xf4, 0xc2, 0x73, 0x4f, 0x39, 0x15, 0xf3, 0xd8, 0x97,
+	0xdf, 0x28, 0xe5, 0xdb, 0x7c, 0xbf, 0xef, 0xb7, 0xdf, 0xcc, 0x7c, 0xcf, 0x21, 0x01, 0xc8, 0x84,
+	0x78, 0xbd, 0x98, 0xd1, 0x94, 0x22, 0x9d, 0xaf, 0xd7, 0x3e, 0x0e, 0xc2, 0xf4, 0x62, 0x3c, 0xe8,
+	0x79, 0x74, 0xb4, 0x1d, 0xd0, 0x80, 0x6e, 0x0b, 0xe5, 0x60, 0xfc, 0x50, 0x48, 0x42, 0x10, 0x2b,
+	0xf9, 0xd1, 0x5a, 0x97, 0x30, 0x46, 0x59, 0xa2, 0xa4, 0x4e, 0xe4, 0x8e, 0x48, 0x26, 0x18, 0xe9,
+	0x24, 0x5b, 0xae, 0xc4, 0x84, 0x8d, 0xc2, 0x24, 0x09, 0x69, 0xa4, 0x10, 0x48, 0xe2, 0x6c, 0xe3,
+	0xb5, 0xae, 0xc7, 0xa6, 0x71, 0xaa, 0x2c, 0xda, 0x7f, 0xad, 0xc1, 0xb2, 0x33, 0xa4, 0xde, 0xa3,
+	0xc3, 0x09, 0xf1, 0xc6, 0x69, 0x48, 0x23, 0xf4, 0x3e, 0x34, 0x8f, 0x48, 0x18, 0x5c, 0xa4, 0xa6,
+	0xb6, 0xa1, 0xdd, 0xd2, 0xb1, 0x92, 0xd0, 0xa7, 0xd0, 0x11, 0xcc, 0x23, 0xe2, 0xfa, 0x84, 0x99,
+	0xb5, 0x0d, 0xed, 0x56, 0x67, 0xe7, 0x7a, 0x4f, 0xdc, 0xa9, 0xa4, 0xc0, 0x65, 0x16, 0xda, 0x85,
+	0x6e, 0x7f, 0x92, 0xdb, 0x4e, 0xcc, 0xfa, 0x46, 0xbd, 0xf8, 0xaa, 0xa4, 0xc1, 0x15, 0x1a, 0xda,
+	0x02, 0xe3, 0x73, 0x42, 0x0e, 0x2f, 0x49, 0x94, 0x26, 0xa6, 0x2e, 0xbe, 0x59, 0x96, 0xdf, 0x64,
+	0x30, 0x2e, 0x08, 0x68, 0x07, 0x3a, 0xf7, 0x87, 0x6e, 0x72, 0xa1, 0xf8, 0x0d, 0xc1, 0x5f, 0x91,
+	0xfc, 0x42, 0x81, 0xcb, 0x24, 0xf4, 0x19, 0x2c, 0x61, 0x32, 0x24, 0x6e, 0x92, 0xed, 0xd2, 0x14,
+	0x5f, 0x21, 0xf9, 0x55, 0x59, 0x85, 0xab, 0x44, 0xfb, 0x27, 0x15, 0x3f, 0x20, 0x04, 0xfa, 0xbd,
+	0xfb, 0x67, 0xa7, 0xc2, 0x59, 0x06, 0x16, 0x6b, 0xee, 0xc2, 0xd3, 0xf1, 0xa8, 0x3f, 0x49, 0x84,
+	0x97, 0x1a, 0x58, 0x49, 0xf6, 0x3f, 0xeb, 0xd0, 0x29, 0xdd, 0x13, 0xdd, 0x83, 0x66, 0x7f, 0xd2,
+	0x9f, 0xc6, 0x44, 0xf0, 0x96, 0x9c, 0x9d, 0xef, 0x66, 0x56, 0xaf, 0x94, 0x12, 0x17, 0xd3, 0x98,
+	0xb0, 0x21, 0xf1, 0x03, 0xc2, 0xb6, 0x07, 0x63, 0xc6, 0xe8, 0xe3, 0xed, 0x74, 0x92, 0x6c, 0xc7,
+	0xee, 0x74, 0x48, 0x5d, 0xbf, 0xc7, 0xbf, 0xc4, 0xca, 0x02, 0x3a, 0xe1, 0xb6, 0x8e, 0xdc, 0xe4,
+	0xc2, 0xac, 0x6f, 0x68, 0xb7, 0xba, 0xce, 0xee, 0x93, 0x99, 0xf5, 0xce, 0x37, 0x33, 0xeb, 0xe3,
+	0x57, 0xdb, 0x1b, 0x84, 0x91, 0xcb, 0xa6, 0xbd, 0x23, 0x32, 0x71, 0xa6, 0x29, 0x49, 0xb0, 0x32,
+	0x52, 0xca, 0x02, 0xbd, 0x92, 0x05, 0xab, 0xd0, 0xb8, 0x1b, 0xf9, 0x64, 0x62, 0x36, 0x04, 0x2c,
+	0x05, 0xf4, 0x6b, 0x68, 0x1f, 0x46, 0x97, 0x64, 0x48, 0x63, 0x62, 0x36, 0x45, 0x62, 0x2c, 0xf5,
+	0x78, 0x42, 0x66, 0xa0, 0xd3, 0xfb, 0x66, 0x66, 0x6d, 0xbe, 0xf6, 0x66, 0x39, 0x1f, 0xe7, 0xe6,
+	0xd0, 0x87, 0xd0, 0x54, 0x11, 0x6a, 0x89, 0x08, 0x75, 0x64, 0x84, 0x64, 0x68, 0x94, 0x0a, 0xdd,
+	0x80, 0x26, 0x26, 0xc9, 0x78, 0x98, 0x9a, 0x6d, 0xb1, 0x7b, 0x37, 0x0b, 0x23, 0xc7, 0xb0, 0xd2,
+	0xa1, 0x9b, 0xd0, 0xc2, 0xc4, 0x23, 0x61, 0x9c, 0x9a, 0x86, 0xa2, 0xf1, 0x4d, 0x15, 0x86, 0x33,
+	0x25, 0xda, 0x06, 0xe3, 0x70, 0xe2, 0x91, 0x98, 0xc7, 0xc8, 0x84, 0x2c, 0xcf, 0x65, 0xe9, 0xe5,
+	0x0a, 0x5c, 0x70, 0xec, 0x7f, 0xd4, 0xa0, 0xa9, 0xd2, 0xa1, 0x08, 0xa9, 0x76, 0x85, 0x21, 0xad,
+	0x5d, 0x45, 0x48, 0x7f, 0x00, 0x86, 0x70, 0x97, 0x38, 0x5d, 0x5d, 0x9c, 0x6e, 0xe9, 0xbb, 0x99,
+	0x55, 0x80, 0xb8, 0x58, 0x22, 0x13, 0x5a, 0x42, 0xb8, 0x7b, 0x20, 0x12, 0xc0, 0xc0, 0x99, 0x58,
+	0xca, 0x8c, 0xc6, 0x8b, 0x33, 0xa3, 0x59, 0xce, 0x8c, 0x8a, 0x2f, 0x5b, 0xaf, 0xf7, 0xe5, 0x6d,
+	0xfd, 0xab, 0x3f, 0x5b, 0xef, 0xd8, 0xff, 0xaa, 0x41, 0x43, 0x6c, 0x88, 0x6e, 0x64, 0xae, 0x35,
+	0x35, 0x15, 0x33, 0x11, 0x5a, 0x89, 0xe1, 0xcc, 0xed, 0x37, 0xf9, 0xe6, 0xf1, 0x38, 0x55, 0x6d,
+	0x49, 0x15, 0xbf, 0x80, 0x64, 0xa6, 0x48, 0x35, 0xfa, 0x08, 0x9a, 0x67, 0xe3, 0x94, 0x13, 0xeb,
+	0xe5, 0xfe, 0x25, 0x31, 0x95, 0x53, 0x52, 0x40, 0x1f, 0x82, 0xbe, 0xef, 0x0e, 0x87, 0xe2, 0xfa,
+	0x9d, 0x9d, 0x6b, 0x92, 0xc8, 0x11, 0x49, 0x13, 0x4a, 0xb4, 0x01, 0xf5, 0x63, 0x1a, 0x08, 0x4f,
+	0xe4, 0x2d, 0xea, 0x98, 0x06, 0x92, 0xc2, 0x55, 0xe8, 0x67, 0xb0, 0x74, 0x87, 0x5e, 0x12, 0x16,
+	0xed, 0x79, 0x1e, 0x1d, 0x47, 0xa9, 0xaa, 0x0f, 0x53, 0x72, 0x2b, 0x2a, 0xd5, 0x6e, 0x2a, 0x18,
+	0x3f, 0x86, 0x43, 0x23, 0xdf, 0x6c, 0x95, 0x8f, 0xc1, 0x11, 0x75, 0x0c, 0xbe, 0xe4, 0xd7, 0x7a,
+	0x10, 0x0d, 0x38, 0xad, 0x5d, 0xbe, 0x96, 0xc4, 0xd4, 0xb5, 0xa4, 0xa0, 0xfc, 0xfb, 0x95, 0x96,
+	0x55, 0x0c, 0x8f, 0x27, 0x26, 0xe9, 0x98, 0x45, 0xc2, 0xc1, 0x5d, 0xac, 0x24, 0x9e, 0x01, 0x77,
+	0xdc, 0xe4, 0x41, 0x42, 0x7c, 0xe1, 0x54, 0x1d, 0x67, 0x22, 0xda, 0x04, 0xe3, 0xd4, 0x1d, 0x91,
+	0xc3, 0x28, 0x65, 0x53, 0xe5, 0xc7, 0x6e, 0x4f, 0x0e, 0x23, 0x81, 0xe1, 0x42, 0x8d, 0x7e, 0x08,
+	0xed, 0x73, 0xc2, 0x46, 0x7b, 0x2c, 0x48, 0x94, 0x27, 0x57, 0x7b, 0xa5, 0xf9, 0x94, 0xe9, 0x70,
+	0xce, 0xb2, 0xff, 0x54, 0x83, 0x76, 0xe6, 0x42, 0x74, 0x0a, 0xad, 0x3d, 0xdf, 0x67, 0x24, 0x49,
+	0xe4, 0xe9, 0x9c, 0x1f, 0xa9, 0x1a, 0xd8, 0x7a, 0x75, 0x0d, 0xa8, 0x21, 0xa7, 0xbe, 0xc5, 0x99,
+	0x11, 0x74, 0x17, 0xf4, 0x03, 0x37, 0x75, 0x17, 0x2b, 0x28, 0x61, 0x02, 0x1d, 0x43, 0xb3, 0x4f,
+	0xe3, 0xd0, 0x93, 0x43, 0xed, 0x8d, 0x4f, 0xa6, 0x8c, 0xfd, 0x8a, 0x32, 0x7f, 0x67, 0xf7, 0xc7,
+	0x58, 0xd9, 0x40, 0x9b, 0xd0, 0x3a, 0x20, 0x1e, 0xf5, 0x89, 0x6f, 0xea, 0xe5, 0x14, 0x56, 0xe0,
+	0x31, 0x0d, 0x70, 0x46, 0xb0, 0xbf, 0x04, 0x28, 0x60, 0xf4, 0x81, 0x2a, 0x6b, 0xee, 0x73, 0x35,
+	0x85, 0x0a, 0x00, 0x7d, 0x02, 0xc6, 0x1e, 0x0b, 0xc6, 0x23, 0xd1, 0x41, 0x6b, 0xa2, 0x83, 0xbe,
+	0x5b, 0xea, 0xa0, 0x99, 0x0e, 0x17, 0x2c, 0xfb, 0x04, 0x96, 0x2a, 0x3a, 0x3e, 0xe2, 0x4a, 0xc6,
+	0xc5, 0x9a, 0x63, 0xf9, 0xe0, 0x32, 0xb0, 0x58, 0xf3, 0x0e, 0xf0, 0x85, 0x3b, 0x1c, 0xcb, 0xe6,
+	0x62, 0x60, 0x29, 0xd8, 0xff, 0xd5, 0xc0, 0xc8, 0xcb, 0x06, 0x6d, 0x42, 0x9b, 0x0b, 0x22, 0x08,
+	0x5a, 0xb9, 0x6a, 0x32, 0x14, 0xe7, 0x7a, 0xee, 0xe1, 0x33, 0x16, 0x06, 0x61, 0xa4, 0xc2, 0xf5,
+	0xff, 0xc5, 0x5e, 0xd9, 0x40, 0xeb, 0x00, 0xf7, 0x53, 0xd7, 0x7b, 0x74, 0x40, 0xe2, 0x54, 0x0e,
+	0x49, 0x1d, 0x97, 0x10, 0xde, 0x6d, 0x55, 0x1d, 0xe8, 0x0b, 0x75, 0x5b, 0x69, 0xc4, 0xfe, 0x25,
+	0xa0, 0xe7, 0x8b, 0x1b, 0xfd, 0x14, 0x96, 0x94, 0xfc, 0x20, 0xf6, 0xdd, 0x94, 0x28, 0x1f, 0xbc,
+	0xd7, 0x13, 0x2f, 0xb4, 0x3e, 0x19, 0xc5, 0x43, 0x37, 0x25, 0x8a, 0x82, 0xab, 0x5c, 0xfb, 0x31,
+	0x18, 0x79, 0xe1, 0x23, 0x0c, 0xc6, 0x17, 0xee, 0x30, 0xf4, 0xdd, 0x94, 0xb2, 0x85, 0x6a, 0xa3,
+	0x30, 0xc3, 0x5b, 0xc1, 0xde, 0x48, 0x34, 0x29, 0x59, 0xf1, 0x4a, 0xb2, 0x7f, 0xaf, 0x41, 0x3b,
+	0x7b, 0x6e, 0xf1, 0x8d, 0x31, 0xf1, 0xc2, 0x38, 0x24, 0x51, 0xba, 0xd8, 0xc6, 0xb9, 0x99, 0x97,
+	0x6d, 0x8c, 0xd6, 0xa0, 0x7d, 0xce, 0x68, 0x4c, 0x13, 0xc2, 0x44, 0xc4, 0xda, 0x38, 0x97, 0xed,
+	0xaf, 0x35, 0x80, 0xe2, 0x45, 0xf7, 0xb6, 0xfc, 0x81, 0x89, 0x9b, 0xd0, 0x48, 0xa5, 0xb9, 0x92,
+	0x78, 0x6b, 0x14, 0x3b, 0x13, 0x5f, 0xe5, 0x51, 0x26, 0xf2, 0x2f, 0xee, 0xb9, 0xe1, 0x50, 0x55,
+	0xb1, 0x8e, 0x95, 0x84, 0x6e, 0xe4, 0xcf, 0xcd, 0xca, 0xec, 0xac, 0x82, 0xf6, 0x04, 0xba, 0xe5,
+	0xb7, 0xe6, 0x5b, 0xb9, 0xd3, 0x2a, 0x34, 0xce, 0xe9, 0x63, 0xf5, 0x80, 0xd7, 0xb1, 0x14, 0xec,
+	0x3f, 0x68, 0xd0, 0xe2, 0x47, 0x0d, 0xa3, 0x00, 0xed, 0x82, 0x71, 0x3e, 0x1e, 0x0c, 0x43, 0xef,
+	0x17, 0x64, 0xaa, 0xf2, 0xf3, 0x7a, 0x4f, 0x19, 0xcc, 0x15, 0x8e, 0xce, 0x0f, 0x82, 0x0b, 0xe6,
+	0x8b, 0x0d, 0x97, 0x5e, 0x0b, 0xf5, 0xca, 0x6b, 0xe1, 0x39, 0x87, 0xe8, 0x2f, 0x71, 0xc8, 0x49,
+	0x98, 0x24, 0xc4, 0x17, 0x2f, 0xee, 0xe4, 0xca, 0xc7, 0x81, 0x09, 0x2d, 0xb9, 0x93, 0xec, 0x8d,
+	0x3a, 0xce, 0x44, 0xfb, 0x3f, 0x1a, 0x74, 0x4a, 0xe3, 0xf3, 0xad, 0x84, 0xe2, 0x00, 0x6a, 0x7d,
+	0xba, 0x50, 0x6f, 0xab, 0xf5, 0x69, 0xa9, 0x76, 0xea, 0x95, 0xda, 0x79, 0x33, 0x0f, 0xff, 0x16,
+	0xa0, 0x78, 0x25, 0x5d, 0xb5, 0x7f, 0xed, 0x2f, 0xa1, 0x53, 0x7a, 0x5a, 0x5d, 0xb9, 0xf9, 0x3f,
+	0xd6, 0xa0, 0x32, 0x2d, 0xf8, 0x9a, 0x2c, 0x16, 0x1e, 0x65, 0x23, 0xb7, 0x46, 0x16, 0x9b, 0x3d,
+	0xd2, 0x46, 0xfe, 0xec, 0xa8, 0x2f, 0xfe, 0xec, 0xc8, 0x87, 0xac, 0x0c, 0xa7, 0x14, 0xd0, 0x0a,
+	0xd4, 0xef, 0xb8, 0x89, 0xea, 0x2a, 0x7c, 0x69, 0xff, 0x0e, 0x3a, 0x7d, 0xe6, 0x7a, 0x64, 0x9f,
+	0x46, 0x0f, 0xc3, 0x00, 0xd9, 0xd0, 0x3d, 0x08, 0x13, 0x77, 0x30, 0x24, 0x62, 0xe4, 0x09, 0x1f,
+	0xb5, 0x71, 0x05, 0xe3, 0x19, 0xa3, 0xe4, 0x13, 0x32, 0xa2, 0x6c, 0x2a, 0xae, 0xde, 0xc6, 0x55,
+	0x10, 0xdd, 0x84, 0xe5, 0xfc, 0x2b, 0xca, 0xdc, 0x80, 0xa8, 0xce, 0xfc, 0x0c, 0x6a, 0xff, 0x4d,
+	0x83, 0x86, 0x38, 0x01, 0xfa, 0x9e, 0x3c, 0x1c, 0xdf, 0x52, 0x77, 0x5a, 0xf3, 0x99, 0x55, 0x0f,
+	0xdc, 0x44, 0x9c, 0x12, 0xd9, 0xd0, 0xfc, 0x5c, 0xf6, 0x4b, 0xb1, 0x97, 0x03, 0xf3, 0x99, 0xd5,
+	0x7c, 0x28, 0x10, 0xac, 0x34, 0xe8, 0x13, 0xe8, 0xc8, 0x99, 0x5a, 0x7a, 0x5c, 0x38, 0xd7, 0xe6,
+	0x33, 0xab, 0xc3, 0x0a, 0x18, 0x97, 0x39, 0xe8, 0xe7, 0x7c, 0xd6, 0xb3, 0xb1, 0x97, 0x1e, 0xd3,
+	0x20, 0xfb, 0x03, 0x41, 0x3d, 0x9d, 0x73, 0xdc, 0x59, 0x9e, 0xcf, 0x2c, 0x48, 0x72, 0x1a, 0x2e,
+	0x7d, 0x62, 0xff, 0xa5, 0x0e, 0x46, 0x2e, 0xa2, 0xf7, 0xa1, 0x76, 0xbe, 0xaf, 0xce, 0xdf, 0x9c,
+	0xcf, 0xac, 0x5a, 0xec, 0xe1, 0xda, 0xf9, 0x3e, 0xc7, 0xcf, 0x62, 0x39, 0x1b, 0x24, 0x4e, 0x63,
+	0x5c, 0x3b, 0x8b, 0xb3, 0x0b, 0xd7, 0x5f, 0x70, 0xe1, 0xef, 0x8b, 0x57, 0xf5, 0x3e, 0x4d, 0x54,
+	0x3d, 0x3a, 0x9d, 0xf9, 0xcc, 0x6a, 0x05, 0x12, 0xc2, 0x99, 0x0e, 0x59, 0xd0, 0x90, 0xef, 0x14,
+	0x11, 0x51, 0xc7, 0x98, 0xcf, 0xac, 0x86, 0xcf, 0x01, 0x2c, 0x71, 0xf4, 0x11, 0x34, 0x0e, 0x19,
+	0xa3, 0x4c, 0xfc, 0x9c, 0x30, 0x9c, 0x77, 0xe7, 0x33, 0xeb, 0x9a, 0xf8, 0x59, 0xb5, 0x45, 0x47,
+	0x61, 0x4a, 0x46, 0x71, 0x3a, 0xc5, 0x92, 0xc1, 0xa9, 0x32, 0xe6, 0xfc, 0x07, 0xb4, 0xa2, 0x26,
+	0x1c, 0x28, 0x53, 0x65, 0x06, 0x6c, 0x41, 0x53, 0x85, 0xbe, 0x2d, 0xb8, 0xab, 0xf3, 0x99, 0xb5,
+	0x32, 0x12, 0x48, 0x89, 0xac, 0x38, 0xe8, 0x04, 0x5a, 0x59, 0x0a, 0x18, 0xc2, 0xc5, 0x1f, 0x3c,
+	0xe3, 0xe2, 0x9e, 0x52, 0x8b, 0x9f, 0x02, 0xce, 0x7b, 0xf3, 0x99, 0x75, 0x3d, 0x91, 0x48, 0xc9,
+	0x5a, 0x66, 0x63, 0xed, 0x36, 0x74, 0xcb, 0x7c, 0x9e, 0xd3, 0x8f, 0xd4, 0x04, 0x32, 0x30, 0x5f,
+	0xf2, 0xdc, 0xbf, 0x14, 0x39, 0x20, 0xc7, 0xb1, 0x14, 0x6e, 0xd7, 0x3e, 0xd3, 0x1c, 0xe7, 0xc9,
+	0xd3, 0x75, 0xed, 0xeb, 0xa7, 0xeb, 0xda, 0xbf, 0x9f, 0xae, 0x6b, 0x7f, 0xff, 0x76, 0x5d, 0x7b,
+	0xf2, 0xed, 0xba, 0xf6, 0x9b, 0xd7, 0x14, 0x2b, 0xc9, 0xfe, 0x88, 0x11, 0xab, 0x41, 0x53, 0xfc,
+	0x25, 0xf6, 0xe9, 0xff, 0x06, 0x00, 0x7c, 0xd9, 0x13, 0x9c, 0xa7, 0x13, 0x00, 0x00,
 }
@@ -60,6 +60,11 @@ func (log *LogEvent) Get(key string) (string, bool) {
 	switch key {
 	case event.AddressKey:
 		value = log.Address
+	case event.EventNameKey:
+		if log.Decoded == nil {
+			return "", false
+		}
+		return log.Decoded.EventName, true
 	default:
 		if i, ok := logNTopicIndex[key]; ok {
 			return hex.EncodeUpperToString(log.GetTopic(i).Bytes()), true
@@ -67,6 +72,12 @@ func (log *LogEvent) Get(key string) (string, bool) {
 		if i, ok := logNTextTopicIndex[key]; ok {
 			return strings.Trim(string(log.GetTopic(i).Bytes()), logNTextTopicCutset), true
 		}
+		// The arguments of a decoded log are tagged by name
+		for _, arg := range log.GetDecoded().GetArguments() {
+			if arg.Name == key {
+				return arg.Value, true
+			}
+		}
 		return "", false
 	}
 	return query.StringFromValue(value), true
@@ -80,9 +91,18 @@ func (log *LogEvent) GetTopic(i int) Word256 {
 }
 
 func (log *LogEvent) Len() int {
-	return len(logTagKeys)
+	return len(log.Keys())
 }
 
 func (log *LogEvent) Keys() []string {
-	return logTagKeys
+	if log.GetDecoded() == nil {
+		return logTagKeys
+	}
+	keys := make([]string, len(logTagKeys), len(logTagKeys)+len(log.Decoded.Arguments)+1)
+	copy(keys, logTagKeys)
+	keys = append(keys, event.EventNameKey)
+	for _, arg := range log.Decoded.Arguments {
+		keys = append(keys, arg.Name)
+	}
+	return keys
 }
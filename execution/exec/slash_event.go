@@ -0,0 +1,7 @@
+package exec
+
+// The misbehaviours for which validators are penalised, see SlashEvent.Reason
+const (
+	SlashReasonDoubleSign = "DoubleSign"
+	SlashReasonDowntime   = "Downtime"
+)
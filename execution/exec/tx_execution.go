@@ -19,6 +19,8 @@ func EventStringAccountCall(addr crypto.Address) string    { return fmt.Sprintf(
 func EventStringLogEvent(addr crypto.Address) string       { return fmt.Sprintf("Log/%s", addr) }
 func EventStringTxExecution(txHash []byte) string          { return fmt.Sprintf("Execution/Tx/%X", txHash) }
 func EventStringGovernAccount(addr *crypto.Address) string { return fmt.Sprintf("Govern/Acc/%v", addr) }
+func EventStringBond(addr crypto.Address) string           { return fmt.Sprintf("Bond/%v", addr) }
+func EventStringUnbond(addr crypto.Address) string         { return fmt.Sprintf("Unbond/%v", addr) }
 
 func NewTxExecution(txEnv *txs.Envelope) *TxExecution {
 	return &TxExecution{
@@ -97,6 +99,20 @@ func (txe *TxExecution) GovernAccount(governAccount *GovernAccountEvent, excepti
 	})
 }
 
+func (txe *TxExecution) Bond(bond *BondEvent) {
+	txe.Append(&Event{
+		Header: txe.Header(TypeBond, EventStringBond(bond.Validator), nil),
+		Bond:   bond,
+	})
+}
+
+func (txe *TxExecution) Unbond(unbond *UnbondEvent) {
+	txe.Append(&Event{
+		Header: txe.Header(TypeUnbond, EventStringUnbond(unbond.Validator), nil),
+		Unbond: unbond,
+	})
+}
+
 func (txe *TxExecution) SetException(err error) {
 	txe.Exception = errors.AsException(err)
 }
@@ -17,22 +17,28 @@ package execution
 import (
 	"context"
 	"fmt"
+	"math"
+	"math/big"
 	"runtime/debug"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
@@ -48,6 +54,14 @@ type Context interface {
 
 type ExecutorState interface {
 	Update(updater func(ws Updatable) error) (hash []byte, err error)
+	// Delete historical state committed below height
+	Prune(height uint64) error
+	IterateUnbondings(releaseHeight uint64, consumer func(*exec.UnbondEvent) (stop bool)) (stopped bool, err error)
+	GetMinimumFee(txType payload.Type) (uint64, error)
+	GetJailing(address crypto.Address) (*exec.Jailing, error)
+	IterateJailings(consumer func(*exec.Jailing) (stop bool)) (stopped bool, err error)
+	GetMissedBlocks(address crypto.Address) (*exec.MissedBlocks, error)
+	GetBonded(address crypto.Address) (uint64, error)
 	names.Reader
 	state.IterableReader
 }
@@ -68,11 +82,14 @@ type BatchCommitter interface {
 	// Commit execution results to underlying State and provide opportunity
 	// to mutate state before it is saved
 	Commit(blockHash []byte, blockTime time.Time, header *abciTypes.Header) (stateHash []byte, err error)
+	// Apply the penalties for validator misbehaviour reported by Tendermint at the beginning of a block
+	BeginBlock(block *abciTypes.RequestBeginBlock) error
 }
 
 type executor struct {
 	sync.RWMutex
 	runCall        bool
+	tip            bcm.BlockchainInfo
 	blockchain     *bcm.Blockchain
 	state          ExecutorState
 	stateCache     *state.Cache
@@ -81,10 +98,37 @@ type executor struct {
 	blockExecution *exec.BlockExecution
 	logger         *logging.Logger
 	vmOptions      []func(*evm.VM)
+	natives        *evm.Natives
 	contexts       map[payload.Type]Context
+	// Unbondings scheduled by the current block
+	unbondings []*exec.UnbondEvent
+	// Minimum fees set by the current block
+	minimumFees map[payload.Type]uint64
+	// Validators jailed by the current block, or released when nil
+	jailings map[crypto.Address]*exec.Jailing
+	// Missed blocks recorded by the current block
+	missedBlocks map[crypto.Address]*exec.MissedBlocks
+	// Bonded amounts changed by the current block
+	bonded map[crypto.Address]uint64
+	// The fees collected from the transactions of the current block
+	fees uint64
+	// The number of transactions executed successfully since the last reset, which for the checker is the number of
+	// transactions in the mempool
+	executedTxs int
+	// The number of transactions the checker may hold before the fee required to join them starts to rise (zero
+	// disables this)
+	mempoolFeeThreshold int
+	// The number of heights of historical state to retain in addition to the latest (zero retains all)
+	stateRetention uint64
+	// Whether to maintain the event index
+	indexEvents bool
 }
 
 var _ BatchExecutor = (*executor)(nil)
+var _ contexts.UnbondingWriter = (*executor)(nil)
+var _ contexts.MinimumFeeWriter = (*executor)(nil)
+var _ contexts.JailReader = (*executor)(nil)
+var _ contexts.BondedAmounts = (*executor)(nil)
 
 // Wraps a cache of what is variously known as the 'check cache' and 'mempool'
 func NewBatchChecker(backend ExecutorState, blockchain *bcm.Blockchain, logger *logging.Logger,
@@ -92,14 +136,9 @@ func NewBatchChecker(backend ExecutorState, blockchain *bcm.Blockchain, logger *
 
 	exe := newExecutor("CheckCache", false, backend, blockchain, event.NewNoOpPublisher(),
 		logger.WithScope("NewBatchExecutor"), options...)
+	exe.blockchain = blockchain
 
-	return exe.AddContext(payload.TypeGovernance,
-		&contexts.GovernanceContext{
-			ValidatorSet: exe.blockchain.ValidatorChecker(),
-			StateWriter:  exe.stateCache,
-			Logger:       exe.logger,
-		},
-	)
+	return exe.addValidatorContexts(exe.blockchain.ValidatorChecker())
 }
 
 func NewBatchCommitter(backend ExecutorState, blockchain *bcm.Blockchain, emitter event.Publisher,
@@ -107,57 +146,69 @@ func NewBatchCommitter(backend ExecutorState, blockchain *bcm.Blockchain, emitte
 
 	exe := newExecutor("CommitCache", true, backend, blockchain, emitter,
 		logger.WithScope("NewBatchCommitter"), options...)
+	exe.blockchain = blockchain
 
-	return exe.AddContext(payload.TypeGovernance,
-		&contexts.GovernanceContext{
-			ValidatorSet: exe.blockchain.ValidatorWriter(),
-			StateWriter:  exe.stateCache,
-			Logger:       exe.logger,
-		},
-	)
+	return exe.addValidatorContexts(exe.blockchain.ValidatorWriter())
 }
 
-func newExecutor(name string, runCall bool, backend ExecutorState, blockchain *bcm.Blockchain, publisher event.Publisher,
+func newExecutor(name string, runCall bool, backend ExecutorState, tip bcm.BlockchainInfo, publisher event.Publisher,
 	logger *logging.Logger, options ...ExecutionOption) *executor {
 	exe := &executor{
 		runCall:      runCall,
 		state:        backend,
-		blockchain:   blockchain,
+		tip:          tip,
 		stateCache:   state.NewCache(backend, state.Name(name)),
 		nameRegCache: names.NewCache(backend),
 		publisher:    publisher,
 		blockExecution: &exec.BlockExecution{
-			Height: blockchain.LastBlockHeight() + 1,
+			Height: tip.LastBlockHeight() + 1,
 		},
-		logger: logger.With(structure.ComponentKey, "Executor"),
+		minimumFees:  make(map[payload.Type]uint64),
+		jailings:     make(map[crypto.Address]*exec.Jailing),
+		missedBlocks: make(map[crypto.Address]*exec.MissedBlocks),
+		bonded:       make(map[crypto.Address]uint64),
+		logger:       logger.With(structure.ComponentKey, "Executor"),
 	}
 	for _, option := range options {
 		option(exe)
 	}
+	if exe.natives != nil {
+		exe.vmOptions = append(exe.vmOptions[:len(exe.vmOptions):len(exe.vmOptions)], evm.WithNatives(exe.natives))
+	}
 	exe.contexts = map[payload.Type]Context{
 		payload.TypeSend: &contexts.SendContext{
-			Tip:         blockchain,
+			Tip:         tip,
 			StateWriter: exe.stateCache,
 			Logger:      exe.logger,
 		},
 		payload.TypeCall: &contexts.CallContext{
-			Tip:         blockchain,
+			Tip:         tip,
 			StateWriter: exe.stateCache,
 			RunCall:     runCall,
 			VMOptions:   exe.vmOptions,
+			Natives:     exe.natives,
 			Logger:      exe.logger,
 		},
 		payload.TypeName: &contexts.NameContext{
-			Tip:         blockchain,
+			Tip:         tip,
 			StateWriter: exe.stateCache,
 			NameReg:     exe.nameRegCache,
 			Logger:      exe.logger,
 		},
 		payload.TypePermissions: &contexts.PermissionsContext{
-			Tip:         blockchain,
+			Tip:         tip,
 			StateWriter: exe.stateCache,
 			Logger:      exe.logger,
 		},
+		payload.TypeBatch: &contexts.BatchContext{
+			Tip:         tip,
+			StateWriter: exe.stateCache,
+			NameReg:     exe.nameRegCache,
+			RunCall:     runCall,
+			VMOptions:   exe.vmOptions,
+			Natives:     exe.natives,
+			Logger:      exe.logger,
+		},
 	}
 	return exe
 }
@@ -167,6 +218,35 @@ func (exe *executor) AddContext(ty payload.Type, ctx Context) *executor {
 	return exe
 }
 
+// Adds the contexts for transactions that change the validator set
+func (exe *executor) addValidatorContexts(validatorSet validator.ReaderWriter) *executor {
+	return exe.AddContext(payload.TypeGovernance,
+		&contexts.GovernanceContext{
+			ValidatorSet: validatorSet,
+			StateWriter:  exe.stateCache,
+			MinimumFees:  exe,
+			Logger:       exe.logger,
+		},
+	).AddContext(payload.TypeBond,
+		&contexts.BondContext{
+			ValidatorSet: validatorSet,
+			StateWriter:  exe.stateCache,
+			Jails:        exe,
+			Bonds:        exe,
+			Logger:       exe.logger,
+		},
+	).AddContext(payload.TypeUnbond,
+		&contexts.UnbondContext{
+			Tip:          exe.tip,
+			ValidatorSet: validatorSet,
+			StateWriter:  exe.stateCache,
+			Unbondings:   exe,
+			Bonds:        exe,
+			Logger:       exe.logger,
+		},
+	)
+}
+
 // If the tx is invalid, an error will be returned.
 // Unlike ExecBlock(), state will not be altered.
 func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err error) {
@@ -184,7 +264,7 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 	logger.InfoMsg("Executing transaction", "tx", txEnv.String())
 
 	// Verify transaction signature against inputs
-	err = txEnv.Verify(exe.stateCache, exe.blockchain.ChainID())
+	err = txEnv.Verify(exe.stateCache, exe.tip.ChainID())
 	if err != nil {
 		logger.InfoMsg("Transaction Verify failed", structure.ErrorKey, err)
 		return nil, err
@@ -199,6 +279,11 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			logger.InfoMsg("Transaction validate failed", structure.ErrorKey, err)
 			return nil, err
 		}
+		err = exe.checkFee(txEnv.Tx.Payload)
+		if err != nil {
+			logger.InfoMsg("Transaction fee check failed", structure.ErrorKey, err)
+			return nil, err
+		}
 		err = txExecutor.Execute(txe)
 		if err != nil {
 			logger.InfoMsg("Transaction execution failed", structure.ErrorKey, err)
@@ -210,6 +295,9 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 			logger.InfoMsg("Updating signatories failed", structure.ErrorKey, err)
 			return nil, err
 		}
+		// Fees are charged even when a transaction fails
+		exe.fees += payload.Fee(txEnv.Tx.Payload)
+		exe.executedTxs++
 		// Return execution for this tx
 		return txe, nil
 	}
@@ -230,6 +318,25 @@ func (exe *executor) Commit(blockHash []byte, blockTime time.Time, header *abciT
 	if err != nil {
 		return nil, err
 	}
+	// Pay out any unbonded funds due by the end of this block
+	released, pending, err := exe.releaseUnbondings(blockExecution.Height)
+	if err != nil {
+		return nil, err
+	}
+	// Pay out the fees collected from the transactions in this block
+	blockExecution.FeeEvents, err = exe.distributeFees(header)
+	if err != nil {
+		return nil, err
+	}
+	minimumFees := exe.minimumFees
+	exe.minimumFees = make(map[payload.Type]uint64)
+	jailings := exe.jailings
+	exe.jailings = make(map[crypto.Address]*exec.Jailing)
+	missedBlocks := exe.missedBlocks
+	exe.missedBlocks = make(map[crypto.Address]*exec.MissedBlocks)
+	bonded := exe.bonded
+	exe.bonded = make(map[crypto.Address]uint64)
+	exe.executedTxs = 0
 
 	// First commit the app state, this app hash will not get checkpointed until the next block when we are sure
 	// that nothing in the downstream commit process could have failed. At worst we go back one block.
@@ -243,15 +350,87 @@ func (exe *executor) Commit(blockHash []byte, blockTime time.Time, header *abciT
 		if err != nil {
 			return err
 		}
+		for _, unbonding := range released {
+			err = ws.RemoveUnbonding(unbonding)
+			if err != nil {
+				return err
+			}
+		}
+		for _, unbonding := range pending {
+			err = ws.AddUnbonding(unbonding)
+			if err != nil {
+				return err
+			}
+		}
+		// Write in a fixed order since the state hash depends on the order of insertion
+		txTypes := make([]payload.Type, 0, len(minimumFees))
+		for txType := range minimumFees {
+			txTypes = append(txTypes, txType)
+		}
+		sort.Slice(txTypes, func(i, j int) bool { return txTypes[i] < txTypes[j] })
+		for _, txType := range txTypes {
+			err = ws.SetMinimumFee(&payload.MinimumFee{TxType: txType, Fee: minimumFees[txType]})
+			if err != nil {
+				return err
+			}
+		}
+		addresses := make(crypto.Addresses, 0, len(jailings))
+		for address := range jailings {
+			addresses = append(addresses, address)
+		}
+		sort.Sort(addresses)
+		for _, address := range addresses {
+			if jailings[address] == nil {
+				err = ws.RemoveJailing(address)
+			} else {
+				err = ws.SetJailing(jailings[address])
+			}
+			if err != nil {
+				return err
+			}
+		}
+		addresses = make(crypto.Addresses, 0, len(missedBlocks))
+		for address := range missedBlocks {
+			addresses = append(addresses, address)
+		}
+		sort.Sort(addresses)
+		for _, address := range addresses {
+			err = ws.SetMissedBlocks(missedBlocks[address])
+			if err != nil {
+				return err
+			}
+		}
+		addresses = make(crypto.Addresses, 0, len(bonded))
+		for address := range bonded {
+			addresses = append(addresses, address)
+		}
+		sort.Sort(addresses)
+		for _, address := range addresses {
+			err = ws.SetBonded(address, bonded[address])
+			if err != nil {
+				return err
+			}
+		}
 		err = ws.AddBlock(blockExecution)
 		if err != nil {
 			return err
 		}
+		if exe.indexEvents {
+			return ws.IndexBlock(blockExecution)
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	if exe.stateRetention > 0 && blockExecution.Height > exe.stateRetention {
+		// Failing to prune is not fatal so carry on (we will try again next block)
+		pruneErr := exe.state.Prune(blockExecution.Height - exe.stateRetention)
+		if pruneErr != nil {
+			exe.logger.InfoMsg("Error pruning historical state",
+				"height", blockExecution.Height, structure.ErrorKey, pruneErr)
+		}
+	}
 	// Now state is committed publish events
 	for _, txe := range blockExecution.TxExecutions {
 		publishErr := exe.publisher.Publish(context.Background(), txe, txe.Tagged())
@@ -284,9 +463,248 @@ func (exe *executor) Reset() error {
 	// As with Commit() we do not take the write lock here
 	exe.stateCache.Reset(exe.state)
 	exe.nameRegCache.Reset(exe.state)
+	exe.unbondings = nil
+	exe.minimumFees = make(map[payload.Type]uint64)
+	exe.jailings = make(map[crypto.Address]*exec.Jailing)
+	exe.missedBlocks = make(map[crypto.Address]*exec.MissedBlocks)
+	exe.bonded = make(map[crypto.Address]uint64)
+	exe.fees = 0
+	exe.executedTxs = 0
+	return nil
+}
+
+// Schedule unbonded funds for release, they are stored on Commit
+func (exe *executor) AddUnbonding(unbonding *exec.UnbondEvent) error {
+	exe.unbondings = append(exe.unbondings, unbonding)
 	return nil
 }
 
+// Credits the recipients of the stored unbondings and those scheduled by this block that are due for release at height.
+// Returns those stored unbondings that have been released and those scheduled by this block that remain to be stored.
+func (exe *executor) releaseUnbondings(height uint64) (released, pending []*exec.UnbondEvent, err error) {
+	_, err = exe.state.IterateUnbondings(height, func(unbonding *exec.UnbondEvent) (stop bool) {
+		released = append(released, unbonding)
+		return false
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	due := released
+	for _, unbonding := range exe.unbondings {
+		if unbonding.ReleaseHeight <= height {
+			due = append(due, unbonding)
+		} else {
+			pending = append(pending, unbonding)
+		}
+	}
+	exe.unbondings = nil
+	for _, unbonding := range due {
+		err = exe.credit(unbonding.To, unbonding.Amount)
+		if err != nil {
+			return nil, nil, err
+		}
+		exe.logger.InfoMsg("Released unbonded funds",
+			"validator", unbonding.Validator,
+			"to", unbonding.To,
+			"amount", unbonding.Amount,
+			"height", height)
+	}
+	return released, pending, nil
+}
+
+// Pays the fees collected from the transactions of the current block to the validators in proportion to their power,
+// except for the ProposerFeePercentage of them that is paid to the proposer named in header. Any remainder left by
+// rounding is paid to the proposer, or to the first validator by address if header names none.
+func (exe *executor) distributeFees(header *abciTypes.Header) ([]*exec.FeeEvent, error) {
+	fees := exe.fees
+	exe.fees = 0
+	if fees == 0 {
+		return nil, nil
+	}
+	payments := make(map[crypto.Address]*big.Int)
+	pay := func(address crypto.Address, amount *big.Int) {
+		if payments[address] == nil {
+			payments[address] = new(big.Int)
+		}
+		payments[address].Add(payments[address], amount)
+	}
+	pool := new(big.Int).SetUint64(fees)
+	remainder := new(big.Int).Set(pool)
+	var proposer, remainderTo *crypto.Address
+	if header != nil && len(header.ProposerAddress) > 0 {
+		address, err := crypto.AddressFromBytes(header.ProposerAddress)
+		if err != nil {
+			return nil, fmt.Errorf("could not read block proposer address: %v", err)
+		}
+		proposer = &address
+		share := new(big.Int).SetUint64(exe.tip.GenesisDoc().ProposerFeePercentage)
+		share.Mul(share, pool).Div(share, big.NewInt(100))
+		pay(address, share)
+		pool.Sub(pool, share)
+		remainder.Sub(remainder, share)
+		remainderTo = proposer
+	}
+	validators := exe.blockchain.CurrentValidators()
+	totalPower := validators.TotalPower()
+	if totalPower.Sign() > 0 {
+		validators.Iterate(func(id crypto.Addressable, power *big.Int) (stop bool) {
+			if power.Sign() == 0 {
+				return
+			}
+			share := new(big.Int).Mul(pool, power)
+			share.Div(share, totalPower)
+			pay(id.Address(), share)
+			remainder.Sub(remainder, share)
+			if remainderTo == nil {
+				address := id.Address()
+				remainderTo = &address
+			}
+			return
+		})
+	}
+	if remainderTo == nil {
+		exe.logger.InfoMsg("No proposer or validators to pay fees to so they have been burnt", "fees", fees)
+		return nil, nil
+	}
+	pay(*remainderTo, remainder)
+
+	addresses := make(crypto.Addresses, 0, len(payments))
+	for address := range payments {
+		addresses = append(addresses, address)
+	}
+	sort.Sort(addresses)
+	var feeEvents []*exec.FeeEvent
+	for _, address := range addresses {
+		// The shares cannot exceed fees, which fits into a uint64
+		amount := payments[address].Uint64()
+		if amount == 0 {
+			continue
+		}
+		err := exe.credit(address, amount)
+		if err != nil {
+			return nil, err
+		}
+		feeEvents = append(feeEvents, &exec.FeeEvent{
+			Recipient: address,
+			Amount:    amount,
+			Proposer:  proposer != nil && address == *proposer,
+		})
+	}
+	return feeEvents, nil
+}
+
+// Adds amount to the balance of the account at address, creating the account if it does not exist
+func (exe *executor) credit(address crypto.Address, amount uint64) error {
+	account, err := state.GetMutableAccount(exe.stateCache, address)
+	if err != nil {
+		return err
+	}
+	if account == nil {
+		account = acm.ConcreteAccount{
+			Address:     address,
+			Permissions: permission.ZeroAccountPermissions,
+		}.MutableAccount()
+	}
+	err = account.AddToBalance(amount)
+	if err != nil {
+		return err
+	}
+	return exe.stateCache.UpdateAccount(account)
+}
+
+// Sets a minimum fee, which is stored on Commit
+func (exe *executor) SetMinimumFee(minimumFee *payload.MinimumFee) error {
+	exe.minimumFees[minimumFee.TxType] = minimumFee.Fee
+	return nil
+}
+
+// Returns the balance the validator at address has bonded including any bonded or unbonded by the current block
+func (exe *executor) Bonded(address crypto.Address) (uint64, error) {
+	if amount, ok := exe.bonded[address]; ok {
+		return amount, nil
+	}
+	return exe.state.GetBonded(address)
+}
+
+// Records the balance the validator at address has bonded, which is stored on Commit
+func (exe *executor) SetBonded(address crypto.Address, amount uint64) error {
+	exe.bonded[address] = amount
+	return nil
+}
+
+func (exe *executor) minimumFee(txType payload.Type) (uint64, error) {
+	if fee, ok := exe.minimumFees[txType]; ok {
+		return fee, nil
+	}
+	return exe.state.GetMinimumFee(txType)
+}
+
+// Checks that tx pays at least the minimum fee for its type. Once the checker holds mempoolFeeThreshold transactions
+// the fee required of a transaction doubles with each further mempoolFeeThreshold transactions so that those paying
+// higher fees are prioritised when the mempool is congested.
+func (exe *executor) checkFee(tx payload.Payload) error {
+	err := exe.checkMinimumFee(tx)
+	if err != nil {
+		return err
+	}
+	if exe.runCall || exe.mempoolFeeThreshold <= 0 || exe.executedTxs < exe.mempoolFeeThreshold ||
+		!tx.Type().PaysFee() {
+		return nil
+	}
+	required, err := exe.minimumFee(tx.Type())
+	if err != nil {
+		return err
+	}
+	required = congestionFee(required, exe.executedTxs/exe.mempoolFeeThreshold)
+	if fee := payload.Fee(tx); fee < required {
+		return errors.ErrorCodef(errors.ErrorCodeInsufficientFee,
+			"mempool is congested with %d transactions so %v must pay a fee of at least %d but pays %d",
+			exe.executedTxs, tx.Type(), required, fee)
+	}
+	return nil
+}
+
+// Checks the fee paid by tx and, if it is a BatchTx, by each of the transactions it contains
+func (exe *executor) checkMinimumFee(tx payload.Payload) error {
+	if !tx.Type().PaysFee() {
+		return nil
+	}
+	if batchTx, ok := tx.(*payload.BatchTx); ok {
+		for _, any := range batchTx.Txs {
+			// Empty transactions are rejected by BatchContext
+			if inner := any.GetValue(); inner != nil {
+				err := exe.checkMinimumFee(inner)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	required, err := exe.minimumFee(tx.Type())
+	if err != nil {
+		return err
+	}
+	if fee := payload.Fee(tx); fee < required {
+		return errors.ErrorCodef(errors.ErrorCodeInsufficientFee, "%v must pay a fee of at least %d but pays %d",
+			tx.Type(), required, fee)
+	}
+	return nil
+}
+
+// Doubles fee, or one if it is zero, multiple times
+func congestionFee(fee uint64, multiple int) uint64 {
+	if fee == 0 {
+		fee = 1
+	}
+	for i := 0; i < multiple; i++ {
+		if fee > math.MaxUint64/2 {
+			return math.MaxUint64
+		}
+		fee *= 2
+	}
+	return fee
+}
+
 // executor exposes access to the underlying state cache protected by a RWMutex that prevents access while locked
 // (during an ABCI commit). while access can occur (and needs to continue for CheckTx/DeliverTx to make progress)
 // through calls to Execute() external readers will be blocked until the executor is unlocked that allows the Transactor
@@ -331,12 +749,15 @@ func (exe *executor) updateSignatories(txEnv *txs.Envelope) error {
 		if err != nil {
 			return fmt.Errorf("error getting account on which to set public key: %v", *sig.Address)
 		}
-		// Important that verify has been run against signatories at this point
-		if sig.PublicKey.Address() != acc.Address() {
-			return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
-				acc.Address(), sig.PublicKey)
+		// Multisig accounts have no public key of their own, Verify has checked their members' signatures
+		if acc.Multisig() == nil {
+			// Important that verify has been run against signatories at this point
+			if sig.PublicKey.Address() != acc.Address() {
+				return fmt.Errorf("unexpected mismatch between address %v and supplied public key %v",
+					acc.Address(), sig.PublicKey)
+			}
+			acc.SetPublicKey(*sig.PublicKey)
 		}
-		acc.SetPublicKey(*sig.PublicKey)
 
 		exe.logger.TraceMsg("Incrementing sequence number Tx signatory/input",
 			"tag", "sequence",
@@ -23,11 +23,13 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/balance"
 	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/bcm"
 	. "github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/contexts"
 	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/evm"
 	. "github.com/hyperledger/burrow/execution/evm/asm"
@@ -36,11 +38,14 @@ import (
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/genesis/spec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tmthrgd/go-hex"
 )
@@ -282,6 +287,116 @@ func TestSendPermission(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestBondAndUnbond(t *testing.T) {
+	genDoc := newBaseGenDoc(permission.ZeroAccountPermissions, permission.ZeroAccountPermissions)
+	genDoc.Accounts[1].Permissions.Base.Set(permission.Bond, true)
+	genDoc.Accounts[4].Permissions.Base.Set(permission.Bond, true)
+	genDoc.Validators[0].Amount = 1000
+	for _, user := range []acm.AddressableSigner{users[2], users[4]} {
+		genDoc.Validators = append(genDoc.Validators, genesis.Validator{
+			BasicAccount: genesis.BasicAccount{PublicKey: user.PublicKey(), Amount: 10},
+		})
+	}
+	genDoc.UnbondingPeriod = 2
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.NoError(t, err)
+	blockchain, err := bcm.LoadOrNewBlockchain(dbm.NewMemDB(), &genDoc, logger)
+	require.NoError(t, err)
+	exe := NewBatchCommitter(st, blockchain, event.NewNoOpPublisher(), logger)
+	signExecuteCommit := func(tx payload.Payload, signer acm.AddressableSigner) (*exec.TxExecution, error) {
+		txEnv := txs.Enclose(genDoc.ChainID(), tx)
+		require.NoError(t, txEnv.Sign(signer))
+		txe, err := exe.Execute(txEnv)
+		if err != nil {
+			return nil, err
+		}
+		_, err = exe.Commit(nil, time.Now(), nil)
+		require.NoError(t, err)
+		return txe, nil
+	}
+
+	// Without the bond permission
+	bondTx := &payload.BondTx{}
+	require.NoError(t, bondTx.AddInputWithSequence(users[2].PublicKey(), 100, 1))
+	_, err = signExecuteCommit(bondTx, users[2])
+	require.Error(t, err)
+
+	// More than the validator set can absorb in one block
+	bondTx = &payload.BondTx{}
+	require.NoError(t, bondTx.AddInputWithSequence(users[1].PublicKey(), 500, 1))
+	_, err = signExecuteCommit(bondTx, users[1])
+	require.Error(t, err)
+
+	bondTx = &payload.BondTx{}
+	require.NoError(t, bondTx.AddInputWithSequence(users[1].PublicKey(), 100, 1))
+	txe, err := signExecuteCommit(bondTx, users[1])
+	require.NoError(t, err)
+	require.Equal(t, exec.TypeBond, txe.Events[len(txe.Events)-1].EventType())
+	require.Equal(t, int64(100), blockchain.Validators().Power(users[1].Address()).Int64())
+	require.Equal(t, uint64(1000000-100), getAccount(st, users[1].Address()).Balance())
+
+	unbondTx := &payload.UnbondTx{
+		Input:   &payload.TxInput{Address: users[1].Address(), Sequence: 2},
+		Address: users[3].Address(),
+	}
+	txe, err = signExecuteCommit(unbondTx, users[1])
+	require.NoError(t, err)
+	unbondHeight := blockchain.LastBlockHeight()
+	unbond := txe.Events[len(txe.Events)-1].Unbond
+	require.NotNil(t, unbond)
+	require.Equal(t, uint64(100), unbond.Amount)
+	require.Equal(t, unbondHeight+genDoc.UnbondingPeriod, unbond.ReleaseHeight)
+	require.Equal(t, int64(0), blockchain.Validators().Power(users[1].Address()).Int64())
+
+	// Nothing left to unbond
+	unbondTx.Input.Sequence = 3
+	_, err = signExecuteCommit(unbondTx, users[1])
+	require.Error(t, err)
+
+	// Funds are held until the unbonding period has elapsed
+	for blockchain.LastBlockHeight() < unbond.ReleaseHeight {
+		require.Equal(t, uint64(1000000), getAccount(st, users[3].Address()).Balance())
+		_, err = exe.Commit(nil, time.Now(), nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(1000000+100), getAccount(st, users[3].Address()).Balance())
+	_, err = st.IterateUnbondings(blockchain.LastBlockHeight(), func(unbonding *exec.UnbondEvent) bool {
+		t.Errorf("unbonding %v should have been released", unbonding)
+		return false
+	})
+	require.NoError(t, err)
+
+	// Only the balance bonded is released and not the power given by genesis
+	bondTx = &payload.BondTx{}
+	require.NoError(t, bondTx.AddInputWithSequence(users[4].PublicKey(), 20, 1))
+	_, err = signExecuteCommit(bondTx, users[4])
+	require.NoError(t, err)
+	require.Equal(t, int64(30), blockchain.Validators().Power(users[4].Address()).Int64())
+	unbondTx = &payload.UnbondTx{Input: &payload.TxInput{Address: users[4].Address(), Sequence: 2}}
+	txe, err = signExecuteCommit(unbondTx, users[4])
+	require.NoError(t, err)
+	unbond = txe.Events[len(txe.Events)-1].Unbond
+	require.Equal(t, uint64(20), unbond.Amount)
+	require.Equal(t, int64(0), blockchain.Validators().Power(users[4].Address()).Int64())
+	for blockchain.LastBlockHeight() < unbond.ReleaseHeight {
+		_, err = exe.Commit(nil, time.Now(), nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(1000000), getAccount(st, users[4].Address()).Balance())
+
+	// A validator that never bonded loses its power but is paid nothing
+	unbondTx = &payload.UnbondTx{Input: &payload.TxInput{Address: users[2].Address(), Sequence: 1}}
+	txe, err = signExecuteCommit(unbondTx, users[2])
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), txe.Events[len(txe.Events)-1].Unbond.Amount)
+	require.Equal(t, int64(0), blockchain.Validators().Power(users[2].Address()).Int64())
+	for i := uint64(0); i <= genDoc.UnbondingPeriod; i++ {
+		_, err = exe.Commit(nil, time.Now(), nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(1000000), getAccount(st, users[2].Address()).Balance())
+}
+
 func TestCallPermission(t *testing.T) {
 	stateDB := dbm.NewDB("state", dbBackend, dbDir)
 	defer stateDB.Close()
@@ -1138,6 +1253,440 @@ func TestCreates(t *testing.T) {
 	}
 }
 
+func TestCreateWithContractMeta(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	acc0 := getAccount(st, privAccounts[0].Address())
+	acc1 := getAccount(st, privAccounts[1].Address())
+	exe := makeExecutor(st)
+
+	abiJSON := `[{"type":"function","name":"foo","inputs":[],"outputs":[]}]`
+	sourceHash := []byte{1, 2, 3}
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address(),
+			Amount:   1,
+			Sequence: acc0.Sequence() + 1,
+		},
+		GasLimit: 1000,
+		Data:     []byte{0x00},
+		ContractMeta: &acm.ContractMeta{
+			Abi:             abiJSON,
+			CompilerVersion: "0.4.25",
+			SourceHash:      sourceHash,
+		},
+	}
+	require.NoError(t, exe.signExecuteCommit(tx, privAccounts[0]))
+
+	contractAddress := crypto.NewContractAddress(acc0.Address(), tx.Input.Sequence)
+	meta, err := st.GetContractMeta(contractAddress)
+	require.NoError(t, err)
+	require.NotNil(t, meta)
+	assert.Equal(t, abiJSON, meta.Abi)
+	assert.Equal(t, "0.4.25", meta.CompilerVersion)
+	assert.Equal(t, sourceHash, []byte(meta.SourceHash))
+	// The deployer is always the creator regardless of what the transaction claims
+	assert.Equal(t, acc0.Address(), meta.Deployer)
+
+	abiFromRegistry, err := st.GetAbi(contractAddress)
+	require.NoError(t, err)
+	assert.Equal(t, abiJSON, abiFromRegistry)
+
+	// Metadata cannot be attached to an existing account
+	tx = &payload.CallTx{
+		Input: &payload.TxInput{
+			Address:  acc0.Address(),
+			Amount:   1,
+			Sequence: tx.Input.Sequence + 1,
+		},
+		Address:      addressPtr(acc1),
+		GasLimit:     1000,
+		ContractMeta: &acm.ContractMeta{Abi: abiJSON},
+	}
+	require.Error(t, exe.signExecuteCommit(tx, privAccounts[0]))
+	meta, err = st.GetContractMeta(acc1.Address())
+	require.NoError(t, err)
+	assert.Nil(t, meta)
+}
+
+func TestBatchTx(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
+	acc0 := getAccount(st, privAccounts[0].Address())
+	acc1 := getAccount(st, privAccounts[1].Address())
+	acc2 := getAccount(st, privAccounts[2].Address())
+	exe := makeExecutor(st)
+
+	// PUSH1 0 PUSH1 0 REVERT
+	reverter := crypto.Address{1, 2, 3}
+	_, err := st.Update(func(up Updatable) error {
+		return up.UpdateAccount(acm.ConcreteAccount{Address: reverter, Code: []byte{0x60, 0, 0x60, 0, 0xfd}}.Account())
+	})
+	require.NoError(t, err)
+
+	send := func(from, to acm.Account, amount uint64) *payload.SendTx {
+		return &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: from.Address(), Amount: amount}},
+			Outputs: []*payload.TxOutput{{Address: to.Address(), Amount: amount}},
+		}
+	}
+	execute := func(batchTx *payload.BatchTx, signers ...acm.AddressableSigner) (*exec.TxExecution, error) {
+		for _, input := range batchTx.Inputs {
+			input.Sequence = getAccount(exe.stateCache, input.Address).Sequence() + 1
+		}
+		txEnv := txs.Enclose(testChainID, batchTx)
+		require.NoError(t, txEnv.Sign(signers...))
+		txe, err := exe.Execute(txEnv)
+		if err != nil {
+			return nil, err
+		}
+		_, err = exe.Commit(nil, time.Now(), nil)
+		require.NoError(t, err)
+		return txe, nil
+	}
+
+	// Both legs applied
+	batchTx := &payload.BatchTx{}
+	batchTx.Add(send(acc0, acc2, 10), send(acc1, acc2, 20))
+	txe, err := execute(batchTx, privAccounts[0], privAccounts[1])
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception)
+	assert.Len(t, txe.Events, 4)
+	assert.Equal(t, acc0.Balance()-10, getAccount(st, acc0.Address()).Balance())
+	assert.Equal(t, acc1.Balance()-20, getAccount(st, acc1.Address()).Balance())
+	assert.Equal(t, acc2.Balance()+30, getAccount(st, acc2.Address()).Balance())
+	assert.Equal(t, acc0.Sequence()+1, getAccount(st, acc0.Address()).Sequence())
+
+	acc0 = getAccount(st, acc0.Address())
+	acc2 = getAccount(st, acc2.Address())
+	// The second leg fails validation so the batch is rejected without effect
+	batchTx = &payload.BatchTx{}
+	batchTx.Add(send(acc0, acc2, 10), send(acc0, acc2, acc0.Balance()))
+	_, err = execute(batchTx, privAccounts[0])
+	require.Error(t, err)
+	assert.Equal(t, acc0.Balance(), getAccount(st, acc0.Address()).Balance())
+
+	// The second leg reverts so the first is rolled back but the batch is included and its fee is paid
+	batchTx = &payload.BatchTx{}
+	batchTx.Add(send(acc0, acc2, 10), &payload.CallTx{
+		Input:    &payload.TxInput{Address: acc0.Address(), Amount: 3},
+		Address:  &reverter,
+		GasLimit: 1000,
+		Fee:      2,
+	})
+	txe, err = execute(batchTx, privAccounts[0])
+	require.NoError(t, err)
+	require.NotNil(t, txe.Exception)
+	assert.Equal(t, errors.ErrorCodeExecutionReverted, txe.Exception.ErrorCode())
+	assert.Equal(t, acc0.Balance()-2, getAccount(st, acc0.Address()).Balance())
+	assert.Equal(t, acc2.Balance(), getAccount(st, acc2.Address()).Balance())
+	assert.Equal(t, acc0.Sequence()+1, getAccount(st, acc0.Address()).Sequence())
+
+	// Inner transactions may only spend from the inputs of the batch
+	batchTx = &payload.BatchTx{Txs: []*payload.Any{send(acc1, acc2, 10).Any()}}
+	batchTx.Inputs = []*payload.TxInput{{Address: acc0.Address()}}
+	_, err = execute(batchTx, privAccounts[0])
+	require.Error(t, err)
+
+	// Together the CallTxs may use no more gas than one CallTx
+	acc0 = getAccount(st, acc0.Address())
+	batchTx = &payload.BatchTx{}
+	for i := 0; i < 2; i++ {
+		batchTx.Add(&payload.CallTx{
+			Input:    &payload.TxInput{Address: acc0.Address(), Amount: 1},
+			Address:  &reverter,
+			GasLimit: contexts.GasLimit/2 + 1,
+		})
+	}
+	_, err = execute(batchTx, privAccounts[0])
+	require.Error(t, err)
+	assert.Equal(t, acc0.Balance(), getAccount(st, acc0.Address()).Balance())
+}
+
+func TestFees(t *testing.T) {
+	genDoc := newBaseGenDoc(permission.AllAccountPermissions, permission.AllAccountPermissions)
+	genDoc.MinimumFees = []genesis.MinimumFee{{TxType: "CallTx", Fee: 5}}
+	genDoc.ProposerFeePercentage = 40
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.NoError(t, err)
+	blockchain, err := bcm.LoadOrNewBlockchain(dbm.NewMemDB(), &genDoc, logger)
+	require.NoError(t, err)
+	exe := NewBatchCommitter(st, blockchain, event.NewNoOpPublisher(), logger)
+
+	validator := users[0].Address()
+	proposer := users[4].Address()
+	callee := crypto.Address{1, 2, 3}
+	call := func(signer acm.AddressableSigner, fee, sequence uint64) *payload.CallTx {
+		return &payload.CallTx{
+			Input:    &payload.TxInput{Address: signer.Address(), Amount: fee, Sequence: sequence},
+			Address:  &callee,
+			GasLimit: 100,
+			Fee:      fee,
+		}
+	}
+	execute := func(executor Executor, tx payload.Payload, signer acm.AddressableSigner) error {
+		txEnv := txs.Enclose(genDoc.ChainID(), tx)
+		require.NoError(t, txEnv.Sign(signer))
+		_, err := executor.Execute(txEnv)
+		return err
+	}
+	commit := func() *exec.BlockExecution {
+		_, err := exe.Commit(nil, time.Now(), &abciTypes.Header{
+			Height:          int64(blockchain.LastBlockHeight() + 1),
+			ProposerAddress: proposer.Bytes(),
+		})
+		require.NoError(t, err)
+		be, err := st.GetBlock(blockchain.LastBlockHeight())
+		require.NoError(t, err)
+		return be
+	}
+
+	err = execute(exe, call(users[1], 4, 1), users[1])
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeInsufficientFee, errors.AsException(err).ErrorCode())
+
+	require.NoError(t, execute(exe, call(users[1], 10, 1), users[1]))
+	require.NoError(t, execute(exe, call(users[1], 11, 2), users[1]))
+	be := commit()
+	// 40% of the 21 collected goes to the proposer and the rest to the only validator
+	assert.ElementsMatch(t, []*exec.FeeEvent{
+		{Recipient: proposer, Amount: 8, Proposer: true},
+		{Recipient: validator, Amount: 13},
+	}, be.FeeEvents)
+	assert.Equal(t, uint64(1000000-21), getAccount(st, users[1].Address()).Balance())
+	assert.Equal(t, uint64(1000000+8), getAccount(st, proposer).Balance())
+	assert.Equal(t, uint64(1000000+13), getAccount(st, validator).Balance())
+
+	// Raise the minimum fee for CallTx, which applies to the rest of the block
+	govTx := &payload.GovTx{
+		Inputs:      []*payload.TxInput{{Address: users[2].Address(), Sequence: 1}},
+		MinimumFees: []*payload.MinimumFee{{TxType: payload.TypeCall, Fee: 20}},
+	}
+	require.NoError(t, execute(exe, govTx, users[2]))
+	require.Error(t, execute(exe, call(users[1], 11, 3), users[1]))
+	commit()
+	minimumFee, err := st.GetMinimumFee(payload.TypeCall)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), minimumFee)
+
+	// Only transactions that carry a fee can have a minimum fee
+	govTx = &payload.GovTx{
+		Inputs:      []*payload.TxInput{{Address: users[2].Address(), Sequence: 2}},
+		MinimumFees: []*payload.MinimumFee{{TxType: payload.TypeSend, Fee: 1}},
+	}
+	require.Error(t, execute(exe, govTx, users[2]))
+
+	// A GovTx with an invalid minimum fee sets none of its minimum fees
+	govTx = &payload.GovTx{
+		Inputs: []*payload.TxInput{{Address: users[2].Address(), Sequence: 2}},
+		MinimumFees: []*payload.MinimumFee{
+			{TxType: payload.TypeCall, Fee: 30},
+			{TxType: payload.TypeSend, Fee: 1},
+		},
+	}
+	require.Error(t, execute(exe, govTx, users[2]))
+	require.NoError(t, execute(exe, call(users[1], 20, 3), users[1]))
+	commit()
+	minimumFee, err = st.GetMinimumFee(payload.TypeCall)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), minimumFee)
+
+	// Once the mempool holds two transactions the fee required to join it doubles
+	checker := NewBatchChecker(st, blockchain, logger, MempoolFeeThreshold(2))
+	require.NoError(t, execute(checker, call(users[3], 20, 1), users[3]))
+	require.NoError(t, execute(checker, call(users[3], 20, 2), users[3]))
+	err = execute(checker, call(users[3], 39, 3), users[3])
+	require.Error(t, err)
+	assert.Equal(t, errors.ErrorCodeInsufficientFee, errors.AsException(err).ErrorCode())
+	require.NoError(t, execute(checker, call(users[3], 40, 3), users[3]))
+	require.NoError(t, checker.Reset())
+	require.NoError(t, execute(checker, call(users[3], 20, 1), users[3]))
+
+	// Genesis minimum fees are checked
+	genDoc.MinimumFees = []genesis.MinimumFee{{TxType: "SendTx", Fee: 1}}
+	_, err = MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.Error(t, err)
+}
+
+func TestSlashing(t *testing.T) {
+	genDoc := newBaseGenDoc(permission.AllAccountPermissions, permission.AllAccountPermissions)
+	genDoc.Validators = nil
+	for _, user := range users[:4] {
+		genDoc.Validators = append(genDoc.Validators, genesis.Validator{
+			BasicAccount: genesis.BasicAccount{PublicKey: user.PublicKey(), Amount: 100},
+		})
+	}
+	genDoc.Slashing = &genesis.Slashing{
+		DoubleSignPercentage: 50,
+		DowntimeWindow:       4,
+		MaxMissedBlocks:      2,
+		JailPeriod:           3,
+	}
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.NoError(t, err)
+	blockchain, err := bcm.LoadOrNewBlockchain(dbm.NewMemDB(), &genDoc, logger)
+	require.NoError(t, err)
+	exe := NewBatchCommitter(st, blockchain, event.NewNoOpPublisher(), logger)
+
+	doubleSigner := users[1].Address()
+	absentee := users[2].Address()
+	block := func(evidence []abciTypes.Evidence, missed ...crypto.Address) *exec.BlockExecution {
+		height := int64(blockchain.LastBlockHeight() + 1)
+		req := &abciTypes.RequestBeginBlock{
+			Header:              abciTypes.Header{Height: height},
+			ByzantineValidators: evidence,
+		}
+		for _, address := range missed {
+			req.LastCommitInfo.Votes = append(req.LastCommitInfo.Votes, abciTypes.VoteInfo{
+				Validator: abciTypes.Validator{Address: address.Bytes()},
+			})
+		}
+		require.NoError(t, exe.BeginBlock(req))
+		// Beginning the block again, as when it is replayed, must not penalise anyone twice
+		require.NoError(t, exe.BeginBlock(req))
+		_, err := exe.Commit(nil, time.Now(), &req.Header)
+		require.NoError(t, err)
+		be, err := st.GetBlock(uint64(height))
+		require.NoError(t, err)
+		return be
+	}
+	power := func(address crypto.Address) uint64 {
+		return blockchain.CurrentValidators().Power(address).Uint64()
+	}
+	jailed := func(address crypto.Address) *exec.Jailing {
+		jailing, err := st.GetJailing(address)
+		require.NoError(t, err)
+		return jailing
+	}
+	missed := func(address crypto.Address) []uint64 {
+		missedBlocks, err := st.GetMissedBlocks(address)
+		require.NoError(t, err)
+		return missedBlocks.Heights
+	}
+
+	// Height 1: half the double-signer's power is destroyed and the rest withheld until height 4
+	be := block([]abciTypes.Evidence{{
+		Type:      "duplicate/vote",
+		Validator: abciTypes.Validator{Address: doubleSigner.Bytes(), Power: 100},
+	}})
+	assert.Equal(t, []*exec.SlashEvent{{
+		Validator:     doubleSigner,
+		Reason:        exec.SlashReasonDoubleSign,
+		Slashed:       50,
+		Jailed:        50,
+		ReleaseHeight: 4,
+	}}, be.SlashEvents)
+	assert.Equal(t, uint64(0), power(doubleSigner))
+	require.NotNil(t, jailed(doubleSigner))
+
+	// A jailed validator cannot bond
+	bondTx := &payload.BondTx{Inputs: []*payload.TxInput{{Address: doubleSigner, Amount: 10, Sequence: 1}}}
+	txEnv := txs.Enclose(genDoc.ChainID(), bondTx)
+	require.NoError(t, txEnv.Sign(users[1]))
+	_, err = exe.Execute(txEnv)
+	require.Error(t, err)
+
+	// Heights 2 to 4: the absentee misses blocks 2 and 3, which is within the limit, and the double-signer is released
+	block(nil)
+	be = block(nil, absentee)
+	assert.Empty(t, be.SlashEvents)
+	be = block(nil, absentee)
+	assert.Empty(t, be.SlashEvents)
+	assert.Equal(t, []uint64{2, 3}, missed(absentee))
+	assert.Equal(t, []*exec.ReleaseEvent{{Validator: doubleSigner, Power: 50}}, be.ReleaseEvents)
+	assert.Equal(t, uint64(50), power(doubleSigner))
+	assert.Nil(t, jailed(doubleSigner))
+
+	// Height 5: missing block 4 is one too many in the window so the absentee is jailed with all of its power
+	be = block(nil, absentee)
+	assert.Equal(t, []*exec.SlashEvent{{
+		Validator:     absentee,
+		Reason:        exec.SlashReasonDowntime,
+		Jailed:        100,
+		ReleaseHeight: 8,
+	}}, be.SlashEvents)
+	assert.Equal(t, uint64(0), power(absentee))
+
+	assert.Equal(t, &exec.Jailing{
+		PublicKey:     users[2].PublicKey(),
+		Power:         100,
+		Height:        5,
+		ReleaseHeight: 8,
+	}, jailed(absentee))
+	assert.Empty(t, missed(absentee))
+
+	// Jailings are committed with the state of the block that made them
+	for height, isJailed := range map[uint64]bool{4: false, 5: true} {
+		stAt, err := st.AtHeight(height)
+		require.NoError(t, err)
+		jailing, err := stAt.GetJailing(absentee)
+		require.NoError(t, err)
+		assert.Equal(t, isJailed, jailing != nil, "jailed at height %d", height)
+	}
+}
+
+func TestSlashing_DeterministicHash(t *testing.T) {
+	genDoc := newBaseGenDoc(permission.AllAccountPermissions, permission.AllAccountPermissions)
+	genDoc.Validators = nil
+	for i, user := range users {
+		// The small validators can all be jailed at once without exceeding the limit on the change in power
+		power := uint64(1000)
+		if i >= 6 {
+			power = 1
+		}
+		genDoc.Validators = append(genDoc.Validators, genesis.Validator{
+			BasicAccount: genesis.BasicAccount{PublicKey: user.PublicKey(), Amount: power},
+		})
+	}
+	genDoc.Slashing = &genesis.Slashing{
+		DoubleSignPercentage: 50,
+		DowntimeWindow:       4,
+		MaxMissedBlocks:      2,
+		JailPeriod:           3,
+	}
+	// Jails the small validators at height 1 and records missed blocks for the large ones at height 2
+	run := func() [][]byte {
+		st, err := MakeGenesisState(dbm.NewMemDB(), &genDoc)
+		require.NoError(t, err)
+		blockchain, err := bcm.LoadOrNewBlockchain(dbm.NewMemDB(), &genDoc, logger)
+		require.NoError(t, err)
+		exe := NewBatchCommitter(st, blockchain, event.NewNoOpPublisher(), logger)
+		var hashes [][]byte
+		for height := int64(1); height <= 2; height++ {
+			req := &abciTypes.RequestBeginBlock{Header: abciTypes.Header{Height: height}}
+			for i, user := range users {
+				if height == 1 && i >= 6 {
+					req.ByzantineValidators = append(req.ByzantineValidators, abciTypes.Evidence{
+						Type:      "duplicate/vote",
+						Validator: abciTypes.Validator{Address: user.Address().Bytes(), Power: 1},
+					})
+				} else if height == 2 && i < 6 {
+					req.LastCommitInfo.Votes = append(req.LastCommitInfo.Votes, abciTypes.VoteInfo{
+						Validator: abciTypes.Validator{Address: user.Address().Bytes()},
+					})
+				}
+			}
+			require.NoError(t, exe.BeginBlock(req))
+			hash, err := exe.Commit(nil, time.Now(), &req.Header)
+			require.NoError(t, err)
+			hashes = append(hashes, hash)
+		}
+		jailed := 0
+		_, err = st.IterateJailings(func(jailing *exec.Jailing) (stop bool) {
+			jailed++
+			return false
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, jailed)
+		missedBlocks, err := st.GetMissedBlocks(users[0].Address())
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1}, missedBlocks.Heights)
+		return hashes
+	}
+	hashes := run()
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, hashes, run())
+	}
+}
+
 /*
 contract Caller {
    function send(address x){
@@ -1148,6 +1697,100 @@ contract Caller {
 var callerCode, _ = hex.DecodeString("60606040526000357c0100000000000000000000000000000000000000000000000000000000900480633e58c58c146037576035565b005b604b6004808035906020019091905050604d565b005b8073ffffffffffffffffffffffffffffffffffffffff16600034604051809050600060405180830381858888f19350505050505b5056")
 var sendData, _ = hex.DecodeString("3e58c58c")
 
+func TestMultisig(t *testing.T) {
+	genDoc := newBaseGenDoc(permission.AllAccountPermissions, permission.AllAccountPermissions)
+	st, err := MakeGenesisState(dbm.NewMemDB(), &genDoc)
+	require.NoError(t, err)
+	blockchain, err := bcm.LoadOrNewBlockchain(dbm.NewMemDB(), &genDoc, logger)
+	require.NoError(t, err)
+	exe := NewBatchCommitter(st, blockchain, event.NewNoOpPublisher(), logger)
+
+	// A treasury that needs two of three officers to sign
+	policy, err := acm.NewMultisigPolicy(2, users[1].PublicKey(), users[2].PublicKey(), users[3].PublicKey())
+	require.NoError(t, err)
+	treasury := policy.Address()
+	recipient := users[4].Address()
+	send := func(amount uint64) *txs.Envelope {
+		sequence := getAccount(exe, treasury).Sequence() + 1
+		return txs.Enclose(genDoc.ChainID(), &payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: treasury, Amount: amount, Sequence: sequence}},
+			Outputs: []*payload.TxOutput{{Address: recipient, Amount: amount}},
+		})
+	}
+	execute := func(txEnv *txs.Envelope) error {
+		_, err := exe.Execute(txEnv)
+		return err
+	}
+
+	// Multisig accounts are created by GovTx
+	govTx := txs.Enclose(genDoc.ChainID(), &payload.GovTx{
+		Inputs: []*payload.TxInput{{Address: users[0].Address(), Sequence: 1}},
+		AccountUpdates: []*spec.TemplateAccount{{
+			Multisig: policy,
+			Amounts:  balance.New().Native(1000),
+		}},
+	})
+	require.NoError(t, govTx.Sign(users[0]))
+	require.NoError(t, execute(govTx))
+	acc := getAccount(exe, treasury)
+	assert.Equal(t, policy, acc.Multisig())
+	assert.Equal(t, uint64(1000), acc.Balance())
+
+	// One officer is not enough
+	txEnv := send(100)
+	require.NoError(t, txEnv.SignMultisig([]*acm.MultisigPolicy{policy}, users[1]))
+	require.Error(t, execute(txEnv))
+	// Nor is one officer signing twice
+	txEnv.Signatories[0].Multisig = append(txEnv.Signatories[0].Multisig, txEnv.Signatories[0].Multisig[0])
+	require.Error(t, execute(txEnv))
+	// Nor can a single key sign for the account
+	txEnv = send(100)
+	require.Error(t, txEnv.Sign(users[1]))
+	signBytes, err := txEnv.Tx.SignBytes()
+	require.NoError(t, err)
+	signature, err := users[1].Sign(signBytes)
+	require.NoError(t, err)
+	txEnv.Signatories = []txs.Signatory{{Address: &treasury, PublicKey: addressablePublicKey(users[1]),
+		Signature: signature}}
+	require.Error(t, execute(txEnv))
+	// Nor can outsiders sign
+	txEnv = send(100)
+	require.Error(t, txEnv.SignMultisig([]*acm.MultisigPolicy{policy}, users[5], users[6]))
+
+	txEnv = send(100)
+	require.NoError(t, txEnv.SignMultisig([]*acm.MultisigPolicy{policy}, users[1], users[3]))
+	require.NoError(t, execute(txEnv))
+	acc = getAccount(exe, treasury)
+	assert.Equal(t, uint64(900), acc.Balance())
+	assert.Equal(t, uint64(1), acc.Sequence())
+	assert.Equal(t, uint64(1000100), getAccount(exe, recipient).Balance())
+
+	// Officers can sign separately and combine their signatures
+	txEnv = send(50)
+	other := &txs.Envelope{Tx: txEnv.Tx}
+	require.NoError(t, txEnv.SignMultisig([]*acm.MultisigPolicy{policy}, users[2]))
+	require.NoError(t, other.SignMultisig([]*acm.MultisigPolicy{policy}, users[3]))
+	txEnv.Signatories[0].Multisig = append(txEnv.Signatories[0].Multisig, other.Signatories[0].Multisig...)
+	require.NoError(t, execute(txEnv))
+	assert.Equal(t, uint64(850), getAccount(exe, treasury).Balance())
+
+	// The address of a multisig account must match its policy
+	govTx = txs.Enclose(genDoc.ChainID(), &payload.GovTx{
+		Inputs: []*payload.TxInput{{Address: users[0].Address(), Sequence: 2}},
+		AccountUpdates: []*spec.TemplateAccount{{
+			Address:  &recipient,
+			Multisig: policy,
+		}},
+	})
+	require.NoError(t, govTx.Sign(users[0]))
+	require.Error(t, execute(govTx))
+}
+
+func addressablePublicKey(addressable crypto.Addressable) *crypto.PublicKey {
+	publicKey := addressable.PublicKey()
+	return &publicKey
+}
+
 func TestContractSend(t *testing.T) {
 	st, privAccounts := makeGenesisState(3, true, 1000, 1, true, 1000)
 
@@ -1527,9 +2170,10 @@ type testExecutor struct {
 
 func makeExecutor(state *State) *testExecutor {
 	blockchain := newBlockchain(testGenesisDoc)
+	exe := newExecutor("makeExecutorCache", true, state, blockchain, event.NewNoOpPublisher(), logger)
+	exe.blockchain = blockchain
 	return &testExecutor{
-		executor: newExecutor("makeExecutorCache", true, state, blockchain, event.NewNoOpPublisher(),
-			logger),
+		executor: exe,
 	}
 }
 
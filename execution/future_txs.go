@@ -0,0 +1,155 @@
+package execution
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+)
+
+const (
+	DefaultFutureTxTTL   = time.Minute
+	DefaultFutureTxLimit = 64
+)
+
+// A signed transaction held back from the mempool until its input has the sequence number before Sequence
+type FutureTx struct {
+	Envelope *txs.Envelope
+	Address  crypto.Address
+	Sequence uint64
+	Expires  time.Time
+}
+
+// FutureTxQueue holds transactions whose input sequence numbers are ahead of the mempool so that clients can submit
+// pre-signed transactions out of order. It does not check transactions itself, see Transactor.
+type FutureTxQueue struct {
+	sync.Mutex
+	ttl   time.Duration
+	limit int
+	txs   map[crypto.Address]map[uint64]*FutureTx
+}
+
+// Transactions are dropped after ttl and at most limit transactions are queued for any one address
+func NewFutureTxQueue(ttl time.Duration, limit int) *FutureTxQueue {
+	return &FutureTxQueue{
+		ttl:   ttl,
+		limit: limit,
+		txs:   make(map[crypto.Address]map[uint64]*FutureTx),
+	}
+}
+
+// Queue txEnv on the sequence of the input at address, replacing any transaction already queued on that sequence.
+// The caller must have verified the signatures of txEnv, which must include a signatory for that input so that only
+// the holder of address can replace what is queued for it.
+func (ftq *FutureTxQueue) Push(txEnv *txs.Envelope, address crypto.Address, sequence uint64) (*FutureTx, error) {
+	if !signsInput(txEnv, address, sequence) {
+		return nil, fmt.Errorf("cannot queue transaction %X since it has no signatory for the input from %v with "+
+			"sequence %d", txEnv.Tx.Hash(), address, sequence)
+	}
+	ftq.Lock()
+	defer ftq.Unlock()
+	queued := ftq.txs[address]
+	if queued == nil {
+		queued = make(map[uint64]*FutureTx)
+		ftq.txs[address] = queued
+	}
+	if _, ok := queued[sequence]; !ok && len(queued) >= ftq.limit {
+		return nil, fmt.Errorf("cannot queue transaction with sequence %d for %v since %d transactions are "+
+			"already queued for that address", sequence, address, len(queued))
+	}
+	ftx := &FutureTx{
+		Envelope: txEnv,
+		Address:  address,
+		Sequence: sequence,
+		Expires:  time.Now().Add(ftq.ttl),
+	}
+	queued[sequence] = ftx
+	return ftx, nil
+}
+
+// Returns the transaction queued on sequence for address or nil if there is none
+func (ftq *FutureTxQueue) Get(address crypto.Address, sequence uint64) *FutureTx {
+	ftq.Lock()
+	defer ftq.Unlock()
+	return ftq.txs[address][sequence]
+}
+
+func (ftq *FutureTxQueue) Remove(address crypto.Address, sequence uint64) {
+	ftq.Lock()
+	defer ftq.Unlock()
+	ftq.remove(address, sequence)
+}
+
+// Drops and returns the transactions that expired before now
+func (ftq *FutureTxQueue) Expire(now time.Time) []*FutureTx {
+	ftq.Lock()
+	defer ftq.Unlock()
+	var expired []*FutureTx
+	for _, queued := range ftq.txs {
+		for _, ftx := range queued {
+			if ftx.Expires.Before(now) {
+				expired = append(expired, ftx)
+			}
+		}
+	}
+	for _, ftx := range expired {
+		ftq.remove(ftx.Address, ftx.Sequence)
+	}
+	return expired
+}
+
+// The addresses with queued transactions in address order
+func (ftq *FutureTxQueue) Addresses() crypto.Addresses {
+	ftq.Lock()
+	defer ftq.Unlock()
+	addresses := make(crypto.Addresses, 0, len(ftq.txs))
+	for address := range ftq.txs {
+		addresses = append(addresses, address)
+	}
+	sort.Sort(addresses)
+	return addresses
+}
+
+// Lists the queued transactions in address then sequence order, only those for address if it is not nil
+func (ftq *FutureTxQueue) List(address *crypto.Address) []*FutureTx {
+	addresses := ftq.Addresses()
+	if address != nil {
+		addresses = crypto.Addresses{*address}
+	}
+	ftq.Lock()
+	defer ftq.Unlock()
+	var ftxs []*FutureTx
+	for _, addr := range addresses {
+		start := len(ftxs)
+		for _, ftx := range ftq.txs[addr] {
+			ftxs = append(ftxs, ftx)
+		}
+		forAddress := ftxs[start:]
+		sort.Slice(forAddress, func(i, j int) bool {
+			return forAddress[i].Sequence < forAddress[j].Sequence
+		})
+	}
+	return ftxs
+}
+
+func (ftq *FutureTxQueue) remove(address crypto.Address, sequence uint64) {
+	queued := ftq.txs[address]
+	delete(queued, sequence)
+	if len(queued) == 0 {
+		delete(ftq.txs, address)
+	}
+}
+
+// Whether txEnv has an input from address with sequence and a signatory for it (signatories are in input order)
+func signsInput(txEnv *txs.Envelope, address crypto.Address, sequence uint64) bool {
+	for i, input := range txEnv.Tx.GetInputs() {
+		if input.Address == address && input.Sequence == sequence {
+			return i < len(txEnv.Signatories) && txEnv.Signatories[i].Address != nil &&
+				*txEnv.Signatories[i].Address == address
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFutureTxQueue(t *testing.T) {
+	addressA := crypto.Address{1}
+	addressB := crypto.Address{2}
+	// Signatures are verified by the Transactor so only the signatory addresses matter here
+	txEnv := func(address crypto.Address, sequence uint64) *txs.Envelope {
+		txEnv := txs.Enclose("TestChain", &payload.CallTx{Input: &payload.TxInput{Address: address, Sequence: sequence}})
+		txEnv.Signatories = []txs.Signatory{{Address: &address}}
+		return txEnv
+	}
+	ftq := NewFutureTxQueue(time.Minute, 2)
+
+	_, err := ftq.Push(txEnv(addressB, 3), addressB, 3)
+	require.NoError(t, err)
+	_, err = ftq.Push(txEnv(addressA, 9), addressA, 9)
+	require.NoError(t, err)
+	_, err = ftq.Push(txEnv(addressA, 7), addressA, 7)
+	require.NoError(t, err)
+	_, err = ftq.Push(txEnv(addressA, 8), addressA, 8)
+	require.Error(t, err)
+	// Replacing a queued transaction does not count towards the limit
+	_, err = ftq.Push(txEnv(addressA, 7), addressA, 7)
+	require.NoError(t, err)
+	// But it must be signed for the same input
+	_, err = ftq.Push(txEnv(addressB, 7), addressA, 7)
+	require.Error(t, err)
+	unsigned := txEnv(addressA, 7)
+	unsigned.Signatories = nil
+	_, err = ftq.Push(unsigned, addressA, 7)
+	require.Error(t, err)
+
+	var listed []uint64
+	for _, ftx := range ftq.List(nil) {
+		listed = append(listed, ftx.Sequence)
+	}
+	assert.Equal(t, []uint64{7, 9, 3}, listed)
+	assert.Len(t, ftq.List(&addressB), 1)
+	assert.Equal(t, crypto.Addresses{addressA, addressB}, ftq.Addresses())
+
+	assert.NotNil(t, ftq.Get(addressA, 7))
+	ftq.Remove(addressA, 7)
+	assert.Nil(t, ftq.Get(addressA, 7))
+
+	assert.Empty(t, ftq.Expire(time.Now()))
+	assert.Len(t, ftq.Expire(time.Now().Add(time.Hour)), 2)
+	assert.Empty(t, ftq.Addresses())
+}
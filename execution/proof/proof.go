@@ -0,0 +1,117 @@
+// Package proof provides the means to verify values read from burrow's state tree against a state hash (the AppHash
+// of a block header) without trusting the node that served them.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+)
+
+// The layout of keys in the state tree
+var (
+	AccountKeyFormat = storage.NewMustKeyFormat("a", crypto.AddressLength)
+	StorageKeyFormat = storage.NewMustKeyFormat("s", crypto.AddressLength, binary.Word256Length)
+	NameKeyFormat    = storage.NewMustKeyFormat("n", storage.VariadicSegmentLength)
+)
+
+var cdc = amino.NewCodec()
+
+// Make a Proof from an IAVL RangeProof of key
+func New(height uint64, stateHash, key []byte, rangeProof *iavl.RangeProof) (*Proof, error) {
+	bs, err := cdc.MarshalBinaryBare(rangeProof)
+	if err != nil {
+		return nil, fmt.Errorf("could not encode RangeProof: %v", err)
+	}
+	return &Proof{
+		Height:     height,
+		StateHash:  stateHash,
+		Key:        key,
+		RangeProof: bs,
+	}, nil
+}
+
+// Decode the IAVL RangeProof
+func (p *Proof) GetRangeProof() (*iavl.RangeProof, error) {
+	rangeProof := new(iavl.RangeProof)
+	err := cdc.UnmarshalBinaryBare(p.RangeProof, rangeProof)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode RangeProof: %v", err)
+	}
+	return rangeProof, nil
+}
+
+// Verify that value is stored at key in the state tree with root stateHash, or if value is nil that key is absent
+func (p *Proof) Verify(stateHash, key, value []byte) error {
+	if !bytes.Equal(key, p.Key) {
+		return fmt.Errorf("proof is for key %X but key %X was expected", p.Key, key)
+	}
+	rangeProof, err := p.GetRangeProof()
+	if err != nil {
+		return err
+	}
+	err = rangeProof.Verify(stateHash)
+	if err != nil {
+		return fmt.Errorf("proof does not verify against state hash %X: %v", stateHash, err)
+	}
+	if value == nil {
+		err = rangeProof.VerifyAbsence(key)
+		if err != nil {
+			return fmt.Errorf("proof does not show key %X is absent: %v", key, err)
+		}
+		return nil
+	}
+	err = rangeProof.VerifyItem(key, value)
+	if err != nil {
+		return fmt.Errorf("proof does not show key %X has value %X: %v", key, value, err)
+	}
+	return nil
+}
+
+// Verify that account is stored at address, or if account is nil that there is no account at address
+func (p *Proof) VerifyAccount(stateHash []byte, address crypto.Address, account acm.Account) error {
+	var value []byte
+	if account != nil {
+		if account.Address() != address {
+			return fmt.Errorf("account has address %v but address %v was expected", account.Address(), address)
+		}
+		var err error
+		value, err = account.Encode()
+		if err != nil {
+			return err
+		}
+	}
+	return p.Verify(stateHash, AccountKeyFormat.Key(address), value)
+}
+
+// Verify that value is stored at key in the storage of address (a zero value is not stored)
+func (p *Proof) VerifyStorage(stateHash []byte, address crypto.Address, key, value binary.Word256) error {
+	var bs []byte
+	if value != binary.Zero256 {
+		bs = value.Bytes()
+	}
+	return p.Verify(stateHash, StorageKeyFormat.Key(address, key), bs)
+}
+
+// Verify that entry is registered under name, or if entry is nil that name is not registered
+func (p *Proof) VerifyName(stateHash []byte, name string, entry *names.Entry) error {
+	var value []byte
+	if entry != nil {
+		if entry.Name != name {
+			return fmt.Errorf("entry has name %s but name %s was expected", entry.Name, name)
+		}
+		var err error
+		value, err = entry.Encode()
+		if err != nil {
+			return err
+		}
+	}
+	return p.Verify(stateHash, NameKeyFormat.Key(name), value)
+}
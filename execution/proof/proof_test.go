@@ -0,0 +1,47 @@
+package proof
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestProof_VerifyStorage(t *testing.T) {
+	tree := storage.NewRWTree(dbm.NewMemDB(), 100)
+	address := crypto.Address{1, 2, 3}
+	for i := int64(1); i <= 10; i++ {
+		tree.Set(StorageKeyFormat.Key(address, binary.Int64ToWord256(i*2)), binary.Int64ToWord256(i).Bytes())
+	}
+	stateHash, _, err := tree.Save()
+	require.NoError(t, err)
+
+	prove := func(key binary.Word256) *Proof {
+		_, rangeProof, err := tree.GetWithProof(StorageKeyFormat.Key(address, key))
+		require.NoError(t, err)
+		prf, err := New(1, stateHash, StorageKeyFormat.Key(address, key), rangeProof)
+		require.NoError(t, err)
+		// Check the proof survives encoding
+		bs, err := prf.Marshal()
+		require.NoError(t, err)
+		prf = new(Proof)
+		require.NoError(t, prf.Unmarshal(bs))
+		return prf
+	}
+
+	prf := prove(binary.Int64ToWord256(4))
+	assert.NoError(t, prf.VerifyStorage(stateHash, address, binary.Int64ToWord256(4), binary.Int64ToWord256(2)))
+	assert.Error(t, prf.VerifyStorage(stateHash, address, binary.Int64ToWord256(4), binary.Int64ToWord256(3)))
+	assert.Error(t, prf.VerifyStorage(stateHash, address, binary.Int64ToWord256(6), binary.Int64ToWord256(3)))
+	assert.Error(t, prf.VerifyStorage([]byte("some other hash"), address, binary.Int64ToWord256(4),
+		binary.Int64ToWord256(2)))
+
+	// Absent values are stored as zero
+	prf = prove(binary.Int64ToWord256(5))
+	assert.NoError(t, prf.VerifyStorage(stateHash, address, binary.Int64ToWord256(5), binary.Zero256))
+	assert.Error(t, prf.VerifyStorage(stateHash, address, binary.Int64ToWord256(5), binary.Int64ToWord256(1)))
+}
@@ -0,0 +1,184 @@
+// Copyright 2017 Monax Industries Limited
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package execution
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/txs/payload"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// Re-executes transactions from committed blocks against the state as it was at the start of their block, and
+// provides access to historical state. Nothing is persisted.
+type Replayer struct {
+	state   *State
+	tip     bcm.BlockchainInfo
+	logger  *logging.Logger
+	options []ExecutionOption
+}
+
+// options should match those given to the BatchCommitter for replayed transactions to execute identically
+func NewReplayer(st *State, tip bcm.BlockchainInfo, logger *logging.Logger, options ...ExecutionOption) *Replayer {
+	return &Replayer{
+		state:   st,
+		tip:     tip,
+		logger:  logger.WithScope("Replayer"),
+		options: options,
+	}
+}
+
+// Replays the transaction identified by txHash by first replaying those that preceded it in its block. The EVM
+// executing the transaction itself will have vmOptions applied (for instance to attach a Tracer).
+func (rp *Replayer) ReplayTx(txHash []byte, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	txe, err := rp.state.GetTx(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if txe == nil {
+		return nil, fmt.Errorf("transaction with hash %X not found in state", txHash)
+	}
+	be, err := rp.state.GetBlock(txe.Height)
+	if err != nil {
+		return nil, err
+	}
+	if be == nil {
+		return nil, fmt.Errorf("block at height %v containing transaction %X not found in state", txe.Height, txHash)
+	}
+	header, err := decodeHeader(be)
+	if err != nil {
+		return nil, err
+	}
+	tip, err := rp.tipBefore(header)
+	if err != nil {
+		return nil, err
+	}
+	// The app hash in the header for a block is that of the state after the previous block
+	st, err := LoadState(rp.state.db, header.AppHash)
+	if err != nil {
+		return nil, fmt.Errorf("could not load state prior to block %v: %v", be.Height, err)
+	}
+	exe := newExecutor("ReplayCache", true, st, tip, event.NewNoOpPublisher(), rp.logger, rp.options...)
+	exe.addValidatorContexts(validator.Copy(tip.Validators()))
+	for _, previous := range be.TxExecutions {
+		if bytes.Equal(previous.TxHash, txe.TxHash) {
+			break
+		}
+		// Transactions that failed originally will fail again and this is not our concern here
+		_, err = exe.Execute(previous.Envelope)
+		if err != nil {
+			rp.logger.TraceMsg("Replayed transaction failed", "tx_hash", previous.TxHash, structure.ErrorKey, err)
+		}
+	}
+	callContext := exe.contexts[payload.TypeCall].(*contexts.CallContext)
+	callContext.VMOptions = append(append([]func(*evm.VM){}, callContext.VMOptions...), vmOptions...)
+	return exe.Execute(txe.Envelope)
+}
+
+// Returns the state and the chain as they were once the block at height was committed
+func (rp *Replayer) AtHeight(height uint64) (*ReadState, bcm.BlockchainInfo, error) {
+	st, err := rp.state.AtHeight(height)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The hash is only available if height is within the window of recent block hashes
+	blockHash, _ := rp.tip.BlockHash(height)
+	tip, err := rp.tipAt(height, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, tip, nil
+}
+
+// Returns the chain as it was before the block with header was executed
+func (rp *Replayer) tipBefore(header *abciTypes.Header) (*replayTip, error) {
+	return rp.tipAt(uint64(header.Height)-1, header.LastBlockId.Hash)
+}
+
+func (rp *Replayer) tipAt(height uint64, blockHash []byte) (*replayTip, error) {
+	tip := &replayTip{
+		BlockchainInfo:  rp.tip,
+		lastBlockHeight: height,
+		lastBlockHash:   blockHash,
+		lastBlockTime:   rp.tip.GenesisDoc().GenesisTime,
+	}
+	if height > 0 {
+		be, err := rp.state.GetBlock(height)
+		if err != nil {
+			return nil, err
+		}
+		if be != nil {
+			header, err := decodeHeader(be)
+			if err != nil {
+				return nil, err
+			}
+			tip.lastBlockTime = header.Time
+		}
+	}
+	return tip, nil
+}
+
+func decodeHeader(be *exec.BlockExecution) (*abciTypes.Header, error) {
+	if be.BlockHeader == nil {
+		return nil, fmt.Errorf("block at height %v has no header so cannot be replayed", be.Height)
+	}
+	header := new(abciTypes.Header)
+	err := json.Unmarshal([]byte(be.BlockHeader.JSON), header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode header of block at height %v: %v", be.Height, err)
+	}
+	return header, nil
+}
+
+// Presents the chain as it was at an earlier height
+type replayTip struct {
+	bcm.BlockchainInfo
+	lastBlockHeight uint64
+	lastBlockHash   []byte
+	lastBlockTime   time.Time
+}
+
+func (rt *replayTip) LastBlockHeight() uint64 {
+	return rt.lastBlockHeight
+}
+
+func (rt *replayTip) LastBlockHash() []byte {
+	return rt.lastBlockHash
+}
+
+func (rt *replayTip) LastBlockTime() time.Time {
+	return rt.lastBlockTime
+}
+
+func (rt *replayTip) BlockHash(height uint64) ([]byte, error) {
+	if height > rt.lastBlockHeight {
+		return nil, fmt.Errorf("block %v is after the replayed block height %v", height, rt.lastBlockHeight)
+	}
+	if height == rt.lastBlockHeight {
+		return rt.lastBlockHash, nil
+	}
+	return rt.BlockchainInfo.BlockHash(height)
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 Monax Industries Limited
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package execution
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/evm"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestReplayer_ReplayTx(t *testing.T) {
+	st, privAccounts := makeGenesisState(2, false, 1000, 1, false, 1000)
+	// A counter that increments the word in storage slot zero and returns it
+	counter := getAccount(st, privAccounts[1].Address())
+	counter.SetCode(bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 1, ADD, DUP1, PUSH1, 0, SSTORE,
+		PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN))
+	appHash, err := st.Update(func(ws Updatable) error {
+		return ws.UpdateAccount(counter)
+	})
+	require.NoError(t, err)
+
+	exe := makeExecutor(st)
+	var txHashes [][]byte
+	for i := uint64(1); i <= 2; i++ {
+		txEnv := txs.Enclose(testChainID, &payload.CallTx{
+			Input: &payload.TxInput{
+				Address:  privAccounts[0].Address(),
+				Amount:   1,
+				Sequence: i,
+			},
+			Address:  addressPtr(counter),
+			GasLimit: 1000,
+		})
+		require.NoError(t, txEnv.Sign(privAccounts[0]))
+		_, err = exe.Execute(txEnv)
+		require.NoError(t, err)
+		txHashes = append(txHashes, txEnv.Tx.Hash())
+	}
+	_, err = exe.Commit([]byte("Blocky McHash"), time.Now(), &abciTypes.Header{
+		Height:  1,
+		Time:    time.Now(),
+		AppHash: appHash,
+	})
+	require.NoError(t, err)
+
+	replayer := NewReplayer(st, exe.blockchain, logger)
+	for i, txHash := range txHashes {
+		tracer := evm.NewStructLogger(nil)
+		txe, err := replayer.ReplayTx(txHash, evm.TraceWith(tracer))
+		require.NoError(t, err)
+		assert.Nil(t, txe.Exception)
+		// Only the replayed transaction itself is traced
+		expected := binary.Int64ToWord256(int64(i + 1)).Bytes()
+		assert.Equal(t, expected, txe.Result.Return)
+		assert.Equal(t, hex.EncodeToString(expected), tracer.Trace().ReturnValue)
+		assert.Equal(t, "RETURN", tracer.Trace().StructLogs[len(tracer.Trace().StructLogs)-1].Op)
+	}
+
+	// Replaying does not touch committed state
+	stored, err := st.GetStorage(counter.Address(), binary.Zero256)
+	require.NoError(t, err)
+	assert.Equal(t, binary.Int64ToWord256(2), stored)
+
+	_, err = replayer.ReplayTx(make([]byte, len(txHashes[0])))
+	assert.Error(t, err)
+}
@@ -6,33 +6,50 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
+// The gas limit with which EstimateGas first runs a CallTx that has no GasLimit of its own
+const MaxGasEstimate = uint64(1 << 30)
+
 // Run a contract's code on an isolated and unpersisted state
 // Cannot be used to create new contracts
 func CallSim(reader state.Reader, tip bcm.BlockchainInfo, fromAddress, address crypto.Address, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+	natives *evm.Natives, logger *logging.Logger, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
 
+	return CallTxSim(reader, tip, &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: fromAddress,
+		},
+		Address:  &address,
+		Data:     data,
+		GasLimit: contexts.GasLimit,
+	}, natives, logger, vmOptions...)
+}
+
+// Run a CallTx (which creates a contract if it has no Address) on an isolated and unpersisted state. Any natives
+// are callable just as they are by the executor.
+func CallTxSim(reader state.Reader, tip bcm.BlockchainInfo, tx *payload.CallTx, natives *evm.Natives,
+	logger *logging.Logger, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+
+	if natives != nil {
+		vmOptions = append(vmOptions[:len(vmOptions):len(vmOptions)], evm.WithNatives(natives))
+	}
 	cache := state.NewCache(reader)
 	exe := contexts.CallContext{
 		RunCall:     true,
 		StateWriter: cache,
 		Tip:         tip,
+		VMOptions:   vmOptions,
+		Natives:     natives,
 		Logger:      logger,
 	}
 
-	txe := exec.NewTxExecution(txs.Enclose(tip.ChainID(), &payload.CallTx{
-		Input: &payload.TxInput{
-			Address: fromAddress,
-		},
-		Address:  &address,
-		Data:     data,
-		GasLimit: contexts.GasLimit,
-	}))
+	txe := exec.NewTxExecution(txs.Enclose(tip.ChainID(), tx))
 	err := exe.Execute(txe)
 	if err != nil {
 		return nil, err
@@ -43,7 +60,7 @@ func CallSim(reader state.Reader, tip bcm.BlockchainInfo, fromAddress, address c
 // Run the given code on an isolated and unpersisted state
 // Cannot be used to create new contracts.
 func CallCodeSim(reader state.Reader, tip bcm.BlockchainInfo, fromAddress, address crypto.Address, code, data []byte,
-	logger *logging.Logger) (*exec.TxExecution, error) {
+	natives *evm.Natives, logger *logging.Logger, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
 
 	// Attach code to target account (overwriting target)
 	cache := state.NewCache(reader)
@@ -55,5 +72,55 @@ func CallCodeSim(reader state.Reader, tip bcm.BlockchainInfo, fromAddress, addre
 	if err != nil {
 		return nil, err
 	}
-	return CallSim(cache, tip, fromAddress, address, data, logger)
+	return CallSim(cache, tip, fromAddress, address, data, natives, logger, vmOptions...)
+}
+
+// Finds the smallest GasLimit with which tx succeeds when run on an isolated and unpersisted state. The search starts
+// from the gas used when tx is run with its own GasLimit (or MaxGasEstimate if it has none) and narrows by bisection.
+// Returns the gas limit found and the execution at that limit. If tx fails even with the maximum gas limit then the
+// gas limit returned is zero and the execution is the failed one.
+func EstimateGas(reader state.Reader, tip bcm.BlockchainInfo, tx *payload.CallTx, natives *evm.Natives,
+	logger *logging.Logger, vmOptions ...func(*evm.VM)) (uint64, *exec.TxExecution, error) {
+
+	maxGas := tx.GasLimit
+	if maxGas == 0 {
+		maxGas = MaxGasEstimate
+	}
+	// Each run gets its own copy of the tx so the caller's is left untouched
+	run := func(gasLimit uint64) (*exec.TxExecution, error) {
+		txCopy := *tx
+		txCopy.GasLimit = gasLimit
+		return CallTxSim(reader, tip, &txCopy, natives, logger, vmOptions...)
+	}
+	txe, err := run(maxGas)
+	if err != nil || txe.Exception != nil {
+		return 0, txe, err
+	}
+	gasUsed := txe.GetResult().GetGasUsed()
+	// No gas limit lower than the gas used can succeed and usually the gas used is enough
+	if gasUsed < maxGas {
+		usedTxe, err := run(gasUsed)
+		if err != nil {
+			return 0, nil, err
+		}
+		if usedTxe.Exception == nil {
+			return gasUsed, usedTxe, nil
+		}
+	}
+	// Otherwise bisect the gas limits between the gas used (which fails) and the maximum (which succeeds)
+	low, high := gasUsed+1, maxGas
+	for low < high {
+		gasLimit := low + (high-low)/2
+		midTxe, err := run(gasLimit)
+		if err != nil {
+			return 0, nil, err
+		}
+		if midTxe.Exception == nil {
+			high = gasLimit
+			txe = midTxe
+		} else {
+			low = gasLimit + 1
+		}
+	}
+	return high, txe, nil
 }
@@ -0,0 +1,125 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	. "github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/hyperledger/burrow/execution/evm/asm/bc"
+	"github.com/hyperledger/burrow/keys/mock"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGas(t *testing.T) {
+	st, privAccounts := makeGenesisState(3, false, 1000, 1, false, 1000)
+	counter := getAccount(st, privAccounts[1].Address())
+	counter.SetCode(bc.MustSplice(PUSH1, 0, SLOAD, PUSH1, 1, ADD, DUP1, PUSH1, 0, SSTORE,
+		PUSH1, 0, MSTORE, PUSH1, 32, PUSH1, 0, RETURN))
+	reverter := getAccount(st, privAccounts[2].Address())
+	reverter.SetCode(bc.MustSplice(PUSH1, 0, PUSH1, 0, REVERT))
+	_, err := st.Update(func(ws Updatable) error {
+		err := ws.UpdateAccount(counter)
+		if err != nil {
+			return err
+		}
+		return ws.UpdateAccount(reverter)
+	})
+	require.NoError(t, err)
+	tip := makeExecutor(st).blockchain
+
+	tx := &payload.CallTx{
+		Input:   &payload.TxInput{Address: privAccounts[0].Address(), Amount: 1},
+		Address: addressPtr(counter),
+	}
+	gasLimit, txe, err := EstimateGas(st, tip, tx, nil, logger)
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception)
+	assert.True(t, gasLimit > 0)
+	assert.Equal(t, uint64(0), tx.GasLimit, "should not modify the CallTx passed")
+
+	tx.GasLimit = MaxGasEstimate
+	maxTxe, err := CallTxSim(st, tip, tx, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, maxTxe.Result.GasUsed, gasLimit)
+	tx.GasLimit = gasLimit
+	txe, err = CallTxSim(st, tip, tx, nil, logger)
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception)
+	tx.GasLimit = 0
+
+	tx.Address = addressPtr(reverter)
+	gasLimit, txe, err = EstimateGas(st, tip, tx, nil, logger)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), gasLimit)
+	assert.NotNil(t, txe.Exception)
+}
+
+func TestCallTxSim_Natives(t *testing.T) {
+	native, err := evm.NewNative("", "Doubler", &evm.NativeFunction{
+		Name: "double",
+		Gas:  10,
+		F: func(ctx evm.NativeContext, x uint64) (uint64, error) {
+			return 2 * x, ctx.UseGas(x)
+		},
+	})
+	require.NoError(t, err)
+	natives, err := evm.NewNatives(native)
+	require.NoError(t, err)
+
+	st, privAccounts := makeGenesisState(2, false, 1000, 1, false, 1000)
+	// Calls the native with the call data, returning its output or reverting if the call fails
+	call := bc.MustSplice(CALLDATASIZE, PUSH1, 0, PUSH1, 0, CALLDATACOPY, PUSH1, 32, PUSH1, 0, CALLDATASIZE,
+		PUSH1, 0, PUSH1, 0, PUSH20, native.Address(), PUSH1, 2, GAS, DIV, CALL, ISZERO)
+	caller := getAccount(st, privAccounts[1].Address())
+	caller.SetCode(bc.MustSplice(call, PUSH1, len(call)+8, JUMPI, PUSH1, 32, PUSH1, 0, RETURN,
+		JUMPDEST, PUSH1, 0, DUP1, REVERT))
+	_, err = st.Update(func(ws Updatable) error {
+		return ws.UpdateAccount(caller)
+	})
+	require.NoError(t, err)
+	tip := makeExecutor(st).blockchain
+
+	function := native.Functions()[0]
+	input, err := abi.Pack(function.Abi.Inputs, uint64(21))
+	require.NoError(t, err)
+	tx := &payload.CallTx{
+		Input:    &payload.TxInput{Address: privAccounts[0].Address(), Amount: 1},
+		Address:  addressPtr(caller),
+		Data:     append(function.Abi.FunctionID[:], input...),
+		GasLimit: MaxGasEstimate,
+	}
+	expected, err := abi.Pack(function.Abi.Outputs, uint64(42))
+	require.NoError(t, err)
+
+	// Without the natives the call reaches an empty account
+	txe, err := CallTxSim(st, tip, tx, nil, logger)
+	require.NoError(t, err)
+	assert.NotEqual(t, expected, txe.GetResult().GetReturn())
+
+	txe, err = CallTxSim(st, tip, tx, natives, logger)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, expected, txe.Result.Return)
+
+	trans := NewTransactor(tip, event.NewEmitter(logger), NewAccounts(st, mock.NewKeyClient(privAccounts...), 100),
+		nil, nil, logger, SimulateWith(Natives(natives)))
+	txe, err = trans.CallSim(privAccounts[0].Address(), caller.Address(), tx.Data)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, expected, txe.Result.Return)
+
+	// The estimate must cover the gas used by the native
+	tx.GasLimit = 0
+	gasLimit, txe, err := trans.EstimateGas(tx)
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+	assert.Equal(t, expected, txe.Result.Return)
+	tx.GasLimit = gasLimit - 1
+	txe, err = trans.CallTxSimAt(st, tip, tx)
+	require.NoError(t, err)
+	assert.NotNil(t, txe.Exception)
+}
@@ -0,0 +1,272 @@
+package execution
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/hyperledger/burrow/acm/validator"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging/structure"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// Penalises the validators that Tendermint reports in block as having signed conflicting votes or as having failed to
+// sign too many recent blocks according to the genesis Slashing parameters, and restores the power of the jailed
+// validators that are due for release. A penalised validator is jailed: its power (less any slashed for double-signing)
+// is withheld until it is released and it cannot bond in the meantime. Must be called before the transactions of block
+// are executed. Jailings and missed blocks are stored in state on Commit.
+func (exe *executor) BeginBlock(block *abciTypes.RequestBeginBlock) error {
+	height := uint64(block.Header.Height)
+	if height != exe.blockExecution.Height {
+		return fmt.Errorf("trying to begin block at height %v but the current block execution has height %v",
+			height, exe.blockExecution.Height)
+	}
+	slashing := exe.blockchain.GenesisDoc().Slashing
+	if slashing == nil {
+		return nil
+	}
+	validators := exe.blockchain.ValidatorWriter()
+	releases, err := exe.releaseJailings(validators, height)
+	if err != nil {
+		return err
+	}
+	exe.blockExecution.ReleaseEvents = append(exe.blockExecution.ReleaseEvents, releases...)
+
+	for _, evidence := range block.ByzantineValidators {
+		if evidence.Type != tmTypes.ABCIEvidenceTypeDuplicateVote {
+			continue
+		}
+		address, err := crypto.AddressFromBytes(evidence.Validator.Address)
+		if err != nil {
+			return err
+		}
+		err = exe.penalise(validators, address, exec.SlashReasonDoubleSign, slashing.DoubleSignPercentage, slashing,
+			height)
+		if err != nil {
+			return err
+		}
+	}
+
+	if slashing.DowntimeWindow == 0 || height <= 1 {
+		return nil
+	}
+	// The votes are those for the previous block
+	for _, vote := range block.LastCommitInfo.Votes {
+		if vote.SignedLastBlock {
+			continue
+		}
+		address, err := crypto.AddressFromBytes(vote.Validator.Address)
+		if err != nil {
+			return err
+		}
+		// A jailed validator is not expected to sign (and one jailed in this block has been penalised already)
+		jailing, err := exe.Jailed(address)
+		if err != nil {
+			return err
+		}
+		if jailing != nil {
+			continue
+		}
+		missed, err := exe.recordMissedBlock(address, height-1, slashing.DowntimeWindow)
+		if err != nil {
+			return err
+		}
+		if uint64(missed) > slashing.MaxMissedBlocks {
+			err = exe.penalise(validators, address, exec.SlashReasonDowntime, 0, slashing, height)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the jailing of the validator at address including those made by the current block, or nil if it is not
+// jailed
+func (exe *executor) Jailed(address crypto.Address) (*exec.Jailing, error) {
+	if jailing, ok := exe.jailings[address]; ok {
+		return jailing, nil
+	}
+	return exe.state.GetJailing(address)
+}
+
+// Destroys percentage of the power of the validator at address and jails it with the rest. The power of a validator
+// that is already jailed is withheld for a further jail period. A validator is penalised at most once in a block, so
+// beginning a block again (as when Tendermint replays the last block) does not penalise it twice. The validator set
+// limits how much power may change in one block so if the penalty cannot be applied it is abandoned (a validator that
+// continues to miss blocks will be penalised in a later block).
+func (exe *executor) penalise(validators validator.ReaderWriter, address crypto.Address, reason string,
+	percentage uint64, slashing *genesis.Slashing, height uint64) error {
+
+	logger := exe.logger.With("validator", address, "reason", reason, "height", height)
+	jailing, err := exe.Jailed(address)
+	if err != nil {
+		return err
+	}
+	if jailing != nil && jailing.Height == height {
+		logger.InfoMsg("Validator has already been penalised in this block")
+		return nil
+	}
+	publicKey := exe.validatorPublicKey(address, jailing)
+	if publicKey == nil {
+		logger.InfoMsg("Could not penalise validator because it is not in the validator set")
+		return nil
+	}
+	power := validators.Power(address)
+	total := new(big.Int).Set(power)
+	if jailing != nil {
+		total.Add(total, new(big.Int).SetUint64(jailing.Power))
+	}
+	slashed := new(big.Int).Div(new(big.Int).Mul(total, new(big.Int).SetUint64(percentage)), big.NewInt(100))
+	if power.Sign() != 0 {
+		_, err := validators.AlterPower(*publicKey, new(big.Int))
+		if err != nil {
+			logger.InfoMsg("Could not penalise validator", structure.ErrorKey, err)
+			return nil
+		}
+	}
+	var releaseHeight uint64
+	if slashing.JailPeriod > 0 {
+		releaseHeight = height + slashing.JailPeriod
+	}
+	jailed := new(big.Int).Sub(total, slashed).Uint64()
+	// The balance the validator has bonded is slashed in the same proportion as its power
+	bonded, err := exe.Bonded(address)
+	if err != nil {
+		return err
+	}
+	if bonded > 0 && percentage > 0 {
+		bondedSlashed := new(big.Int).Mul(new(big.Int).SetUint64(bonded), new(big.Int).SetUint64(percentage))
+		bondedSlashed.Div(bondedSlashed, big.NewInt(100))
+		err = exe.SetBonded(address, bonded-bondedSlashed.Uint64())
+		if err != nil {
+			return err
+		}
+	}
+	// Jailing forgets the blocks the validator has missed
+	exe.jailings[address] = &exec.Jailing{
+		PublicKey:     *publicKey,
+		Power:         jailed,
+		Height:        height,
+		ReleaseHeight: releaseHeight,
+	}
+	exe.missedBlocks[address] = &exec.MissedBlocks{Address: address}
+	logger.InfoMsg("Jailed validator",
+		"slashed", slashed,
+		"jailed", jailed,
+		"release_height", releaseHeight)
+	exe.blockExecution.SlashEvents = append(exe.blockExecution.SlashEvents, &exec.SlashEvent{
+		Validator:     address,
+		Reason:        reason,
+		Slashed:       slashed.Uint64(),
+		Jailed:        jailed,
+		ReleaseHeight: releaseHeight,
+	})
+	return nil
+}
+
+// Restores the withheld power of the jailed validators due for release at height. Any that cannot be released because
+// of the limit on the change in power in one block remain jailed until a later block.
+func (exe *executor) releaseJailings(validators validator.ReaderWriter, height uint64) ([]*exec.ReleaseEvent, error) {
+	jailings, err := exe.currentJailings()
+	if err != nil {
+		return nil, err
+	}
+	var releases []*exec.ReleaseEvent
+	for _, jailing := range jailings {
+		if jailing.ReleaseHeight == 0 || jailing.ReleaseHeight > height {
+			continue
+		}
+		address := jailing.PublicKey.Address()
+		power := new(big.Int).Add(validators.Power(address), new(big.Int).SetUint64(jailing.Power))
+		_, err := validators.AlterPower(jailing.PublicKey, power)
+		if err != nil {
+			exe.logger.InfoMsg("Could not release validator from jail, will try again next block",
+				"validator", address,
+				"height", height,
+				structure.ErrorKey, err)
+			continue
+		}
+		exe.jailings[address] = nil
+		exe.logger.InfoMsg("Released validator from jail",
+			"validator", address,
+			"power", jailing.Power,
+			"height", height)
+		releases = append(releases, &exec.ReleaseEvent{
+			Validator: address,
+			Power:     jailing.Power,
+		})
+	}
+	return releases, nil
+}
+
+// Records that the validator at address failed to sign the block at height and returns the number of blocks it has
+// failed to sign out of the window of blocks ending at height. Recording the same block again has no effect.
+func (exe *executor) recordMissedBlock(address crypto.Address, height, window uint64) (int, error) {
+	missedBlocks, ok := exe.missedBlocks[address]
+	if !ok {
+		var err error
+		missedBlocks, err = exe.state.GetMissedBlocks(address)
+		if err != nil {
+			return 0, err
+		}
+	}
+	heights := missedBlocks.Heights
+	if len(heights) == 0 || heights[len(heights)-1] < height {
+		heights = append(heights[:len(heights):len(heights)], height)
+	}
+	// Drop the heights that have fallen out of the window
+	for len(heights) > 0 && heights[0]+window <= height {
+		heights = heights[1:]
+	}
+	exe.missedBlocks[address] = &exec.MissedBlocks{
+		Address: address,
+		Heights: heights,
+	}
+	return len(heights), nil
+}
+
+// The jailed validators in address order including those jailed and released by the current block
+func (exe *executor) currentJailings() ([]*exec.Jailing, error) {
+	var jailings []*exec.Jailing
+	_, err := exe.state.IterateJailings(func(jailing *exec.Jailing) (stop bool) {
+		if _, ok := exe.jailings[jailing.PublicKey.Address()]; !ok {
+			jailings = append(jailings, jailing)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, jailing := range exe.jailings {
+		if jailing != nil {
+			jailings = append(jailings, jailing)
+		}
+	}
+	sort.Slice(jailings, func(i, j int) bool {
+		return bytes.Compare(jailings[i].PublicKey.Address().Bytes(), jailings[j].PublicKey.Address().Bytes()) < 0
+	})
+	return jailings, nil
+}
+
+// Returns the public key of the validator at address, which must either be jailed or in the current validator set
+func (exe *executor) validatorPublicKey(address crypto.Address, jailing *exec.Jailing) *crypto.PublicKey {
+	if jailing != nil {
+		return &jailing.PublicKey
+	}
+	var publicKey *crypto.PublicKey
+	exe.blockchain.CurrentValidators().Iterate(func(id crypto.Addressable, power *big.Int) (stop bool) {
+		if id.Address() == address {
+			pk := id.PublicKey()
+			publicKey = &pk
+			return true
+		}
+		return false
+	})
+	return publicKey
+}
@@ -15,6 +15,7 @@
 package execution
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 
@@ -27,9 +28,11 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/proof"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs/payload"
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
@@ -42,33 +45,71 @@ const (
 	// Prefix under which all non-versioned values reside - either immutable values of references to immutable values
 	// that track the current state rather than being part of the history.
 	refsPrefix = "r"
+
+	// The version of the layout of the references, which is stored so that a migration of the references runs once
+	refsVersion = 1
 )
 
 var (
-	// Directly referenced values
-	accountKeyFormat = storage.NewMustKeyFormat("a", crypto.AddressLength)
-	storageKeyFormat = storage.NewMustKeyFormat("s", crypto.AddressLength, binary.Word256Length)
-	nameKeyFormat    = storage.NewMustKeyFormat("n", storage.VariadicSegmentLength)
+	// Directly referenced values (whose layout is shared with the proof package)
+	accountKeyFormat = proof.AccountKeyFormat
+	storageKeyFormat = proof.StorageKeyFormat
+	nameKeyFormat    = proof.NameKeyFormat
+	// Funds awaiting release after unbonding by release height, validator, and recipient
+	unbondingKeyFormat = storage.NewMustKeyFormat("u", uint64Length, crypto.AddressLength, crypto.AddressLength)
+	// Metadata of contracts recorded when they are created
+	contractMetaKeyFormat = storage.NewMustKeyFormat("cm", crypto.AddressLength)
+	// The minimum fee that must be paid by transactions by payload type
+	minimumFeeKeyFormat = storage.NewMustKeyFormat("f", uint64Length)
+	// Validator penalties
+	jailingKeyFormat      = storage.NewMustKeyFormat("j", crypto.AddressLength)
+	missedBlocksKeyFormat = storage.NewMustKeyFormat("mb", crypto.AddressLength)
+	// The balance each validator has locked into power with BondTx
+	bondedKeyFormat = storage.NewMustKeyFormat("bd", crypto.AddressLength)
 	// Keys that reference references
 	blockRefKeyFormat = storage.NewMustKeyFormat("b", uint64Length)
 	txRefKeyFormat    = storage.NewMustKeyFormat("t", uint64Length, uint64Length)
+	// The CommitID of the state committed at a height
+	heightRefKeyFormat = storage.NewMustKeyFormat("v", uint64Length)
 	// Reference keys
 	// TODO: implement content-addressing of code and optionally blocks (to allow reference to block to be stored in state tree)
 	//codeKeyFormat   = storage.NewMustKeyFormat("c", sha256.Size)
 	//blockKeyFormat  = storage.NewMustKeyFormat("b", sha256.Size)
-	txKeyFormat     = storage.NewMustKeyFormat("b", tmhash.Size)
+	txKeyFormat     = storage.NewMustKeyFormat("h", tmhash.Size)
 	commitKeyFormat = storage.NewMustKeyFormat("x", tmhash.Size)
+	// Transaction references used to share the prefix of blockRefKeyFormat, which meant a range of block references
+	// could include them, they are moved to txKeyFormat when state is loaded
+	legacyTxKeyFormat = storage.NewMustKeyFormat("b", tmhash.Size)
+	// The refsVersion the references have been migrated to, absent for state written before it was introduced
+	refsVersionKey = []byte("rv")
 )
 
 // Implements account and blockchain state
 var _ state.IterableReader = &State{}
 var _ names.IterableReader = &State{}
+var _ state.IterableReader = &ReadState{}
+var _ names.IterableReader = &ReadState{}
 var _ Updatable = &writeState{}
 
 type Updatable interface {
 	state.Writer
+	state.ContractMetaSetter
 	names.Writer
 	AddBlock(blockExecution *exec.BlockExecution) error
+	// Adds the events of a block to the event index
+	IndexBlock(blockExecution *exec.BlockExecution) error
+	// Schedules the release of unbonded funds, adding to any already scheduled for the same release
+	AddUnbonding(unbonding *exec.UnbondEvent) error
+	RemoveUnbonding(unbonding *exec.UnbondEvent) error
+	// Sets the minimum fee for a payload type, removing it when zero
+	SetMinimumFee(minimumFee *payload.MinimumFee) error
+	// Jails a validator replacing any existing jailing
+	SetJailing(jailing *exec.Jailing) error
+	RemoveJailing(address crypto.Address) error
+	// Sets the blocks a validator has recently missed, removing them when there are none
+	SetMissedBlocks(missedBlocks *exec.MissedBlocks) error
+	// Sets the balance a validator has bonded, removing it when zero
+	SetBonded(address crypto.Address, amount uint64) error
 }
 
 // Wraps state to give access to writer methods
@@ -83,10 +124,32 @@ type CommitID struct {
 	Version int64
 }
 
+// Reads account and name state from a version of the state tree
+type ReadState struct {
+	tree storage.ProvableReader
+	// Set when reading a historical version of the state tree, which may be pruned
+	version  *versionRef
+	versions *versionRefs
+}
+
+// A historical version of the state tree with the number of reads from it in progress
+type versionRef struct {
+	version int64
+	readers int
+	pruned  bool
+}
+
+// The historical versions of the state tree that have been read, which Prune does not delete while they are being read
+type versionRefs struct {
+	sync.Mutex
+	refs map[int64]*versionRef
+}
+
 // Writers to state are responsible for calling State.Lock() before calling
 type State struct {
 	// Values not reassigned
 	sync.RWMutex
+	ReadState
 	writeState *writeState
 	height     uint64
 	db         dbm.DB
@@ -94,6 +157,7 @@ type State struct {
 	tree       *storage.RWTree
 	refs       storage.KVStore
 	codec      *amino.Codec
+	versions   *versionRefs
 }
 
 // Create a new State object
@@ -103,11 +167,13 @@ func NewState(db dbm.DB) *State {
 	tree := storage.NewRWTree(storage.NewPrefixDB(cacheDB, treePrefix), defaultCacheCapacity)
 	refs := storage.NewPrefixDB(cacheDB, refsPrefix)
 	s := &State{
-		db:      db,
-		cacheDB: cacheDB,
-		tree:    tree,
-		refs:    refs,
-		codec:   amino.NewCodec(),
+		ReadState: ReadState{tree: tree},
+		db:        db,
+		cacheDB:   cacheDB,
+		tree:      tree,
+		refs:      refs,
+		codec:     amino.NewCodec(),
+		versions:  &versionRefs{refs: make(map[int64]*versionRef)},
 	}
 	s.writeState = &writeState{state: s}
 	return s
@@ -153,6 +219,29 @@ func MakeGenesisState(db dbm.DB, genesisDoc *genesis.GenesisDoc) (*State, error)
 		return nil, err
 	}
 
+	if genesisDoc.ProposerFeePercentage > 100 {
+		return nil, fmt.Errorf("the genesis file has a ProposerFeePercentage of %d, which is more than 100",
+			genesisDoc.ProposerFeePercentage)
+	}
+	if genesisDoc.Slashing != nil && genesisDoc.Slashing.DoubleSignPercentage > 100 {
+		return nil, fmt.Errorf("the genesis file has a Slashing.DoubleSignPercentage of %d, which is more than 100",
+			genesisDoc.Slashing.DoubleSignPercentage)
+	}
+	for _, minimumFee := range genesisDoc.MinimumFees {
+		txType := payload.TxTypeFromString(minimumFee.TxType)
+		if !txType.PaysFee() {
+			return nil, fmt.Errorf("the genesis file sets a minimum fee for '%s' but only transactions of type %v, "+
+				"%v, or %v pay fees", minimumFee.TxType, payload.TypeCall, payload.TypeName, payload.TypeBatch)
+		}
+		err = s.writeState.SetMinimumFee(&payload.MinimumFee{TxType: txType, Fee: minimumFee.Fee})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// New state has nothing to migrate
+	s.setRefsVersion()
+
 	// We need to save at least once so that readTree points at a non-working-state tree
 	_, err = s.writeState.commit()
 	if err != nil {
@@ -178,9 +267,126 @@ func LoadState(db dbm.DB, hash []byte) (*State, error) {
 	if err != nil {
 		return nil, fmt.Errorf("could not load current version of state tree: CommitID: %v", commitID)
 	}
+	s.height = commitID.Height
+	s.migrateRefs()
 	return s, nil
 }
 
+// Brings references written by earlier versions up to refsVersion and saves them to DB. Each migration runs once since
+// the version reached is stored.
+func (s *State) migrateRefs() {
+	var version uint64
+	if bs := s.refs.Get(refsVersionKey); len(bs) == uint64Length {
+		version = binary.GetUint64BE(bs)
+	}
+	if version >= refsVersion {
+		return
+	}
+	s.migrateTxKeys()
+	s.setRefsVersion()
+	batch := s.db.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+}
+
+func (s *State) setRefsVersion() {
+	bs := make([]byte, uint64Length)
+	binary.PutUint64BE(bs, refsVersion)
+	s.refs.Set(refsVersionKey, bs)
+}
+
+// Moves any transaction references stored under legacyTxKeyFormat to txKeyFormat. This iterates over every block
+// reference so is only run once by migrateRefs.
+func (s *State) migrateTxKeys() {
+	prefix := legacyTxKeyFormat.Prefix()
+	var keys [][]byte
+	it := s.refs.Iterator(prefix, prefix.Above())
+	for ; it.Valid(); it.Next() {
+		// Block references share the prefix but have shorter keys
+		if len(it.Key()) == len(prefix)+tmhash.Size {
+			keys = append(keys, it.Key())
+		}
+	}
+	it.Close()
+	for _, key := range keys {
+		s.refs.Set(txKeyFormat.KeyBytes(prefix.Suffix(key)), s.refs.Get(key))
+		s.refs.Delete(key)
+	}
+}
+
+// Returns a reader of the state as it was once the block at height was committed. Only heights that have not been
+// pruned are available.
+func (s *State) AtHeight(height uint64) (*ReadState, error) {
+	s.versions.Lock()
+	defer s.versions.Unlock()
+	bs := s.refs.Get(heightRefKeyFormat.Key(height))
+	if len(bs) == 0 {
+		return nil, fmt.Errorf("no state is retained for height %v", height)
+	}
+	commitID := new(CommitID)
+	err := s.codec.UnmarshalBinary(bs, commitID)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode CommitID for height %v: %v", height, err)
+	}
+	tree, err := s.tree.GetImmutable(commitID.Version)
+	if err != nil {
+		return nil, fmt.Errorf("could not load state at height %v (version %v): %v", height, commitID.Version, err)
+	}
+	ref, ok := s.versions.refs[commitID.Version]
+	if !ok {
+		ref = &versionRef{version: commitID.Version}
+		s.versions.refs[commitID.Version] = ref
+	}
+	return &ReadState{
+		tree:     tree,
+		version:  ref,
+		versions: s.versions,
+	}, nil
+}
+
+// Deletes the versions of the state committed at heights below height so they can no longer be read. A version that is
+// being read is kept until a later call.
+func (s *State) Prune(height uint64) error {
+	s.Lock()
+	defer s.Unlock()
+	s.versions.Lock()
+	defer s.versions.Unlock()
+
+	var heights []uint64
+	var versions []int64
+	it := heightRefKeyFormat.Iterator(s.refs, nil, heightRefKeyFormat.Suffix(height))
+	for it.Valid() {
+		commitID := new(CommitID)
+		err := s.codec.UnmarshalBinary(it.Value(), commitID)
+		if err != nil {
+			return fmt.Errorf("could not decode CommitID when pruning state: %v", err)
+		}
+		heights = append(heights, commitID.Height)
+		versions = append(versions, commitID.Version)
+		it.Next()
+	}
+	it.Close()
+	for i, version := range versions {
+		ref := s.versions.refs[version]
+		if ref != nil && ref.readers > 0 {
+			continue
+		}
+		err := s.tree.DeleteVersion(version)
+		if err != nil {
+			return fmt.Errorf("could not prune state at height %v (version %v): %v", heights[i], version, err)
+		}
+		s.refs.Delete(heightRefKeyFormat.Key(heights[i]))
+		if ref != nil {
+			ref.pruned = true
+			delete(s.versions.refs, version)
+		}
+	}
+	batch := s.db.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+	return nil
+}
+
 // Perform updates to state whilst holding the write lock, allows a commit to hold the write lock across multiple
 // operations while preventing interlaced reads and writes
 func (s *State) Update(updater func(up Updatable) error) ([]byte, error) {
@@ -214,6 +420,7 @@ func (ws *writeState) commit() ([]byte, error) {
 		return nil, fmt.Errorf("could not encode CommitID %v: %v", commitID, err)
 	}
 	ws.state.refs.Set(commitKeyFormat.Key(hash), bs)
+	ws.state.refs.Set(heightRefKeyFormat.Key(ws.state.height), bs)
 	// Commit the state in cacheDB atomically for this block (synchronous)
 	batch := ws.state.db.NewBatch()
 	ws.state.cacheDB.Commit(batch)
@@ -221,8 +428,32 @@ func (ws *writeState) commit() ([]byte, error) {
 	return hash, err
 }
 
+// Registers a read from a historical version of the state so that it is not pruned until the read is done, returns the
+// function to call when it is done or an error if the version has already been pruned
+func (s *ReadState) startRead() (done func(), err error) {
+	if s.version == nil {
+		return func() {}, nil
+	}
+	s.versions.Lock()
+	defer s.versions.Unlock()
+	if s.version.pruned {
+		return nil, fmt.Errorf("version %v of the state has been pruned", s.version.version)
+	}
+	s.version.readers++
+	return func() {
+		s.versions.Lock()
+		defer s.versions.Unlock()
+		s.version.readers--
+	}, nil
+}
+
 // Returns nil if account does not exist with given address.
-func (s *State) GetAccount(address crypto.Address) (acm.Account, error) {
+func (s *ReadState) GetAccount(address crypto.Address) (acm.Account, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	accBytes := s.tree.Get(accountKeyFormat.Key(address))
 	if accBytes == nil {
 		return nil, nil
@@ -247,7 +478,12 @@ func (ws *writeState) RemoveAccount(address crypto.Address) error {
 	return nil
 }
 
-func (s *State) IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
+func (s *ReadState) IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped bool, err error) {
+	done, err := s.startRead()
+	if err != nil {
+		return false, err
+	}
+	defer done()
 	it := accountKeyFormat.Iterator(s.tree, nil, nil)
 	for it.Valid() {
 		account, err := acm.Decode(it.Value())
@@ -262,7 +498,12 @@ func (s *State) IterateAccounts(consumer func(acm.Account) (stop bool)) (stopped
 	return false, nil
 }
 
-func (s *State) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+func (s *ReadState) GetStorage(address crypto.Address, key binary.Word256) (binary.Word256, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return binary.Zero256, err
+	}
+	defer done()
 	return binary.LeftPadWord256(s.tree.Get(storageKeyFormat.Key(address, key))), nil
 }
 
@@ -275,7 +516,44 @@ func (ws *writeState) SetStorage(address crypto.Address, key, value binary.Word2
 	return nil
 }
 
-func (s *State) IterateStorage(address crypto.Address, consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
+// Returns nil if no metadata was stored for the contract at address
+func (s *ReadState) GetContractMeta(address crypto.Address) (*acm.ContractMeta, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	bs := s.tree.Get(contractMetaKeyFormat.Key(address))
+	if bs == nil {
+		return nil, nil
+	}
+	meta := new(acm.ContractMeta)
+	err = meta.Unmarshal(bs)
+	if err != nil {
+		return nil, fmt.Errorf("GetContractMeta could not decode metadata for %v: %v", address, err)
+	}
+	return meta, nil
+}
+
+func (ws *writeState) SetContractMeta(address crypto.Address, meta *acm.ContractMeta) error {
+	if meta == nil {
+		ws.state.tree.Delete(contractMetaKeyFormat.Key(address))
+		return nil
+	}
+	bs, err := meta.Marshal()
+	if err != nil {
+		return fmt.Errorf("SetContractMeta could not encode metadata for %v: %v", address, err)
+	}
+	ws.state.tree.Set(contractMetaKeyFormat.Key(address), bs)
+	return nil
+}
+
+func (s *ReadState) IterateStorage(address crypto.Address, consumer func(key, value binary.Word256) (stop bool)) (stopped bool, err error) {
+	done, err := s.startRead()
+	if err != nil {
+		return false, err
+	}
+	defer done()
 	it := storageKeyFormat.Fix(address).Iterator(s.tree, nil, nil)
 	for it.Valid() {
 		key := it.Key()
@@ -299,10 +577,79 @@ func (s *State) IterateStorage(address crypto.Address, consumer func(key, value
 
 // State.storage
 //-------------------------------------
+// Proofs
+
+// Returns the account at address (or nil) with a proof against this state's hash. Since a ReadState does not know its
+// own height it must be provided for the proof.
+func (rs *ReadState) GetAccountWithProof(address crypto.Address, height uint64) (acm.Account, *proof.Proof, error) {
+	bs, prf, err := rs.getWithProof(accountKeyFormat.Key(address), height)
+	if err != nil || bs == nil {
+		return nil, prf, err
+	}
+	account, err := acm.Decode(bs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return account, prf, nil
+}
+
+func (rs *ReadState) GetStorageWithProof(address crypto.Address, key binary.Word256,
+	height uint64) (binary.Word256, *proof.Proof, error) {
+	bs, prf, err := rs.getWithProof(storageKeyFormat.Key(address, key), height)
+	if err != nil {
+		return binary.Zero256, nil, err
+	}
+	return binary.LeftPadWord256(bs), prf, nil
+}
+
+func (rs *ReadState) GetNameWithProof(name string, height uint64) (*names.Entry, *proof.Proof, error) {
+	bs, prf, err := rs.getWithProof(nameKeyFormat.Key(name), height)
+	if err != nil || bs == nil {
+		return nil, prf, err
+	}
+	entry, err := names.DecodeEntry(bs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entry, prf, nil
+}
+
+func (rs *ReadState) getWithProof(key []byte, height uint64) ([]byte, *proof.Proof, error) {
+	done, err := rs.startRead()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer done()
+	value, rangeProof, err := rs.tree.GetWithProof(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get proof of key %X: %v", key, err)
+	}
+	prf, err := proof.New(height, rs.tree.Hash(), key, rangeProof)
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, prf, nil
+}
+
+// Proofs
+//-------------------------------------
 // Events
 
 // Execution events
 func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
+	bs, err := be.Encode()
+	if err != nil {
+		return err
+	}
+	if ws.state.height > 0 && be.Height == ws.state.height {
+		// Tendermint replays the last block committed before a restart during its handshake, which is harmless
+		// provided it is the block we already have
+		if !bytes.Equal(ws.state.refs.Get(blockRefKeyFormat.Key(be.Height)), bs) {
+			return fmt.Errorf("AddBlock received block for height %v that differs from the block already "+
+				"stored at that height", be.Height)
+		}
+		return nil
+	}
 	if ws.state.height > 0 && be.Height != ws.state.height+1 {
 		return fmt.Errorf("AddBlock received block for height %v but last block height was %v",
 			be.Height, ws.state.height)
@@ -312,10 +659,6 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	for i, txe := range be.TxExecutions {
 		ws.addTx(txe.TxHash, be.Height, uint64(i))
 	}
-	bs, err := be.Encode()
-	if err != nil {
-		return err
-	}
 	ws.state.refs.Set(blockRefKeyFormat.Key(be.Height), bs)
 	return nil
 }
@@ -325,7 +668,10 @@ func (ws *writeState) addTx(txHash []byte, height, index uint64) {
 }
 
 func (s *State) GetTx(txHash []byte) (*exec.TxExecution, error) {
-	bs := s.tree.Get(txKeyFormat.Key(txHash))
+	if len(txHash) != tmhash.Size {
+		return nil, fmt.Errorf("transaction hash %X should be %v bytes long", txHash, tmhash.Size)
+	}
+	bs := s.refs.Get(txKeyFormat.Key(txHash))
 	if len(bs) == 0 {
 		return nil, nil
 	}
@@ -343,7 +689,7 @@ func (s *State) GetTx(txHash []byte) (*exec.TxExecution, error) {
 }
 
 func (s *State) GetBlock(height uint64) (*exec.BlockExecution, error) {
-	bs := s.tree.Get(blockRefKeyFormat.Key(height))
+	bs := s.refs.Get(blockRefKeyFormat.Key(height))
 	if len(bs) == 0 {
 		return nil, nil
 	}
@@ -378,7 +724,12 @@ func (s *State) Hash() []byte {
 
 var _ names.IterableReader = &State{}
 
-func (s *State) GetName(name string) (*names.Entry, error) {
+func (s *ReadState) GetName(name string) (*names.Entry, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
 	entryBytes := s.tree.Get(nameKeyFormat.Key(name))
 	if entryBytes == nil {
 		return nil, nil
@@ -401,7 +752,12 @@ func (ws *writeState) RemoveName(name string) error {
 	return nil
 }
 
-func (s *State) IterateNames(consumer func(*names.Entry) (stop bool)) (stopped bool, err error) {
+func (s *ReadState) IterateNames(consumer func(*names.Entry) (stop bool)) (stopped bool, err error) {
+	done, err := s.startRead()
+	if err != nil {
+		return false, err
+	}
+	defer done()
 	it := nameKeyFormat.Iterator(s.tree, nil, nil)
 	for it.Valid() {
 		entry, err := names.DecodeEntry(it.Value())
@@ -416,6 +772,221 @@ func (s *State) IterateNames(consumer func(*names.Entry) (stop bool)) (stopped b
 	return false, nil
 }
 
+//-------------------------------------
+// State.unbonding
+
+// Iterates over the unbondings due for release at or before releaseHeight in order of release height
+func (s *ReadState) IterateUnbondings(releaseHeight uint64,
+	consumer func(*exec.UnbondEvent) (stop bool)) (stopped bool, err error) {
+	done, err := s.startRead()
+	if err != nil {
+		return false, err
+	}
+	defer done()
+	it := unbondingKeyFormat.Iterator(s.tree, nil, unbondingKeyFormat.Suffix(releaseHeight+1))
+	for it.Valid() {
+		unbonding := new(exec.UnbondEvent)
+		err := unbonding.Unmarshal(it.Value())
+		if err != nil {
+			return true, fmt.Errorf("State.IterateUnbondings() could not iterate over unbondings: %v", err)
+		}
+		if consumer(unbonding) {
+			return true, nil
+		}
+		it.Next()
+	}
+	return false, nil
+}
+
+func (ws *writeState) AddUnbonding(unbonding *exec.UnbondEvent) error {
+	key := unbondingKey(unbonding)
+	total := *unbonding
+	if bs := ws.state.tree.Get(key); bs != nil {
+		existing := new(exec.UnbondEvent)
+		err := existing.Unmarshal(bs)
+		if err != nil {
+			return fmt.Errorf("could not decode existing unbonding: %v", err)
+		}
+		total.Amount += existing.Amount
+	}
+	bs, err := total.Marshal()
+	if err != nil {
+		return err
+	}
+	ws.state.tree.Set(key, bs)
+	return nil
+}
+
+func (ws *writeState) RemoveUnbonding(unbonding *exec.UnbondEvent) error {
+	ws.state.tree.Delete(unbondingKey(unbonding))
+	return nil
+}
+
+func unbondingKey(unbonding *exec.UnbondEvent) []byte {
+	return unbondingKeyFormat.Key(unbonding.ReleaseHeight, unbonding.Validator, unbonding.To)
+}
+
+//-------------------------------------
+// State.fees
+
+// Returns the minimum fee that must be paid by transactions of txType, which is zero if none has been set
+func (s *ReadState) GetMinimumFee(txType payload.Type) (uint64, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+	bs := s.tree.Get(minimumFeeKeyFormat.Key(uint64(txType)))
+	if bs == nil {
+		return 0, nil
+	}
+	minimumFee := new(payload.MinimumFee)
+	err = minimumFee.Unmarshal(bs)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode minimum fee for %v: %v", txType, err)
+	}
+	return minimumFee.Fee, nil
+}
+
+func (ws *writeState) SetMinimumFee(minimumFee *payload.MinimumFee) error {
+	key := minimumFeeKeyFormat.Key(uint64(minimumFee.TxType))
+	if minimumFee.Fee == 0 {
+		ws.state.tree.Delete(key)
+		return nil
+	}
+	bs, err := minimumFee.Marshal()
+	if err != nil {
+		return err
+	}
+	ws.state.tree.Set(key, bs)
+	return nil
+}
+
+//-------------------------------------
+// State.jailing
+
+// Returns the jailing of the validator at address or nil if it is not jailed
+func (s *ReadState) GetJailing(address crypto.Address) (*exec.Jailing, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	bs := s.tree.Get(jailingKeyFormat.Key(address))
+	if bs == nil {
+		return nil, nil
+	}
+	jailing := new(exec.Jailing)
+	err = jailing.Unmarshal(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode jailing of %v: %v", address, err)
+	}
+	return jailing, nil
+}
+
+// Iterates over the jailed validators in address order
+func (s *ReadState) IterateJailings(consumer func(*exec.Jailing) (stop bool)) (stopped bool, err error) {
+	done, err := s.startRead()
+	if err != nil {
+		return false, err
+	}
+	defer done()
+	it := jailingKeyFormat.Iterator(s.tree, nil, nil)
+	for it.Valid() {
+		jailing := new(exec.Jailing)
+		err := jailing.Unmarshal(it.Value())
+		if err != nil {
+			return true, fmt.Errorf("State.IterateJailings() could not iterate over jailings: %v", err)
+		}
+		if consumer(jailing) {
+			return true, nil
+		}
+		it.Next()
+	}
+	return false, nil
+}
+
+func (ws *writeState) SetJailing(jailing *exec.Jailing) error {
+	bs, err := jailing.Marshal()
+	if err != nil {
+		return err
+	}
+	ws.state.tree.Set(jailingKeyFormat.Key(jailing.PublicKey.Address()), bs)
+	return nil
+}
+
+func (ws *writeState) RemoveJailing(address crypto.Address) error {
+	ws.state.tree.Delete(jailingKeyFormat.Key(address))
+	return nil
+}
+
+// Returns the heights of the recent blocks the validator at address failed to sign in ascending order
+func (s *ReadState) GetMissedBlocks(address crypto.Address) (*exec.MissedBlocks, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+	missedBlocks := &exec.MissedBlocks{Address: address}
+	bs := s.tree.Get(missedBlocksKeyFormat.Key(address))
+	if bs == nil {
+		return missedBlocks, nil
+	}
+	err = missedBlocks.Unmarshal(bs)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode missed blocks of %v: %v", address, err)
+	}
+	return missedBlocks, nil
+}
+
+func (ws *writeState) SetMissedBlocks(missedBlocks *exec.MissedBlocks) error {
+	key := missedBlocksKeyFormat.Key(missedBlocks.Address)
+	if len(missedBlocks.Heights) == 0 {
+		ws.state.tree.Delete(key)
+		return nil
+	}
+	bs, err := missedBlocks.Marshal()
+	if err != nil {
+		return err
+	}
+	ws.state.tree.Set(key, bs)
+	return nil
+}
+
+//-------------------------------------
+// State.bonding
+
+// Returns the balance the validator at address has locked into its power with BondTx, which excludes any power it was
+// given by genesis or by GovTx
+func (s *ReadState) GetBonded(address crypto.Address) (uint64, error) {
+	done, err := s.startRead()
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+	bs := s.tree.Get(bondedKeyFormat.Key(address))
+	if bs == nil {
+		return 0, nil
+	}
+	if len(bs) != uint64Length {
+		return 0, fmt.Errorf("could not decode bonded amount of %v: expected %d bytes but got %d",
+			address, uint64Length, len(bs))
+	}
+	return binary.GetUint64BE(bs), nil
+}
+
+func (ws *writeState) SetBonded(address crypto.Address, amount uint64) error {
+	key := bondedKeyFormat.Key(address)
+	if amount == 0 {
+		ws.state.tree.Delete(key)
+		return nil
+	}
+	bs := make([]byte, uint64Length)
+	binary.PutUint64BE(bs, amount)
+	ws.state.tree.Set(key, bs)
+	return nil
+}
+
 // Creates a copy of the database to the supplied db
 func (s *State) Copy(db dbm.DB) (*State, error) {
 	stateCopy := NewState(db)
@@ -27,6 +27,7 @@ import (
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/sha3"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/permission"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -75,6 +76,298 @@ func TestWriteState_AddBlock(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestLoadState_MigrateTxKeys(t *testing.T) {
+	stateDB := db.NewMemDB()
+	s := NewState(stateDB)
+	height := uint64(3)
+	be := mkBlock(height, 2, 1)
+	hash, err := s.Update(func(ws Updatable) error {
+		return ws.AddBlock(be)
+	})
+	require.NoError(t, err)
+	// Store the transaction references as older versions did
+	for _, txe := range be.TxExecutions {
+		key := txKeyFormat.Key(txe.TxHash)
+		s.refs.Set(legacyTxKeyFormat.Key(txe.TxHash), s.refs.Get(key))
+		s.refs.Delete(key)
+	}
+	batch := stateDB.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+
+	s, err = LoadState(stateDB, hash)
+	require.NoError(t, err)
+	for _, txe := range be.TxExecutions {
+		txeOut, err := s.GetTx(txe.TxHash)
+		require.NoError(t, err)
+		require.NotNil(t, txeOut)
+		assert.Equal(t, txe.TxHash, txeOut.TxHash)
+		assert.False(t, s.refs.Has(legacyTxKeyFormat.Key(txe.TxHash)))
+	}
+	_, err = s.GetBlocks(0, height+1, func(be *exec.BlockExecution) (stop bool) {
+		assert.Equal(t, height, be.Height)
+		return false
+	})
+	require.NoError(t, err)
+
+	// The migration is recorded so it does not scan the references again
+	legacyKey := legacyTxKeyFormat.Key(be.TxExecutions[0].TxHash)
+	s.refs.Set(legacyKey, []byte{1})
+	batch = stateDB.NewBatch()
+	s.cacheDB.Commit(batch)
+	batch.WriteSync()
+	s, err = LoadState(stateDB, hash)
+	require.NoError(t, err)
+	assert.True(t, s.refs.Has(legacyKey))
+
+	// Nor is there anything to migrate for new state
+	genesisDB := db.NewMemDB()
+	_, err = MakeGenesisState(genesisDB, testGenesisDoc)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, refsVersion}, NewState(genesisDB).refs.Get(refsVersionKey))
+}
+
+func TestState_AtHeight(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	account := acm.NewConcreteAccountFromSecret("Foo").MutableAccount()
+	for height := uint64(1); height <= 3; height++ {
+		account.AddToBalance(10)
+		_, err := s.Update(func(ws Updatable) error {
+			err := ws.UpdateAccount(account)
+			if err != nil {
+				return err
+			}
+			return ws.AddBlock(mkBlock(height, 0, 0))
+		})
+		require.NoError(t, err)
+	}
+	for height := uint64(1); height <= 3; height++ {
+		st, err := s.AtHeight(height)
+		require.NoError(t, err)
+		accountOut, err := st.GetAccount(account.Address())
+		require.NoError(t, err)
+		assert.Equal(t, 10*height, accountOut.Balance())
+	}
+	_, err := s.AtHeight(4)
+	assert.Error(t, err)
+
+	require.NoError(t, s.Prune(3))
+	for height := uint64(1); height < 3; height++ {
+		_, err = s.AtHeight(height)
+		assert.Error(t, err)
+	}
+	st, err := s.AtHeight(3)
+	require.NoError(t, err)
+	accountOut, err := st.GetAccount(account.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(30), accountOut.Balance())
+}
+
+func TestState_PruneWhileReading(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	account := acm.NewConcreteAccountFromSecret("Foo").MutableAccount()
+	for height := uint64(1); height <= 3; height++ {
+		account.AddToBalance(10)
+		_, err := s.Update(func(ws Updatable) error {
+			err := ws.UpdateAccount(account)
+			if err != nil {
+				return err
+			}
+			return ws.AddBlock(mkBlock(height, 0, 0))
+		})
+		require.NoError(t, err)
+	}
+	st1, err := s.AtHeight(1)
+	require.NoError(t, err)
+	st2, err := s.AtHeight(2)
+	require.NoError(t, err)
+
+	// A version being read is not pruned
+	_, err = st1.IterateAccounts(func(acc acm.Account) (stop bool) {
+		require.NoError(t, s.Prune(3))
+		return false
+	})
+	require.NoError(t, err)
+	accountOut, err := st1.GetAccount(account.Address())
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), accountOut.Balance())
+	_, err = st2.GetAccount(account.Address())
+	assert.Error(t, err)
+
+	// Once no longer being read it is pruned and reads fail rather than finding missing nodes
+	require.NoError(t, s.Prune(3))
+	_, err = st1.GetAccount(account.Address())
+	assert.Error(t, err)
+	_, err = s.AtHeight(1)
+	assert.Error(t, err)
+}
+
+func TestReadState_GetWithProof(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	account := acm.NewConcreteAccountFromSecret("Foo").MutableAccount()
+	absent := acm.NewConcreteAccountFromSecret("Bar").Address
+	entry := &names.Entry{Name: "foo", Data: "bar", Owner: account.Address(), Expires: 100}
+	stateHash, err := s.Update(func(ws Updatable) error {
+		err := ws.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		err = ws.SetStorage(account.Address(), binary.One256, binary.Int64ToWord256(42))
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateName(entry)
+		if err != nil {
+			return err
+		}
+		return ws.AddBlock(mkBlock(1, 0, 0))
+	})
+	require.NoError(t, err)
+	st, err := s.AtHeight(1)
+	require.NoError(t, err)
+
+	accountOut, prf, err := st.GetAccountWithProof(account.Address(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), prf.Height)
+	assert.Equal(t, stateHash, prf.StateHash.Bytes())
+	assert.NoError(t, prf.VerifyAccount(stateHash, account.Address(), acm.AsConcreteAccount(accountOut).Account()))
+	assert.Error(t, prf.VerifyAccount(stateHash, account.Address(), nil))
+
+	accountOut, prf, err = st.GetAccountWithProof(absent, 1)
+	require.NoError(t, err)
+	assert.Nil(t, accountOut)
+	assert.NoError(t, prf.VerifyAccount(stateHash, absent, nil))
+
+	value, prf, err := st.GetStorageWithProof(account.Address(), binary.One256, 1)
+	require.NoError(t, err)
+	assert.Equal(t, binary.Int64ToWord256(42), value)
+	assert.NoError(t, prf.VerifyStorage(stateHash, account.Address(), binary.One256, value))
+
+	entryOut, prf, err := st.GetNameWithProof("foo", 1)
+	require.NoError(t, err)
+	assert.NoError(t, prf.VerifyName(stateHash, "foo", entryOut))
+	entryOut.Data = "baz"
+	assert.Error(t, prf.VerifyName(stateHash, "foo", entryOut))
+}
+
+func TestState_GetBlocksIndexed(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	for height := uint64(1); height <= 6; height++ {
+		_, err := s.Update(func(ws Updatable) error {
+			err := ws.AddBlock(mkBlock(height, 1, 1))
+			if err != nil {
+				return err
+			}
+			// Only index from height 3 onwards
+			if height < 3 {
+				return nil
+			}
+			return ws.IndexBlock(mkBlock(height, 1, 1))
+		})
+		require.NoError(t, err)
+	}
+	heights := func(terms *exec.IndexTerms) []uint64 {
+		var hs []uint64
+		_, err := s.GetBlocksIndexed(1, 7, terms, func(be *exec.BlockExecution) (stop bool) {
+			hs = append(hs, be.Height)
+			return false
+		})
+		require.NoError(t, err)
+		return hs
+	}
+	topic := binary.Word256{1, 2, 3}
+	absentTopic := binary.Word256{9}
+
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, heights(nil))
+	// Blocks from before the start of the index are always visited
+	assert.Equal(t, []uint64{1, 2, 5}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{5}}}))
+	assert.Equal(t, []uint64{1, 2, 4},
+		heights(&exec.IndexTerms{Addresses: []crypto.Address{{4}}, Topics: []binary.Word256{topic}}))
+	assert.Equal(t, []uint64{1, 2},
+		heights(&exec.IndexTerms{Addresses: []crypto.Address{{5}}, Topics: []binary.Word256{absentTopic}}))
+
+	require.NoError(t, s.RebuildEventIndex())
+	assert.Equal(t, []uint64{5}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{5}}}))
+	assert.Len(t, heights(&exec.IndexTerms{Topics: []binary.Word256{absentTopic}}), 0)
+	assert.Equal(t, []uint64{1}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{1}}}))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6}, heights(&exec.IndexTerms{Topics: []binary.Word256{topic}}))
+}
+
+func TestState_GetBlocksIndexed_Gaps(t *testing.T) {
+	s := NewState(db.NewMemDB())
+	for height := uint64(1); height <= 8; height++ {
+		_, err := s.Update(func(ws Updatable) error {
+			err := ws.AddBlock(mkBlock(height, 1, 1))
+			if err != nil {
+				return err
+			}
+			// Indexing is turned off for heights 3, 4, 7, and 8
+			if height%4 == 3 || height%4 == 0 {
+				return nil
+			}
+			return ws.IndexBlock(mkBlock(height, 1, 1))
+		})
+		require.NoError(t, err)
+	}
+	heights := func(terms *exec.IndexTerms) []uint64 {
+		var hs []uint64
+		_, err := s.GetBlocksIndexed(1, 9, terms, func(be *exec.BlockExecution) (stop bool) {
+			hs = append(hs, be.Height)
+			return false
+		})
+		require.NoError(t, err)
+		return hs
+	}
+	// The index restarted at height 5 and ended at 6 so the blocks around it are all visited
+	assert.Equal(t, []uint64{1, 2, 3, 4, 6, 7, 8}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{6}}}))
+	assert.Equal(t, []uint64{1, 2, 3, 4, 7, 8}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{3}}}))
+
+	require.NoError(t, s.RebuildEventIndex())
+	assert.Equal(t, []uint64{3}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{3}}}))
+	assert.Equal(t, []uint64{8}, heights(&exec.IndexTerms{Addresses: []crypto.Address{{8}}}))
+}
+
+func TestLoadState_RecommitLastBlock(t *testing.T) {
+	stateDB := db.NewMemDB()
+	s := NewState(stateDB)
+	var hash []byte
+	var err error
+	for height := uint64(1); height <= 2; height++ {
+		hash, err = s.Update(func(ws Updatable) error {
+			return ws.AddBlock(mkBlock(height, 1, 1))
+		})
+		require.NoError(t, err)
+	}
+
+	s, err = LoadState(stateDB, hash)
+	require.NoError(t, err)
+	// The last block may be replayed after loading
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.AddBlock(mkBlock(2, 1, 1))
+	})
+	require.NoError(t, err)
+	// But not replaced by a different block
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.AddBlock(mkBlock(2, 2, 1))
+	})
+	require.Error(t, err)
+	be, err := s.GetBlock(2)
+	require.NoError(t, err)
+	assert.Len(t, be.TxExecutions, 1)
+	txe, err := s.GetTx(be.TxExecutions[0].TxHash)
+	require.NoError(t, err)
+	assert.Equal(t, be.TxExecutions[0], txe)
+	// But blocks must otherwise follow on
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.AddBlock(mkBlock(4, 1, 1))
+	})
+	require.Error(t, err)
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.AddBlock(mkBlock(3, 1, 1))
+	})
+	require.NoError(t, err)
+}
+
 func mkBlock(height, numTxs, events uint64) *exec.BlockExecution {
 	be := &exec.BlockExecution{
 		Height: height,
@@ -114,3 +407,64 @@ func asJSON(t *testing.T, v interface{}) string {
 	require.NoError(t, err)
 	return string(bs)
 }
+
+func TestState_PutAbi(t *testing.T) {
+	stateDB := db.NewMemDB()
+	s := NewState(stateDB)
+	address := crypto.Address{1, 2, 3}
+	abiJSON := `[{"inputs":[],"name":"Ping","type":"event"}]`
+	abiOut, err := s.GetAbi(address)
+	require.NoError(t, err)
+	assert.Equal(t, "", abiOut)
+
+	// Only a contract may have an ABI
+	require.Error(t, s.PutAbi(address, abiJSON))
+	otherAddress := crypto.Address{4, 5, 6}
+	_, err = s.Update(func(ws Updatable) error {
+		for _, address := range []crypto.Address{address, otherAddress} {
+			err := ws.UpdateAccount(acm.ConcreteAccount{Address: address, Code: []byte{0x60, 0}}.Account())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.Error(t, s.PutAbi(address, "not an ABI"))
+	require.NoError(t, s.PutAbi(address, abiJSON))
+	// Should be persisted without a block being committed
+	abiOut, err = NewState(stateDB).GetAbi(address)
+	require.NoError(t, err)
+	assert.Equal(t, abiJSON, abiOut)
+	// Storing the same ABI again is harmless but it cannot be replaced
+	require.NoError(t, s.PutAbi(address, abiJSON))
+	pangAbiJSON := `[{"inputs":[],"name":"Pang","type":"event"}]`
+	require.Error(t, s.PutAbi(address, pangAbiJSON))
+	// Except by the operator
+	require.Error(t, s.SetAbi(address, "not an ABI"))
+	require.NoError(t, s.SetAbi(address, pangAbiJSON))
+	abiOut, err = NewState(stateDB).GetAbi(address)
+	require.NoError(t, err)
+	assert.Equal(t, pangAbiJSON, abiOut)
+	s.DeleteAbi(address)
+	abiOut, err = NewState(stateDB).GetAbi(address)
+	require.NoError(t, err)
+	assert.Equal(t, "", abiOut)
+	require.NoError(t, s.PutAbi(address, abiJSON))
+
+	// The ABI committed with the contract takes precedence
+	metaAbiJSON := `[{"inputs":[],"name":"Pong","type":"event"}]`
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.SetContractMeta(address, &acm.ContractMeta{Abi: metaAbiJSON})
+	})
+	require.NoError(t, err)
+	abiOut, err = s.GetAbi(address)
+	require.NoError(t, err)
+	assert.Equal(t, metaAbiJSON, abiOut)
+	_, err = s.Update(func(ws Updatable) error {
+		return ws.SetContractMeta(otherAddress, &acm.ContractMeta{Abi: metaAbiJSON})
+	})
+	require.NoError(t, err)
+	require.Error(t, s.PutAbi(otherAddress, abiJSON))
+}
@@ -21,15 +21,18 @@ import (
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/state"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
 	abciTypes "github.com/tendermint/tendermint/abci/types"
 	tmTypes "github.com/tendermint/tendermint/types"
 )
@@ -53,14 +56,42 @@ type Transactor struct {
 	MempoolAccounts *Accounts
 	checkTxAsync    func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error
 	txEncoder       txs.Encoder
-	logger          *logging.Logger
+	// Optional queue for signed transactions with sequence numbers ahead of the mempool
+	futureTxs *FutureTxQueue
+	// Applied to simulated calls so that they run as they would on the executor
+	natives   *evm.Natives
+	vmOptions []func(*evm.VM)
+	logger    *logging.Logger
+}
+
+type TransactorOption func(*Transactor)
+
+// Hold signed transactions with input sequence numbers ahead of the mempool until the gap before them is filled,
+// rather than letting CheckTx reject them
+func QueueFutureTxs(ttl time.Duration, limit int) TransactorOption {
+	return func(trans *Transactor) {
+		trans.futureTxs = NewFutureTxQueue(ttl, limit)
+	}
+}
+
+// Simulate calls with the natives and VM options set by exeOptions so that simulations (including gas estimates) match
+// execution
+func SimulateWith(exeOptions ...ExecutionOption) TransactorOption {
+	return func(trans *Transactor) {
+		exe := new(executor)
+		for _, option := range exeOptions {
+			option(exe)
+		}
+		trans.natives = exe.natives
+		trans.vmOptions = exe.vmOptions
+	}
 }
 
 func NewTransactor(tip bcm.BlockchainInfo, subscribable event.Subscribable, mempoolAccounts *Accounts,
 	checkTxAsync func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error, txEncoder txs.Encoder,
-	logger *logging.Logger) *Transactor {
+	logger *logging.Logger, options ...TransactorOption) *Transactor {
 
-	return &Transactor{
+	trans := &Transactor{
 		Tip:             tip,
 		Subscribable:    subscribable,
 		MempoolAccounts: mempoolAccounts,
@@ -68,9 +99,47 @@ func NewTransactor(tip bcm.BlockchainInfo, subscribable event.Subscribable, memp
 		txEncoder:       txEncoder,
 		logger:          logger.With(structure.ComponentKey, "Transactor"),
 	}
+	for _, option := range options {
+		option(trans)
+	}
+	return trans
 }
 
 func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelope) (*exec.TxExecution, error) {
+	wait, err := trans.broadcastTx(ctx, txEnv)
+	if err != nil {
+		return nil, err
+	}
+	return wait()
+}
+
+// Broadcasts each transaction in order, so that they may be signed with consecutive sequence numbers, then waits for
+// all of them to be included in blocks. Transactions broadcast before any failure are not withdrawn, use a BatchTx
+// for atomicity.
+func (trans *Transactor) BroadcastTxBatchSync(ctx context.Context, txEnvs []*txs.Envelope) ([]*exec.TxExecution,
+	error) {
+	waits := make([]func() (*exec.TxExecution, error), len(txEnvs))
+	for i, txEnv := range txEnvs {
+		wait, err := trans.broadcastTx(ctx, txEnv)
+		if err != nil {
+			return nil, fmt.Errorf("could not broadcast transaction %d of batch: %v", i, err)
+		}
+		waits[i] = wait
+	}
+	txes := make([]*exec.TxExecution, len(txEnvs))
+	for i, wait := range waits {
+		txe, err := wait()
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d of batch: %v", i, err)
+		}
+		txes[i] = txe
+	}
+	return txes, nil
+}
+
+// Broadcasts txEnv to the mempool returning a function that waits for it to be executed in a block
+func (trans *Transactor) broadcastTx(ctx context.Context, txEnv *txs.Envelope) (func() (*exec.TxExecution, error),
+	error) {
 	// Sign unless already signed - note we must attempt signing before subscribing so we get accurate final TxHash
 	unlock, err := trans.MaybeSignTxMempool(txEnv)
 	if err != nil {
@@ -92,25 +161,27 @@ func (trans *Transactor) BroadcastTxSync(ctx context.Context, txEnv *txs.Envelop
 	if err != nil {
 		return nil, err
 	}
-	defer trans.Subscribable.UnsubscribeAll(context.Background(), subID)
-	// Wait for all responses
-	timer := time.NewTimer(BlockingTimeout)
-	defer timer.Stop()
+	return func() (*exec.TxExecution, error) {
+		defer trans.Subscribable.UnsubscribeAll(context.Background(), subID)
+		// Wait for all responses
+		timer := time.NewTimer(BlockingTimeout)
+		defer timer.Stop()
 
-	// Get all the execution events for this Tx
-	select {
-	case <-ctx.Done():
-		return nil, ctx.Err()
-	case <-timer.C:
-		return nil, fmt.Errorf("timed out waiting for transaction with hash %v timed out after %v",
-			checkTxReceipt.TxHash, BlockingTimeout)
-	case msg := <-out:
-		txe := msg.(*exec.TxExecution)
-		if txe.Exception != nil && txe.Exception.ErrorCode() != errors.ErrorCodeExecutionReverted {
-			return nil, errors.Wrap(txe.Exception, "exception during transaction execution")
+		// Get all the execution events for this Tx
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, fmt.Errorf("timed out waiting for transaction with hash %v timed out after %v",
+				checkTxReceipt.TxHash, BlockingTimeout)
+		case msg := <-out:
+			txe := msg.(*exec.TxExecution)
+			if txe.Exception != nil && txe.Exception.ErrorCode() != errors.ErrorCodeExecutionReverted {
+				return nil, errors.Wrap(txe.Exception, "exception during transaction execution")
+			}
+			return txe, nil
 		}
-		return txe, nil
-	}
+	}, nil
 }
 
 // Broadcast a transaction without waiting for confirmation - will attempt to sign server-side and set sequence numbers
@@ -135,11 +206,146 @@ func (trans *Transactor) CheckTxSync(txEnv *txs.Envelope) (*txs.Receipt, error)
 	if err != nil {
 		return nil, err
 	}
+	if trans.futureTxs != nil {
+		queued, err := trans.maybeQueueFutureTx(txEnv)
+		if err != nil {
+			return nil, err
+		}
+		if queued {
+			return txEnv.Tx.GenerateReceipt(), nil
+		}
+	}
 	txBytes, err := trans.txEncoder.EncodeTx(txEnv)
 	if err != nil {
 		return nil, err
 	}
-	return trans.CheckTxSyncRaw(txBytes)
+	receipt, err := trans.CheckTxSyncRaw(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if trans.futureTxs != nil {
+		for _, input := range txEnv.Tx.GetInputs() {
+			trans.releaseFutureTx(input.Address)
+		}
+	}
+	return receipt, nil
+}
+
+// Whether the future transaction queue is enabled
+func (trans *Transactor) QueuesFutureTxs() bool {
+	return trans.futureTxs != nil
+}
+
+// Lists the queued future transactions, only those queued on address if it is not nil
+func (trans *Transactor) FutureTxs(address *crypto.Address) []*FutureTx {
+	if trans.futureTxs == nil {
+		return nil
+	}
+	return trans.futureTxs.List(address)
+}
+
+// Broadcasts queued future transactions as blocks are committed (which may fill gaps with transactions from other
+// nodes) and drops those that have expired until ctx is done
+func (trans *Transactor) ProcessFutureTxs(ctx context.Context) error {
+	if trans.futureTxs == nil {
+		return fmt.Errorf("the future transaction queue is not enabled")
+	}
+	subID := event.GenSubID()
+	out, err := trans.Subscribable.Subscribe(ctx, subID, exec.QueryForBlockExecution(), SubscribeBufferSize)
+	if err != nil {
+		return err
+	}
+	go func() {
+		defer trans.Subscribable.UnsubscribeAll(context.Background(), subID)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-out:
+				if !ok {
+					return
+				}
+				for _, ftx := range trans.futureTxs.Expire(time.Now()) {
+					trans.logger.InfoMsg("Dropping expired future transaction",
+						"tx_hash", ftx.Envelope.Tx.Hash(),
+						"address", ftx.Address,
+						"sequence", ftx.Sequence)
+				}
+				for _, address := range trans.futureTxs.Addresses() {
+					trans.releaseFutureTx(address)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Queues txEnv if it was signed by the client with an input sequence number that leaves a gap after the mempool. Since
+// queued transactions bypass CheckTx until released their signatures are verified here.
+func (trans *Transactor) maybeQueueFutureTx(txEnv *txs.Envelope) (bool, error) {
+	for _, input := range txEnv.Tx.GetInputs() {
+		sequence, err := trans.mempoolSequence(input.Address)
+		if err != nil {
+			return false, err
+		}
+		if input.Sequence > sequence+1 {
+			err = txEnv.Verify(trans.MempoolAccounts, trans.Tip.ChainID())
+			if err != nil {
+				return false, fmt.Errorf("cannot queue future transaction: %v", err)
+			}
+			ftx, err := trans.futureTxs.Push(txEnv, input.Address, input.Sequence)
+			if err != nil {
+				return false, err
+			}
+			trans.logger.TraceMsg("Queued future transaction",
+				"tx_hash", txEnv.Tx.Hash(),
+				"address", input.Address,
+				"sequence", input.Sequence,
+				"mempool_sequence", sequence,
+				"expires", ftx.Expires)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Broadcasts any transaction queued on the next sequence number of address, which in turn releases its successors
+func (trans *Transactor) releaseFutureTx(address crypto.Address) {
+	sequence, err := trans.mempoolSequence(address)
+	if err != nil {
+		trans.logger.InfoMsg("Could not get mempool sequence for future transactions",
+			"address", address,
+			structure.ErrorKey, err)
+		return
+	}
+	ftx := trans.futureTxs.Get(address, sequence+1)
+	if ftx == nil {
+		return
+	}
+	_, err = trans.CheckTxSync(ftx.Envelope)
+	if err != nil {
+		if errors.AsException(err).ErrorCode() == errors.ErrorCodeInvalidSequence {
+			// Another input may still be behind so keep the transaction until it expires
+			return
+		}
+		trans.logger.InfoMsg("Queued future transaction was rejected",
+			"tx_hash", ftx.Envelope.Tx.Hash(),
+			"address", address,
+			"sequence", ftx.Sequence,
+			structure.ErrorKey, err)
+	}
+	trans.futureTxs.Remove(address, ftx.Sequence)
+}
+
+func (trans *Transactor) mempoolSequence(address crypto.Address) (uint64, error) {
+	acc, err := trans.MempoolAccounts.GetAccount(address)
+	if err != nil {
+		return 0, err
+	}
+	if acc == nil {
+		return 0, nil
+	}
+	return acc.Sequence(), nil
 }
 
 func (trans *Transactor) MaybeSignTxMempool(txEnv *txs.Envelope) (UnlockFunc, error) {
@@ -257,10 +463,51 @@ func (trans *Transactor) CheckTxAsync(txEnv *txs.Envelope, callback func(res *ab
 	return trans.CheckTxAsyncRaw(txBytes, callback)
 }
 
-func (trans *Transactor) CallCodeSim(fromAddress crypto.Address, code, data []byte) (*exec.TxExecution, error) {
-	return CallCodeSim(trans.MempoolAccounts, trans.Tip, fromAddress, fromAddress, code, data, trans.logger)
+func (trans *Transactor) CallCodeSim(fromAddress crypto.Address, code, data []byte,
+	vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	return trans.CallCodeSimAt(trans.MempoolAccounts, trans.Tip, fromAddress, code, data, vmOptions...)
 }
 
-func (trans *Transactor) CallSim(fromAddress, address crypto.Address, data []byte) (*exec.TxExecution, error) {
-	return CallSim(trans.MempoolAccounts, trans.Tip, fromAddress, address, data, trans.logger)
+// Like CallCodeSim but against the given state, for instance that of an earlier height
+func (trans *Transactor) CallCodeSimAt(reader state.Reader, tip bcm.BlockchainInfo, fromAddress crypto.Address,
+	code, data []byte, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	return CallCodeSim(reader, tip, fromAddress, fromAddress, code, data, trans.natives, trans.logger,
+		trans.withVMOptions(vmOptions)...)
+}
+
+// Finds the smallest GasLimit with which tx succeeds against the current state, see EstimateGas
+func (trans *Transactor) EstimateGas(tx *payload.CallTx) (uint64, *exec.TxExecution, error) {
+	return trans.EstimateGasAt(trans.MempoolAccounts, trans.Tip, tx)
+}
+
+// Like EstimateGas but against the given state
+func (trans *Transactor) EstimateGasAt(reader state.Reader, tip bcm.BlockchainInfo,
+	tx *payload.CallTx) (uint64, *exec.TxExecution, error) {
+	return EstimateGas(reader, tip, tx, trans.natives, trans.logger, trans.vmOptions...)
+}
+
+func (trans *Transactor) CallSim(fromAddress, address crypto.Address, data []byte,
+	vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	return trans.CallSimAt(trans.MempoolAccounts, trans.Tip, fromAddress, address, data, vmOptions...)
+}
+
+// Like CallSim but against the given state
+func (trans *Transactor) CallSimAt(reader state.Reader, tip bcm.BlockchainInfo, fromAddress, address crypto.Address,
+	data []byte, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	return CallSim(reader, tip, fromAddress, address, data, trans.natives, trans.logger,
+		trans.withVMOptions(vmOptions)...)
+}
+
+// Runs tx (which creates a contract if it has no Address) against the given state without committing it
+func (trans *Transactor) CallTxSimAt(reader state.Reader, tip bcm.BlockchainInfo, tx *payload.CallTx,
+	vmOptions ...func(*evm.VM)) (*exec.TxExecution, error) {
+	return CallTxSim(reader, tip, tx, trans.natives, trans.logger, trans.withVMOptions(vmOptions)...)
+}
+
+// Returns the transactor's VM options followed by vmOptions without modifying either
+func (trans *Transactor) withVMOptions(vmOptions []func(*evm.VM)) []func(*evm.VM) {
+	if len(vmOptions) == 0 {
+		return trans.vmOptions
+	}
+	return append(trans.vmOptions[:len(trans.vmOptions):len(trans.vmOptions)], vmOptions...)
 }
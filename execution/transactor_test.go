@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/state"
@@ -25,6 +26,7 @@ import (
 	"github.com/hyperledger/burrow/consensus/tendermint/codes"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/keys/mock"
 	"github.com/hyperledger/burrow/logging"
@@ -76,3 +78,113 @@ func TestTransactor_BroadcastTxSync(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, height, txe.Height)
 }
+
+func TestTransactor_FutureTxs(t *testing.T) {
+	chainID := testChainID
+	logger := logging.NewNoopLogger()
+	txCodec := txs.NewAminoCodec()
+	privAccount := acm.GeneratePrivateAccountFromSecret("frogs")
+	st := state.NewMemoryState()
+	acc := acm.ConcreteAccount{Address: privAccount.Address(), Sequence: 3}.MutableAccount()
+	require.NoError(t, st.UpdateAccount(acc))
+
+	var checked []uint64
+	// Behaves as CheckTx does with respect to sequence numbers
+	checkTxAsync := func(tx tmTypes.Tx, cb func(*abciTypes.Response)) error {
+		txEnv, err := txCodec.DecodeTx(tx)
+		if err != nil {
+			return err
+		}
+		input := txEnv.Tx.GetInputs()[0]
+		acc, err := state.GetMutableAccount(st, input.Address)
+		if err != nil {
+			return err
+		}
+		if input.Sequence != acc.Sequence()+1 {
+			cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{
+				Code: uint32(errors.ErrorCodeInvalidSequence),
+				Log:  "invalid sequence",
+			}))
+			return nil
+		}
+		acc.IncSequence()
+		err = st.UpdateAccount(acc)
+		if err != nil {
+			return err
+		}
+		checked = append(checked, input.Sequence)
+		bs, err := txEnv.Tx.GenerateReceipt().Encode()
+		if err != nil {
+			return err
+		}
+		cb(abciTypes.ToResponseCheckTx(abciTypes.ResponseCheckTx{
+			Code: codes.TxExecutionSuccessCode,
+			Data: bs,
+		}))
+		return nil
+	}
+	signedTx := func(sequence uint64) *txs.Envelope {
+		txEnv := txs.Enclose(chainID, &payload.CallTx{
+			Input: &payload.TxInput{
+				Address:  privAccount.Address(),
+				Sequence: sequence,
+			},
+			Address: &crypto.Address{1, 2, 3},
+		})
+		require.NoError(t, txEnv.Sign(privAccount))
+		return txEnv
+	}
+
+	trans := NewTransactor(newBlockchain(testGenesisDoc), event.NewEmitter(logger),
+		NewAccounts(st, mock.NewKeyClient(privAccount), 100), checkTxAsync, txCodec, logger,
+		QueueFutureTxs(time.Minute, 2))
+
+	// Submit out of order
+	for _, sequence := range []uint64{6, 5} {
+		receipt, err := trans.BroadcastTxAsync(signedTx(sequence))
+		require.NoError(t, err)
+		assert.NotNil(t, receipt.TxHash)
+	}
+	_, err := trans.BroadcastTxAsync(signedTx(7))
+	require.Error(t, err, "should exceed queue limit")
+
+	// Transactions that would not pass CheckTx are neither queued nor replace those queued
+	forged := signedTx(5)
+	forged.Tx.Payload.(*payload.CallTx).Data = []byte{1}
+	forged.Tx.Rehash()
+	_, err = trans.BroadcastTxAsync(forged)
+	require.Error(t, err, "signature should not verify")
+	otherAccount := acm.GeneratePrivateAccountFromSecret("toads")
+	impostor := signedTx(5)
+	signBytes, err := impostor.Tx.SignBytes()
+	require.NoError(t, err)
+	impostor.Signatories[0].Signature, err = otherAccount.Sign(signBytes)
+	require.NoError(t, err)
+	publicKey := otherAccount.PublicKey()
+	impostor.Signatories[0].PublicKey = &publicKey
+	_, err = trans.BroadcastTxAsync(impostor)
+	require.Error(t, err, "should not be signed by the input")
+	wrongChain := signedTx(5)
+	wrongChain.Tx.ChainID = "OtherChain"
+	wrongChain.Tx.Rehash()
+	require.NoError(t, wrongChain.Sign(privAccount))
+	_, err = trans.BroadcastTxAsync(wrongChain)
+	require.Error(t, err, "should be for another chain")
+
+	queued := trans.FutureTxs(nil)
+	require.Len(t, queued, 2)
+	assert.Equal(t, uint64(5), queued[0].Sequence)
+	assert.Equal(t, signedTx(5).Tx.Hash(), queued[0].Envelope.Tx.Hash())
+	assert.Equal(t, uint64(6), queued[1].Sequence)
+	assert.Empty(t, checked)
+
+	// Filling the gap releases the queue in order
+	_, err = trans.BroadcastTxAsync(signedTx(4))
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{4, 5, 6}, checked)
+	assert.Empty(t, trans.FutureTxs(nil))
+
+	// Sequences already used are rejected as before
+	_, err = trans.BroadcastTxAsync(signedTx(5))
+	require.Error(t, err)
+}
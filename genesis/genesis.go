@@ -24,6 +24,7 @@ import (
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/schedule"
 	"github.com/hyperledger/burrow/permission"
 )
 
@@ -55,6 +56,25 @@ type Validator struct {
 	UnbondTo    []BasicAccount
 }
 
+type MinimumFee struct {
+	// The name of the payload type, such as CallTx
+	TxType string
+	Fee    uint64
+}
+
+// The penalties applied to validators that Tendermint reports as misbehaving
+type Slashing struct {
+	// The percentage of its power destroyed when a validator is found to have signed conflicting votes
+	DoubleSignPercentage uint64
+	// The number of most recent blocks over which the blocks a validator failed to sign are counted, zero disables
+	// jailing for downtime
+	DowntimeWindow uint64
+	// A validator that fails to sign more than this many blocks within DowntimeWindow is jailed
+	MaxMissedBlocks uint64
+	// The number of blocks for which a jailed validator's power is withheld, zero withholds it indefinitely
+	JailPeriod uint64
+}
+
 //------------------------------------------------------------
 // GenesisDoc is stored in the state database
 
@@ -65,6 +85,17 @@ type GenesisDoc struct {
 	GlobalPermissions permission.AccountPermissions
 	Accounts          []Account
 	Validators        []Validator
+	// The gas costs charged by the EVM, if absent the burrow-legacy schedule is used
+	GasSchedule *schedule.GasSchedule `json:",omitempty" toml:",omitempty"`
+	// The number of blocks after an UnbondTx before the unbonded funds are released
+	UnbondingPeriod uint64 `json:",omitempty" toml:",omitempty"`
+	// The initial minimum fees that transactions must pay, which may be changed by GovTx
+	MinimumFees []MinimumFee `json:",omitempty" toml:",omitempty"`
+	// The percentage of the fees collected in a block paid to its proposer, the remainder is shared between the
+	// validators in proportion to their power
+	ProposerFeePercentage uint64 `json:",omitempty" toml:",omitempty"`
+	// Penalties for double-signing and downtime, if absent validators are never slashed or jailed
+	Slashing *Slashing `json:",omitempty" toml:",omitempty"`
 }
 
 func (genesisDoc *GenesisDoc) JSONString() string {
@@ -19,6 +19,7 @@ import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import crypto "github.com/hyperledger/burrow/crypto"
 import balance "github.com/hyperledger/burrow/acm/balance"
+import acm "github.com/hyperledger/burrow/acm"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 import github_com_hyperledger_burrow_acm "github.com/hyperledger/burrow/acm"
@@ -46,6 +47,9 @@ type TemplateAccount struct {
 	Permissions []string                                      `protobuf:"bytes,6,rep,name=Permissions" json:",omitempty" toml:",omitempty"`
 	Roles       []string                                      `protobuf:"bytes,7,rep,name=Roles" json:",omitempty" toml:",omitempty"`
 	Code        *github_com_hyperledger_burrow_acm.Bytecode   `protobuf:"bytes,8,opt,name=Code,proto3,customtype=github.com/hyperledger/burrow/acm.Bytecode" json:"Code,omitempty"`
+	// Makes the account a multisig account whose address is derived from the policy (so Address and PublicKey should
+	// be omitted)
+	Multisig *acm.MultisigPolicy `protobuf:"bytes,9,opt,name=Multisig" json:",omitempty" toml:",omitempty"`
 }
 
 func (m *TemplateAccount) Reset()                    { *m = TemplateAccount{} }
@@ -88,6 +92,13 @@ func (m *TemplateAccount) GetRoles() []string {
 	return nil
 }
 
+func (m *TemplateAccount) GetMultisig() *acm.MultisigPolicy {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
 func (*TemplateAccount) XXX_MessageName() string {
 	return "spec.TemplateAccount"
 }
@@ -198,6 +209,16 @@ func (m *TemplateAccount) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n4
 	}
+	if m.Multisig != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintSpec(dAtA, i, uint64(m.Multisig.Size()))
+		n5, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -251,6 +272,10 @@ func (m *TemplateAccount) Size() (n int) {
 		l = m.Code.Size()
 		n += 1 + l + sovSpec(uint64(l))
 	}
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
+		n += 1 + l + sovSpec(uint64(l))
+	}
 	return n
 }
 
@@ -543,6 +568,39 @@ func (m *TemplateAccount) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSpec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSpec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Multisig == nil {
+				m.Multisig = &acm.MultisigPolicy{}
+			}
+			if err := m.Multisig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSpec(dAtA[iNdEx:])
@@ -673,30 +731,32 @@ func init() { proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 func init() { golang_proto.RegisterFile("spec.proto", fileDescriptorSpec) }
 
 var fileDescriptorSpec = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc6, 0x34, 0xbd, 0x52, 0xe7, 0x10, 0x9c, 0xa7, 0xe8, 0x86, 0x24, 0x2a, 0x03, 0x11, 0x3a,
-	0x12, 0xa9, 0x4c, 0x30, 0xd1, 0x20, 0x58, 0x90, 0x4e, 0xa7, 0x5c, 0x27, 0xb6, 0xc4, 0x79, 0xa4,
-	0x91, 0xe2, 0x38, 0xb2, 0x1d, 0xa1, 0x6c, 0xfc, 0x34, 0xc6, 0x8c, 0xcc, 0x1d, 0x22, 0xd4, 0x6e,
-	0x8c, 0xfc, 0x02, 0x54, 0xa7, 0xa1, 0x9d, 0x20, 0x0b, 0x93, 0xdf, 0xe7, 0xa7, 0xef, 0xfb, 0x9e,
-	0xde, 0x67, 0x63, 0x2c, 0x2b, 0xa0, 0x7e, 0x25, 0xb8, 0xe2, 0xc4, 0x38, 0xd4, 0xd7, 0x2f, 0xb3,
-	0x5c, 0x6d, 0xea, 0xc4, 0xa7, 0x9c, 0x05, 0x19, 0xcf, 0x78, 0xa0, 0x9b, 0x49, 0xfd, 0x59, 0x23,
-	0x0d, 0x74, 0xd5, 0x93, 0xae, 0x2f, 0xa9, 0x68, 0x2a, 0x35, 0xa0, 0xc7, 0x49, 0x5c, 0xc4, 0x25,
-	0x85, 0x1e, 0x2e, 0xbe, 0x4e, 0xf1, 0x93, 0x35, 0xb0, 0xaa, 0x88, 0x15, 0xac, 0x28, 0xe5, 0x75,
-	0xa9, 0x08, 0xc1, 0xc6, 0x6d, 0xcc, 0xc0, 0x42, 0x2e, 0xf2, 0xe6, 0x91, 0xae, 0x09, 0xc3, 0xb3,
-	0x55, 0x9a, 0x0a, 0x90, 0xd2, 0x7a, 0xe8, 0x22, 0xef, 0x32, 0xbc, 0xdf, 0x76, 0xce, 0xcd, 0xd9,
-	0x20, 0x9b, 0xa6, 0x02, 0x51, 0x40, 0x9a, 0x81, 0x08, 0x92, 0x5a, 0x08, 0xfe, 0x25, 0x38, 0xfa,
-	0x1e, 0x79, 0x3f, 0x3b, 0x07, 0xdf, 0x70, 0x96, 0x2b, 0x60, 0x95, 0x6a, 0x7e, 0x75, 0xce, 0x95,
-	0xe2, 0xac, 0x78, 0xb3, 0x38, 0xdd, 0x2d, 0xa2, 0xc1, 0x83, 0xd4, 0xd8, 0xbc, 0xe5, 0x29, 0x0c,
-	0x96, 0x93, 0xff, 0x67, 0x79, 0xee, 0x43, 0xd6, 0x78, 0x7e, 0x57, 0x27, 0x45, 0x4e, 0x3f, 0x42,
-	0x63, 0x19, 0x2e, 0xf2, 0xcc, 0xe5, 0x95, 0x7f, 0xd4, 0xfc, 0xd3, 0x08, 0x9f, 0x8d, 0xd1, 0x3d,
-	0x09, 0x91, 0x7b, 0x3c, 0x5b, 0xb1, 0xc3, 0x66, 0xa5, 0x35, 0x75, 0x27, 0x9e, 0xb9, 0x7c, 0xea,
-	0x0f, 0x21, 0x84, 0xfd, 0x19, 0x3e, 0x6f, 0x3b, 0xe7, 0xc1, 0xb8, 0x0d, 0xf5, 0x4a, 0xe4, 0x3d,
-	0x36, 0xef, 0x40, 0xb0, 0x5c, 0xca, 0x9c, 0x97, 0xd2, 0xba, 0x70, 0x27, 0xde, 0x7c, 0xdc, 0x64,
-	0xe7, 0x3c, 0xf2, 0x1a, 0x4f, 0x23, 0x5e, 0x80, 0xb4, 0x66, 0xe3, 0x05, 0x7a, 0x06, 0xf9, 0x80,
-	0x8d, 0x77, 0x3c, 0x05, 0xeb, 0x91, 0x0e, 0x67, 0xd9, 0x76, 0x0e, 0xda, 0x76, 0xce, 0x8b, 0xbf,
-	0x07, 0x14, 0x53, 0xe6, 0x87, 0x8d, 0x02, 0xca, 0x53, 0x88, 0x34, 0x3f, 0x7c, 0xdb, 0xee, 0x6c,
-	0xf4, 0x7d, 0x67, 0xa3, 0x1f, 0x3b, 0x1b, 0x7d, 0xdb, 0xdb, 0xa8, 0xdd, 0xdb, 0xe8, 0xd3, 0x3f,
-	0x74, 0x32, 0x28, 0x41, 0xe6, 0x32, 0x38, 0x7c, 0x88, 0xe4, 0x42, 0xbf, 0xe5, 0x57, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x89, 0xe7, 0xcb, 0xa5, 0x2b, 0x03, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x3f, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0xb4, 0xbd, 0x5e, 0xdc, 0x43, 0x70, 0x66, 0x89, 0x6e, 0x48, 0xa2, 0x32, 0x10, 0xa1,
+	0x23, 0x91, 0xca, 0x04, 0x13, 0x0d, 0x82, 0x05, 0x71, 0xaa, 0x7a, 0x9d, 0xd8, 0x12, 0xe7, 0x91,
+	0xb3, 0x14, 0xd7, 0x91, 0xed, 0x08, 0xe5, 0xdb, 0x31, 0x76, 0x64, 0x62, 0xb8, 0x21, 0x42, 0xbd,
+	0x8d, 0x91, 0x4f, 0x80, 0xe2, 0x24, 0xd7, 0x4e, 0x90, 0x85, 0x29, 0xef, 0xd9, 0xf9, 0xfd, 0xd1,
+	0xef, 0xf9, 0x61, 0xac, 0x0a, 0xa0, 0x41, 0x21, 0x85, 0x16, 0x64, 0xdc, 0xd4, 0x17, 0x2f, 0x33,
+	0xa6, 0x6f, 0xca, 0x24, 0xa0, 0x82, 0x87, 0x99, 0xc8, 0x44, 0x68, 0x2e, 0x93, 0xf2, 0x8b, 0xe9,
+	0x4c, 0x63, 0xaa, 0x16, 0x74, 0x71, 0x46, 0x65, 0x55, 0xe8, 0xbe, 0x7b, 0x94, 0xc4, 0x79, 0xbc,
+	0xa5, 0xd0, 0xb5, 0x56, 0x4c, 0x79, 0x5b, 0xce, 0x7f, 0x4c, 0xf0, 0xe3, 0x0d, 0xf0, 0x22, 0x8f,
+	0x35, 0x2c, 0x29, 0x15, 0xe5, 0x56, 0x13, 0x82, 0xc7, 0x57, 0x31, 0x07, 0x1b, 0x79, 0xc8, 0xb7,
+	0xd6, 0xa6, 0x26, 0x1c, 0x4f, 0x97, 0x69, 0x2a, 0x41, 0x29, 0xfb, 0xa1, 0x87, 0xfc, 0xb3, 0xe8,
+	0xfa, 0xb6, 0x76, 0x2f, 0x8f, 0x3c, 0xdd, 0x54, 0x05, 0xc8, 0x1c, 0xd2, 0x0c, 0x64, 0x98, 0x94,
+	0x52, 0x8a, 0xaf, 0x61, 0x67, 0xa1, 0xc3, 0xfd, 0xaa, 0x5d, 0x7c, 0x29, 0x38, 0xd3, 0xc0, 0x0b,
+	0x5d, 0xfd, 0xae, 0xdd, 0x73, 0x2d, 0x78, 0xfe, 0x66, 0x7e, 0x38, 0x9b, 0xaf, 0x7b, 0x0d, 0x52,
+	0xe2, 0xd9, 0x95, 0x48, 0xa1, 0x97, 0x1c, 0xfd, 0x3f, 0xc9, 0x63, 0x1d, 0xb2, 0xc1, 0xd6, 0xaa,
+	0x4c, 0x72, 0x46, 0x3f, 0x42, 0x65, 0x8f, 0x3d, 0xe4, 0xcf, 0x16, 0xe7, 0x41, 0xc7, 0x79, 0x7f,
+	0x11, 0x3d, 0x1b, 0xc2, 0x7b, 0x20, 0x22, 0xd7, 0x78, 0xba, 0xe4, 0x4d, 0xb2, 0xca, 0x9e, 0x78,
+	0x23, 0x7f, 0xb6, 0x78, 0x12, 0xf4, 0xf3, 0x88, 0xda, 0x6f, 0xf4, 0x7c, 0x57, 0xbb, 0x0f, 0x86,
+	0x25, 0xd4, 0x32, 0x91, 0xf7, 0x78, 0xb6, 0x02, 0xc9, 0x99, 0x52, 0x4c, 0x6c, 0x95, 0x7d, 0xe2,
+	0x8d, 0x7c, 0x6b, 0x98, 0xb3, 0x63, 0x1c, 0x79, 0x8d, 0x27, 0x6b, 0x91, 0x83, 0xb2, 0xa7, 0xc3,
+	0x09, 0x5a, 0x04, 0xf9, 0x80, 0xc7, 0xef, 0x44, 0x0a, 0xf6, 0xa9, 0x19, 0xce, 0x62, 0x57, 0xbb,
+	0xe8, 0xb6, 0x76, 0x5f, 0xfc, 0x7d, 0x40, 0xcd, 0xcb, 0x8b, 0x2a, 0x0d, 0x54, 0xa4, 0xb0, 0x36,
+	0x78, 0xb2, 0xc1, 0xa7, 0x9f, 0xca, 0x5c, 0x33, 0xc5, 0x32, 0xdb, 0x32, 0x99, 0x3f, 0x0d, 0x9a,
+	0xdf, 0xfa, 0xc3, 0x95, 0xc8, 0x19, 0x1d, 0x98, 0xfa, 0x3d, 0x53, 0xf4, 0x76, 0xb7, 0x77, 0xd0,
+	0xf7, 0xbd, 0x83, 0x7e, 0xee, 0x1d, 0xf4, 0xed, 0xce, 0x41, 0xbb, 0x3b, 0x07, 0x7d, 0xfe, 0x87,
+	0xbb, 0x0c, 0xb6, 0xa0, 0x98, 0x0a, 0x9b, 0x8d, 0x4b, 0x4e, 0xcc, 0x86, 0xbc, 0xfa, 0x33, 0x00,
+	0x36, 0x27, 0x7f, 0x7c, 0x8c, 0x03, 0x00, 0x00,
 }
@@ -77,6 +77,14 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 
 	privValidator := tendermint.NewPrivValidatorMemory(validatorAccount, validatorAccount)
 	keyClient := mock.NewKeyClient(keysAccounts...)
+	var transOptions []execution.TransactorOption
+	if testConfig.Execution != nil {
+		var err error
+		transOptions, err = testConfig.Execution.TransactorOptions()
+		if err != nil {
+			panic(err)
+		}
+	}
 	kernel, err := core.NewKernel(context.Background(), keyClient, privValidator,
 		testConfig.GenesisDoc,
 		testConfig.Tendermint.TendermintConfig(),
@@ -84,6 +92,7 @@ func TestKernel(validatorAccount *acm.PrivateAccount, keysAccounts []*acm.Privat
 		testConfig.Keys,
 		nil,
 		[]execution.ExecutionOption{execution.VMOptions(evm.DebugOpcodes)},
+		transOptions,
 		logger)
 	if err != nil {
 		panic(err)
@@ -171,6 +180,7 @@ func NewTestConfig(genesisDoc *genesis.GenesisDoc) *config.BurrowConfig {
 	cnf.RPC.GRPC.ListenAddress = GetLocalAddress()
 	cnf.RPC.Metrics.ListenAddress = GetTCPLocalAddress()
 	cnf.RPC.Info.ListenAddress = GetTCPLocalAddress()
+	cnf.RPC.Web3.ListenAddress = GetTCPLocalAddress()
 	cnf.Keys.RemoteAddress = ""
 	return cnf
 }
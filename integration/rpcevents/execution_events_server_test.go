@@ -149,6 +149,68 @@ func TestRevert(t *testing.T) {
 	assert.Equal(t, 0, n, "should not see reverted events")
 }
 
+func TestSubscribeResume(t *testing.T) {
+	ecli := rpctest.NewExecutionEventsClient(t, testConfig.RPC.GRPC.ListenAddress)
+	request := &rpcevents.SubscribeRequest{
+		Query: query.NewBuilder().AndEquals(event.EventTypeKey, exec.TypeAccountInput.String()).String(),
+		Start: rpcevents.AbsoluteBound(kern.Blockchain.LastBlockHeight()),
+	}
+	doSends(t, 3, rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := ecli.Subscribe(ctx, request)
+	require.NoError(t, err)
+	first, err := stream.Recv()
+	require.NoError(t, err)
+	second, err := stream.Recv()
+	require.NoError(t, err)
+	cancel()
+	assert.NotEmpty(t, first.SubscriptionID)
+	assert.Equal(t, first.SubscriptionID, second.SubscriptionID)
+
+	request.SubscriptionID = first.SubscriptionID
+	request.Cursor = first.Cursor
+	stream, err = ecli.Subscribe(context.Background(), request)
+	require.NoError(t, err)
+	resumed, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, second.Cursor, resumed.Cursor)
+	assert.Equal(t, second.Event.Header.TxHash, resumed.Event.Header.TxHash)
+	require.NoError(t, stream.CloseSend())
+}
+
+func TestGetEventsDecoded(t *testing.T) {
+	tcli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
+	ecli := rpctest.NewExecutionEventsClient(t, testConfig.RPC.GRPC.ListenAddress)
+	txe := rpctest.CreateContract(t, tcli, inputAddress, solidity.Bytecode_StrangeLoop)
+	contractAddress := txe.Receipt.ContractAddress
+	_, err := ecli.PutAbi(context.Background(), &rpcevents.ContractAbi{
+		Address: contractAddress,
+		Abi:     string(solidity.Abi_StrangeLoop),
+	})
+	require.NoError(t, err)
+	spec, err := abi.ReadAbiSpec(solidity.Abi_StrangeLoop)
+	require.NoError(t, err)
+	data, err := spec.Pack("UpsieDownsie")
+	require.NoError(t, err)
+	txe = rpctest.CallContract(t, tcli, inputAddress, contractAddress, data)
+
+	request := &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(txe.Height), rpcevents.AbsoluteBound(txe.Height)),
+		Query: query.NewBuilder().AndEquals(event.AddressKey, contractAddress).
+			AndEquals(event.EventNameKey, "ChangeLevel").AndEquals("newDepth", "18").String(),
+	}
+	responses := getEvents(t, request)
+	require.Len(t, responses, 1)
+	require.True(t, len(responses[0].Events) > 0)
+	for _, ev := range responses[0].Events {
+		require.NotNil(t, ev.Log.Decoded)
+		assert.Equal(t, "ChangeLevel", ev.Log.Decoded.EventName)
+		assert.Equal(t, "newDepth", ev.Log.Decoded.Arguments[1].Name)
+		assert.Equal(t, "18", ev.Log.Decoded.Arguments[1].Value)
+	}
+}
+
 func getEvents(t *testing.T, request *rpcevents.BlocksRequest) []*rpcevents.GetEventsResponse {
 	ecli := rpctest.NewExecutionEventsClient(t, testConfig.RPC.GRPC.ListenAddress)
 	evs, err := ecli.GetEvents(context.Background(), request)
@@ -14,6 +14,7 @@ import (
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/integration/rpctest"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -63,6 +64,26 @@ func TestGetAccount(t *testing.T) {
 	assert.Equal(t, genAcc, genAccOut)
 }
 
+func TestGetAccountWithProof(t *testing.T) {
+	cli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	address := rpctest.PrivateAccounts[2].Address()
+	accWithProof, err := cli.GetAccountWithProof(context.Background(), &rpcquery.GetAccountParam{
+		Address: address,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, accWithProof.Proof)
+	assert.NoError(t, accWithProof.Proof.VerifyAccount(accWithProof.Proof.StateHash, address,
+		accWithProof.Account.Account()))
+
+	// Absent names are proved rather than being an error
+	nameWithProof, err := cli.GetNameWithProof(context.Background(), &rpcquery.GetNameParam{
+		Name: "n'existe pas",
+	})
+	require.NoError(t, err)
+	assert.Nil(t, nameWithProof.Entry)
+	assert.NoError(t, nameWithProof.Proof.VerifyName(nameWithProof.Proof.StateHash, "n'existe pas", nil))
+}
+
 func TestListAccounts(t *testing.T) {
 	cli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
 	stream, err := cli.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{})
@@ -116,3 +137,38 @@ func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*name
 	}
 	return entries
 }
+
+func TestGetContractMeta(t *testing.T) {
+	tcli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
+	cli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	inputAddress := rpctest.PrivateAccounts[0].Address()
+	abiJSON := `[{"type":"function","name":"foo","inputs":[],"outputs":[]}]`
+	txe, err := tcli.CallTxSync(context.Background(), &payload.CallTx{
+		Input: &payload.TxInput{
+			Address: inputAddress,
+			Amount:  2,
+		},
+		Data:     []byte{0x00},
+		Fee:      2,
+		GasLimit: 10000,
+		ContractMeta: &acm.ContractMeta{
+			Abi:             abiJSON,
+			CompilerVersion: "0.4.25",
+		},
+	})
+	require.NoError(t, err)
+	require.Nil(t, txe.Exception)
+
+	meta, err := cli.GetContractMeta(context.Background(), &rpcquery.GetContractMetaParam{
+		Address: txe.Receipt.ContractAddress,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, abiJSON, meta.Abi)
+	assert.Equal(t, "0.4.25", meta.CompilerVersion)
+	assert.Equal(t, inputAddress, meta.Deployer)
+
+	_, err = cli.GetContractMeta(context.Background(), &rpcquery.GetContractMetaParam{
+		Address: inputAddress,
+	})
+	require.Error(t, err)
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/integration"
 	"github.com/hyperledger/burrow/integration/rpctest"
 	"github.com/hyperledger/burrow/logging/logconfig"
@@ -36,6 +37,7 @@ var kern *core.Kernel
 func TestMain(m *testing.M) {
 	cleanup := integration.EnterTestDirectory()
 	defer cleanup()
+	testConfig.Execution.FutureTxQueue = &execution.FutureTxQueueConfig{Enabled: true}
 	kern = integration.TestKernel(rpctest.PrivateAccounts[0], rpctest.PrivateAccounts, testConfig,
 		logconfig.New().Root(func(sink *logconfig.SinkConfig) *logconfig.SinkConfig {
 			return sink
@@ -106,6 +106,93 @@ func TestBroadcastTxLocallySigned(t *testing.T) {
 	assert.NotNil(t, txe.Events[0].Input)
 }
 
+func TestBroadcastTxOutOfOrder(t *testing.T) {
+	input := rpctest.PrivateAccounts[8]
+	qcli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	tcli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
+	ecli := rpctest.NewExecutionEventsClient(t, testConfig.RPC.GRPC.ListenAddress)
+	acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: input.Address()})
+	require.NoError(t, err)
+	sendTx := func(sequence uint64) *txs.Envelope {
+		txEnv := txs.Enclose(rpctest.GenesisDoc.ChainID(), &payload.SendTx{
+			Inputs: []*payload.TxInput{{
+				Address:  input.Address(),
+				Sequence: sequence,
+				Amount:   1,
+			}},
+			Outputs: []*payload.TxOutput{{
+				Address: rpctest.PrivateAccounts[1].Address(),
+				Amount:  1,
+			}},
+		})
+		require.NoError(t, txEnv.Sign(input))
+		return txEnv
+	}
+	second := sendTx(acc.Sequence + 2)
+	_, err = tcli.BroadcastTxAsync(context.Background(), &rpctransact.TxEnvelopeParam{Envelope: second})
+	require.NoError(t, err)
+
+	address := input.Address()
+	stream, err := tcli.ListQueuedTxs(context.Background(), &rpctransact.QueuedTxsParam{Address: &address})
+	require.NoError(t, err)
+	queued, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, second.Tx.Hash(), queued.Envelope.Tx.Hash())
+	assert.Equal(t, acc.Sequence+2, queued.Sequence)
+
+	_, err = tcli.BroadcastTxSync(context.Background(), &rpctransact.TxEnvelopeParam{Envelope: sendTx(acc.Sequence + 1)})
+	require.NoError(t, err)
+	txe, err := ecli.GetTx(context.Background(), &rpcevents.GetTxRequest{
+		TxHash: second.Tx.Hash(),
+		Wait:   true,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, txe.Exception)
+}
+
+func TestBroadcastTxBatch(t *testing.T) {
+	tcli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
+	qcli := rpctest.NewQueryClient(t, testConfig.RPC.GRPC.ListenAddress)
+	input := rpctest.PrivateAccounts[7].Address()
+	output := rpctest.PrivateAccounts[6].Address()
+	send := func(amount uint64) *rpctransact.TxEnvelopeParam {
+		return &rpctransact.TxEnvelopeParam{Payload: (&payload.SendTx{
+			Inputs:  []*payload.TxInput{{Address: input, Amount: amount}},
+			Outputs: []*payload.TxOutput{{Address: output, Amount: amount}},
+		}).Any()}
+	}
+	balance := func() uint64 {
+		acc, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: output})
+		require.NoError(t, err)
+		return acc.Balance
+	}
+	before := balance()
+
+	result, err := tcli.BroadcastTxBatch(context.Background(), &rpctransact.TxBatchParam{
+		Txs: []*rpctransact.TxEnvelopeParam{send(1), send(2), send(3)},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.TxExecutions, 3)
+	assert.Equal(t, before+6, balance())
+
+	result, err = tcli.BroadcastTxBatch(context.Background(), &rpctransact.TxBatchParam{
+		Txs:    []*rpctransact.TxEnvelopeParam{send(4), send(5)},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.TxExecutions, 1)
+	assert.Equal(t, payload.TypeBatch, result.TxExecutions[0].TxType)
+	assert.Equal(t, before+15, balance())
+
+	// The second leg overspends so neither takes effect
+	_, err = tcli.BroadcastTxBatch(context.Background(), &rpctransact.TxBatchParam{
+		Txs:    []*rpctransact.TxEnvelopeParam{send(4), send(1 << 60)},
+		Atomic: true,
+	})
+	require.Error(t, err)
+	assert.Equal(t, before+15, balance())
+}
+
 func TestFormulateTx(t *testing.T) {
 	cli := rpctest.NewTransactClient(t, testConfig.RPC.GRPC.ListenAddress)
 	txEnv, err := cli.FormulateTx(context.Background(), &payload.Any{
@@ -5,6 +5,19 @@ type KeysConfig struct {
 	AllowBadFilePermissions bool
 	RemoteAddress           string
 	KeysDirectory           string
+	// When set keys are held on a hardware security module (or other PKCS#11 token) rather than in KeysDirectory or
+	// by the keys server at RemoteAddress
+	PKCS11 *PKCS11Config `json:",omitempty" toml:",omitempty"`
+}
+
+// Locates and unlocks the PKCS#11 token holding the keys
+type PKCS11Config struct {
+	// Path to the PKCS#11 module (shared library) provided by the token vendor, such as libsofthsm2.so
+	Module string
+	// Label of the token on which the keys are held
+	TokenLabel string
+	// User PIN with which to log in to the token
+	PIN string
 }
 
 func DefaultKeysConfig() *KeysConfig {
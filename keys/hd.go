@@ -0,0 +1,168 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Hierarchical deterministic keys are derived from a seed along a derivation path using BIP-32
+// (https://github.com/bitcoin/bips/blob/master/bip-0032.mediawiki) for secp256k1 and SLIP-10
+// (https://github.com/satoshilabs/slips/blob/master/slip-0010.md) for ed25519
+
+// Indices at or above HardenedKeyStart derive hardened child keys
+const HardenedKeyStart uint32 = 0x80000000
+
+// The BIP-44 purpose and coin type (that of Ethereum, with whose wallets Burrow's secp256k1 accounts are compatible)
+// at the start of the default derivation paths
+const (
+	bip44Purpose  = 44
+	bip44CoinType = 60
+)
+
+type DerivationPath []uint32
+
+// Parses a derivation path such as m/44'/60'/0'/0/0, in which hardened indices are marked by ' or h
+func ParseDerivationPath(path string) (DerivationPath, error) {
+	elements := strings.Split(strings.TrimSpace(path), "/")
+	if elements[0] != "m" {
+		return nil, fmt.Errorf("derivation path '%s' must start with 'm'", path)
+	}
+	derivationPath := make(DerivationPath, 0, len(elements)-1)
+	for _, element := range elements[1:] {
+		var offset uint32
+		if strings.HasSuffix(element, "'") || strings.HasSuffix(element, "h") || strings.HasSuffix(element, "H") {
+			offset = HardenedKeyStart
+			element = element[:len(element)-1]
+		}
+		index, err := strconv.ParseUint(element, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyStart {
+			return nil, fmt.Errorf("derivation path '%s' contains invalid index '%s'", path, element)
+		}
+		derivationPath = append(derivationPath, uint32(index)+offset)
+	}
+	return derivationPath, nil
+}
+
+// Returns the BIP-44 path of the account with index for curveType. Since SLIP-10 ed25519 derivation only supports
+// hardened indices every index in the ed25519 path is hardened.
+func DefaultDerivationPath(curveType crypto.CurveType, index uint32) DerivationPath {
+	path := DerivationPath{bip44Purpose + HardenedKeyStart, bip44CoinType + HardenedKeyStart, HardenedKeyStart, 0,
+		index}
+	if curveType == crypto.CurveTypeEd25519 {
+		for i := range path {
+			path[i] |= HardenedKeyStart
+		}
+	}
+	return path
+}
+
+func (dp DerivationPath) String() string {
+	buf := new(bytes.Buffer)
+	buf.WriteString("m")
+	for _, index := range dp {
+		if index >= HardenedKeyStart {
+			fmt.Fprintf(buf, "/%d'", index-HardenedKeyStart)
+		} else {
+			fmt.Fprintf(buf, "/%d", index)
+		}
+	}
+	return buf.String()
+}
+
+// Derives the key of curveType at path in the tree of keys rooted at seed
+func DeriveKey(seed []byte, curveType crypto.CurveType, path DerivationPath) (*Key, error) {
+	var privateKey []byte
+	var err error
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		privateKey, err = deriveSecp256k1(seed, path)
+		if err != nil {
+			return nil, err
+		}
+	case crypto.CurveTypeEd25519:
+		privateKey, err = deriveEd25519(seed, path)
+		if err != nil {
+			return nil, err
+		}
+		// Expand the 32 byte ed25519 seed to the private key with its public key appended
+		ed25519Key, err := crypto.GeneratePrivateKey(bytes.NewReader(privateKey), crypto.CurveTypeEd25519)
+		if err != nil {
+			return nil, err
+		}
+		privateKey = ed25519Key.RawBytes()
+	default:
+		return nil, crypto.ErrInvalidCurve(curveType.String())
+	}
+	return NewKeyFromPriv(curveType, privateKey)
+}
+
+func deriveSecp256k1(seed []byte, path DerivationPath) ([]byte, error) {
+	curve := btcec.S256()
+	key, chainCode := hmacSHA512([]byte("Bitcoin seed"), seed)
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(curve.N) >= 0 {
+		return nil, fmt.Errorf("seed derives invalid secp256k1 master key")
+	}
+	for _, index := range path {
+		var data []byte
+		if index >= HardenedKeyStart {
+			data = append([]byte{0}, key...)
+		} else {
+			_, publicKey := btcec.PrivKeyFromBytes(curve, key)
+			data = publicKey.SerializeCompressed()
+		}
+		data = appendIndex(data, index)
+		var tweak []byte
+		tweak, chainCode = hmacSHA512(chainCode, data)
+		t := new(big.Int).SetBytes(tweak)
+		k.Add(k, t).Mod(k, curve.N)
+		// The probability of either is less than 1 in 2^127
+		if t.Cmp(curve.N) >= 0 || k.Sign() == 0 {
+			return nil, fmt.Errorf("derivation path %v derives invalid secp256k1 key at index %d", path, index)
+		}
+		key = paddedBytes(k, 32)
+	}
+	return key, nil
+}
+
+func deriveEd25519(seed []byte, path DerivationPath) ([]byte, error) {
+	key, chainCode := hmacSHA512([]byte("ed25519 seed"), seed)
+	for _, index := range path {
+		if index < HardenedKeyStart {
+			return nil, fmt.Errorf("derivation path %v has unhardened index %d but ed25519 keys can only be derived "+
+				"with hardened indices", path, index)
+		}
+		key, chainCode = hmacSHA512(chainCode, appendIndex(append([]byte{0}, key...), index))
+	}
+	return key, nil
+}
+
+// Returns the left and right halves of the HMAC-SHA512 of data
+func hmacSHA512(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+func appendIndex(data []byte, index uint32) []byte {
+	bs := make([]byte, 4)
+	binary.BigEndian.PutUint32(bs, index)
+	return append(data, bs...)
+}
+
+func paddedBytes(n *big.Int, length int) []byte {
+	bs := make([]byte, length)
+	nBytes := n.Bytes()
+	copy(bs[length-len(nBytes):], nBytes)
+	return bs
+}
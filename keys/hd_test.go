@@ -0,0 +1,75 @@
+package keys
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDerivationPath(t *testing.T) {
+	path, err := ParseDerivationPath("m/44'/60'/0'/0/7")
+	require.NoError(t, err)
+	assert.Equal(t, DerivationPath{44 + HardenedKeyStart, 60 + HardenedKeyStart, HardenedKeyStart, 0, 7}, path)
+	assert.Equal(t, "m/44'/60'/0'/0/7", path.String())
+	assert.Equal(t, path, DefaultDerivationPath(crypto.CurveTypeSecp256k1, 7))
+
+	path, err = ParseDerivationPath("m/0h/1H")
+	require.NoError(t, err)
+	assert.Equal(t, "m/0'/1'", path.String())
+
+	path, err = ParseDerivationPath("m")
+	require.NoError(t, err)
+	assert.Len(t, path, 0)
+
+	for _, bad := range []string{"", "44'/60'", "m/x", "m/2147483648", "m//1"} {
+		_, err = ParseDerivationPath(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+// Test vector 1 from BIP-32
+func TestDeriveKey_Secp256k1(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	for path, privateKey := range map[string]string{
+		"m":                      "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		"m/0'":                   "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		"m/0'/1":                 "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		"m/0'/1/2'":              "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca",
+		"m/0'/1/2'/2":            "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4",
+		"m/0'/1/2'/2/1000000000": "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+	} {
+		dp, err := ParseDerivationPath(path)
+		require.NoError(t, err)
+		key, err := DeriveKey(seed, crypto.CurveTypeSecp256k1, dp)
+		require.NoError(t, err)
+		assert.Equal(t, privateKey, hex.EncodeToString(key.PrivateKey.RawBytes()), path)
+	}
+}
+
+// Test vector 1 for ed25519 from SLIP-10
+func TestDeriveKey_Ed25519(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	for path, privateKey := range map[string]string{
+		"m":          "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		"m/0'":       "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		"m/0'/1'":    "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+		"m/0'/1'/2'": "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9",
+	} {
+		dp, err := ParseDerivationPath(path)
+		require.NoError(t, err)
+		key, err := DeriveKey(seed, crypto.CurveTypeEd25519, dp)
+		require.NoError(t, err)
+		assert.Equal(t, privateKey, hex.EncodeToString(key.PrivateKey.RawBytes()[:32]), path)
+		assert.Equal(t, key.PublicKey.Address(), key.Address)
+	}
+	// Only hardened derivation is possible
+	_, err = DeriveKey(seed, crypto.CurveTypeEd25519, DefaultDerivationPath(crypto.CurveTypeSecp256k1, 0))
+	assert.Error(t, err)
+	_, err = DeriveKey(seed, crypto.CurveTypeEd25519, DefaultDerivationPath(crypto.CurveTypeEd25519, 0))
+	assert.NoError(t, err)
+}
@@ -109,6 +109,7 @@ func NewKeyStore(dir string, AllowBadFilePermissions bool, logger *logging.Logge
 	return &KeyStore{
 		keysDirPath:             dir,
 		AllowBadFilePermissions: AllowBadFilePermissions,
+		unlocked:                make(map[crypto.Address]*unlockedKey),
 		logger:                  logger.With(structure.ComponentKey, "keys").WithScope("NewKeyStore"),
 	}
 }
@@ -117,7 +118,10 @@ type KeyStore struct {
 	sync.Mutex
 	AllowBadFilePermissions bool
 	keysDirPath             string
-	logger                  *logging.Logger
+	// Keys decrypted for a session by UnlockKey
+	unlockedMtx sync.Mutex
+	unlocked    map[crypto.Address]*unlockedKey
+	logger      *logging.Logger
 }
 
 func (ks *KeyStore) Gen(passphrase string, curveType crypto.CurveType) (key *Key, err error) {
@@ -0,0 +1,128 @@
+package keys
+
+import (
+	cryptoRand "crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// BIP-39 mnemonic sentences (https://github.com/bitcoin/bips/blob/master/bip-0039.mediawiki) encode entropy from which
+// the seed of a tree of hierarchical deterministic keys is derived
+
+const (
+	DefaultMnemonicBits = 256
+	// The number of bits of entropy encoded by each word of a mnemonic
+	mnemonicWordBits = 11
+	// Iterations of PBKDF2 used to stretch a mnemonic into a seed
+	mnemonicSeedIterations = 2048
+	mnemonicSeedLength     = 64
+)
+
+var mnemonicWordIndices = make(map[string]int, len(mnemonicWords))
+
+func init() {
+	for i, word := range mnemonicWords {
+		mnemonicWordIndices[word] = i
+	}
+}
+
+// Generates a new mnemonic encoding bits of random entropy, bits must be a multiple of 32 between 128 and 256
+func NewMnemonic(bits int) (string, error) {
+	err := validateEntropyBits(bits)
+	if err != nil {
+		return "", err
+	}
+	entropy := make([]byte, bits/8)
+	_, err = cryptoRand.Read(entropy)
+	if err != nil {
+		return "", err
+	}
+	return MnemonicFromEntropy(entropy)
+}
+
+// Encodes entropy and its checksum as a mnemonic sentence
+func MnemonicFromEntropy(entropy []byte) (string, error) {
+	bits := len(entropy) * 8
+	err := validateEntropyBits(bits)
+	if err != nil {
+		return "", err
+	}
+	checksumBits := uint(bits / 32)
+	checksum := sha256.Sum256(entropy)
+	// The entropy followed by the first checksumBits of its hash
+	data := new(big.Int).SetBytes(entropy)
+	data.Lsh(data, checksumBits)
+	data.Or(data, big.NewInt(int64(checksum[0]>>(8-checksumBits))))
+
+	words := make([]string, (bits+int(checksumBits))/mnemonicWordBits)
+	mask := big.NewInt(1<<mnemonicWordBits - 1)
+	index := new(big.Int)
+	for i := len(words) - 1; i >= 0; i-- {
+		words[i] = mnemonicWords[index.And(data, mask).Int64()]
+		data.Rsh(data, mnemonicWordBits)
+	}
+	return strings.Join(words, " "), nil
+}
+
+// Returns the entropy encoded by mnemonic after checking its words and checksum
+func EntropyFromMnemonic(mnemonic string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	totalBits := len(words) * mnemonicWordBits
+	bits := totalBits * 32 / 33
+	if len(words)%3 != 0 || validateEntropyBits(bits) != nil {
+		return nil, fmt.Errorf("mnemonic has %d words but must have 12, 15, 18, 21, or 24", len(words))
+	}
+	data := new(big.Int)
+	for _, word := range words {
+		index, ok := mnemonicWordIndices[word]
+		if !ok {
+			return nil, fmt.Errorf("mnemonic contains '%s', which is not in the BIP-39 English word list", word)
+		}
+		data.Lsh(data, mnemonicWordBits)
+		data.Or(data, big.NewInt(int64(index)))
+	}
+	checksumBits := uint(totalBits - bits)
+	checksum := new(big.Int).And(data, big.NewInt(1<<checksumBits-1))
+	data.Rsh(data, checksumBits)
+
+	entropy := make([]byte, bits/8)
+	dataBytes := data.Bytes()
+	copy(entropy[len(entropy)-len(dataBytes):], dataBytes)
+	hash := sha256.Sum256(entropy)
+	if checksum.Int64() != int64(hash[0]>>(8-checksumBits)) {
+		return nil, fmt.Errorf("mnemonic has invalid checksum")
+	}
+	return entropy, nil
+}
+
+// Returns an error if mnemonic is not a valid BIP-39 mnemonic
+func ValidateMnemonic(mnemonic string) error {
+	_, err := EntropyFromMnemonic(mnemonic)
+	return err
+}
+
+// Returns the 64 byte seed derived from mnemonic and the optional passphrase that protects it (which is distinct from
+// the passphrases used to encrypt keys in the key store). As BIP-39 requires both are NFKD-normalised so that the same
+// text entered with different Unicode encodings gives the same seed.
+func MnemonicToSeed(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = norm.NFKD.String(mnemonic)
+	err := ValidateMnemonic(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return pbkdf2.Key([]byte(strings.Join(strings.Fields(mnemonic), " ")),
+		[]byte(norm.NFKD.String("mnemonic"+passphrase)), mnemonicSeedIterations, mnemonicSeedLength, sha512.New), nil
+}
+
+func validateEntropyBits(bits int) error {
+	if bits < 128 || bits > 256 || bits%32 != 0 {
+		return fmt.Errorf("mnemonic entropy must be a multiple of 32 bits between 128 and 256 but %d requested", bits)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package keys
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Test vectors from https://github.com/trezor/python-mnemonic/blob/master/vectors.json
+var mnemonicVectors = []struct {
+	entropy  string
+	mnemonic string
+	seed     string
+}{
+	{
+		entropy:  "00000000000000000000000000000000",
+		mnemonic: "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		seed:     "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		entropy:  "80808080808080808080808080808080",
+		mnemonic: "letter advice cage absurd amount doctor acoustic avoid letter advice cage above",
+		seed:     "d71de856f81a8acc65e6fc851a38d4d7ec216fd0796d0a6827a3ad6ed5511a30fa280f12eb2e47ed2ac03b5c462a0358d18d69fe4f985ec81778c1b370b652a8",
+	},
+	{
+		entropy:  "7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		mnemonic: "legal winner thank year wave sausage worth useful legal winner thank year wave sausage worth useful legal will",
+		seed:     "f2b94508732bcbacbcc020faefecfc89feafa6649a5491b8c952cede496c214a0c7b3c392d168748f2d4a612bada0753b52a1c7ac53c1e93abd5c6320b9e95dd",
+	},
+	{
+		entropy:  "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		mnemonic: "zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		seed:     "dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+	},
+}
+
+func TestMnemonicVectors(t *testing.T) {
+	for _, vector := range mnemonicVectors {
+		entropy, err := hex.DecodeString(vector.entropy)
+		require.NoError(t, err)
+		mnemonic, err := MnemonicFromEntropy(entropy)
+		require.NoError(t, err)
+		assert.Equal(t, vector.mnemonic, mnemonic)
+
+		decoded, err := EntropyFromMnemonic(mnemonic)
+		require.NoError(t, err)
+		assert.Equal(t, entropy, decoded)
+
+		seed, err := MnemonicToSeed(mnemonic, "TREZOR")
+		require.NoError(t, err)
+		assert.Equal(t, vector.seed, hex.EncodeToString(seed))
+	}
+}
+
+func TestMnemonicToSeed_Normalisation(t *testing.T) {
+	mnemonic := mnemonicVectors[0].mnemonic
+	// The passphrase of the Japanese vectors from https://github.com/bip32JP/bip32JP.github.io/blob/master/test_JP_BIP39.json
+	// which contains characters that NFKD decomposes
+	passphrase := "\u334d\u30ac\u30d0\u30f4\u30a1\u3071\u3070\u3050\u309e\u3061\u3062\u5341\u4eba\u5341\u8272"
+	seed, err := MnemonicToSeed(mnemonic, passphrase)
+	require.NoError(t, err)
+	assert.Equal(t, "ba553eedefe76e67e2602dc20184c564010859faada929a090dd2c57aacb204ceefd15404ab50ef3e8dbeae5195aeae64b0def4d2eead1cdc728a33ced520ffd",
+		hex.EncodeToString(seed))
+
+	// Composed and decomposed forms of the same passphrase give the same seed
+	composed, err := MnemonicToSeed(mnemonic, "caf\u00e9")
+	require.NoError(t, err)
+	decomposed, err := MnemonicToSeed(mnemonic, "cafe\u0301")
+	require.NoError(t, err)
+	assert.Equal(t, composed, decomposed)
+	// As do compatibility forms of the mnemonic
+	fullWidth, err := MnemonicToSeed(strings.Replace(mnemonic, "about", "\uff41\uff42\uff4f\uff55\uff54", 1), "TREZOR")
+	require.NoError(t, err)
+	assert.Equal(t, mnemonicVectors[0].seed, hex.EncodeToString(fullWidth))
+}
+
+func TestNewMnemonic(t *testing.T) {
+	for _, bits := range []int{128, 160, 192, 224, 256} {
+		mnemonic, err := NewMnemonic(bits)
+		require.NoError(t, err)
+		assert.Len(t, strings.Fields(mnemonic), bits*33/32/mnemonicWordBits)
+		entropy, err := EntropyFromMnemonic(mnemonic)
+		require.NoError(t, err)
+		assert.Len(t, entropy, bits/8)
+	}
+	_, err := NewMnemonic(100)
+	assert.Error(t, err)
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	// Unknown word
+	assert.Error(t, ValidateMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+		"abandon abandon aboot"))
+	// Bad checksum
+	assert.Error(t, ValidateMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+		"abandon abandon abandon"))
+	// Wrong number of words
+	assert.Error(t, ValidateMnemonic("abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+		"abandon about"))
+	assert.NoError(t, ValidateMnemonic("  abandon abandon abandon abandon abandon abandon abandon abandon abandon "+
+		"abandon abandon about\n"))
+}
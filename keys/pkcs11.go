@@ -0,0 +1,286 @@
+package keys
+
+import (
+	"bytes"
+	cryptoRand "crypto/rand"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/logging"
+)
+
+// The PKCS#11 object classes, attributes, and mechanisms used by pkcs11KeyClient (see the PKCS#11 specification)
+const (
+	ckoPublicKey  = 0x2
+	ckoPrivateKey = 0x3
+
+	ckaClass       = 0x0
+	ckaToken       = 0x1
+	ckaPrivate     = 0x2
+	ckaLabel       = 0x3
+	ckaID          = 0x102
+	ckaSensitive   = 0x103
+	ckaSign        = 0x108
+	ckaVerify      = 0x10a
+	ckaExtractable = 0x162
+	ckaECParams    = 0x180
+	ckaECPoint     = 0x181
+
+	ckmECKeyPairGen        = 0x1040
+	ckmECDSA               = 0x1041
+	ckmECEdwardsKeyPairGen = 0x1055
+	ckmEdDSA               = 0x1057
+)
+
+// DER encoded object identifiers of the curves, used as CKA_EC_PARAMS
+var (
+	secp256k1Params = []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x0a}
+	ed25519Params   = []byte{0x06, 0x03, 0x2b, 0x65, 0x70}
+)
+
+type pkcs11Object uint
+
+type pkcs11Attribute struct {
+	Type uint
+	// A bool, uint, string, or []byte
+	Value interface{}
+}
+
+// The operations pkcs11KeyClient performs on a session with a PKCS#11 token in which the user is logged in. Sessions
+// are not safe for concurrent use.
+type pkcs11Session interface {
+	// Returns the mechanisms supported by the token
+	Mechanisms() ([]uint, error)
+	// Returns the objects whose attributes match template
+	FindObjects(template []*pkcs11Attribute) ([]pkcs11Object, error)
+	// Returns the values of the byte array attributes of object with the given types
+	GetAttributeValues(object pkcs11Object, types ...uint) (map[uint][]byte, error)
+	GenerateKeyPair(mechanism uint, public, private []*pkcs11Attribute) (publicKey, privateKey pkcs11Object, err error)
+	Sign(mechanism uint, privateKey pkcs11Object, message []byte) ([]byte, error)
+	Close() error
+}
+
+type pkcs11Key struct {
+	publicKey  crypto.PublicKey
+	privateKey pkcs11Object
+}
+
+// A KeyClient whose keys never leave a PKCS#11 token such as a hardware security module. Any secp256k1 or ed25519 key
+// pair on the token can be used, private keys are found by sharing the CKA_ID of their public keys.
+type pkcs11KeyClient struct {
+	sync.Mutex
+	session pkcs11Session
+	keys    map[crypto.Address]*pkcs11Key
+	logger  *logging.Logger
+}
+
+var _ KeyClient = (*pkcs11KeyClient)(nil)
+
+// Opens a session with the token described by conf. Burrow must be built with the pkcs11 tag for PKCS#11 support.
+func NewPKCS11KeyClient(conf *PKCS11Config, logger *logging.Logger) (KeyClient, error) {
+	session, err := openPKCS11Session(conf)
+	if err != nil {
+		return nil, err
+	}
+	return newPKCS11KeyClient(session, logger), nil
+}
+
+func newPKCS11KeyClient(session pkcs11Session, logger *logging.Logger) *pkcs11KeyClient {
+	return &pkcs11KeyClient{
+		session: session,
+		keys:    make(map[crypto.Address]*pkcs11Key),
+		logger:  logger.WithScope("PKCS11KeyClient"),
+	}
+}
+
+func (kc *pkcs11KeyClient) Sign(signAddress crypto.Address, message []byte) (crypto.Signature, error) {
+	kc.Lock()
+	defer kc.Unlock()
+	key, err := kc.key(signAddress)
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+	switch key.publicKey.CurveType {
+	case crypto.CurveTypeSecp256k1:
+		// CKM_ECDSA signs message as though it were a hash, as does btcec, and returns r and s concatenated
+		sig, err := kc.session.Sign(ckmECDSA, key.privateKey, message)
+		if err != nil {
+			return crypto.Signature{}, err
+		}
+		if len(sig) == 0 || len(sig)%2 != 0 {
+			return crypto.Signature{}, fmt.Errorf("token returned ECDSA signature of unexpected length %d", len(sig))
+		}
+		ecSig := &btcec.Signature{
+			R: new(big.Int).SetBytes(sig[:len(sig)/2]),
+			S: new(big.Int).SetBytes(sig[len(sig)/2:]),
+		}
+		return crypto.SignatureFromBytes(ecSig.Serialize(), crypto.CurveTypeSecp256k1)
+	case crypto.CurveTypeEd25519:
+		sig, err := kc.session.Sign(ckmEdDSA, key.privateKey, message)
+		if err != nil {
+			return crypto.Signature{}, err
+		}
+		return crypto.SignatureFromBytes(sig, crypto.CurveTypeEd25519)
+	default:
+		return crypto.Signature{}, crypto.ErrInvalidCurve(key.publicKey.CurveType.String())
+	}
+}
+
+func (kc *pkcs11KeyClient) PublicKey(address crypto.Address) (crypto.PublicKey, error) {
+	kc.Lock()
+	defer kc.Unlock()
+	key, err := kc.key(address)
+	if err != nil {
+		return crypto.PublicKey{}, err
+	}
+	return key.publicKey, nil
+}
+
+// Generates a non-extractable key pair on the token labelled with keyName
+func (kc *pkcs11KeyClient) Generate(keyName string, curveType crypto.CurveType) (crypto.Address, error) {
+	kc.Lock()
+	defer kc.Unlock()
+	var mechanism uint
+	var params []byte
+	switch curveType {
+	case crypto.CurveTypeSecp256k1:
+		mechanism, params = ckmECKeyPairGen, secp256k1Params
+	case crypto.CurveTypeEd25519:
+		mechanism, params = ckmECEdwardsKeyPairGen, ed25519Params
+	default:
+		return crypto.Address{}, crypto.ErrInvalidCurve(curveType.String())
+	}
+	mechanisms, err := kc.session.Mechanisms()
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	if !containsMechanism(mechanisms, mechanism) {
+		return crypto.Address{}, fmt.Errorf("PKCS#11 token does not support generating %v keys", curveType)
+	}
+	id := make([]byte, 20)
+	_, err = cryptoRand.Read(id)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	publicObject, privateObject, err := kc.session.GenerateKeyPair(mechanism,
+		[]*pkcs11Attribute{
+			{ckaToken, true},
+			{ckaVerify, true},
+			{ckaECParams, params},
+			{ckaLabel, keyName},
+			{ckaID, id},
+		},
+		[]*pkcs11Attribute{
+			{ckaToken, true},
+			{ckaPrivate, true},
+			{ckaSensitive, true},
+			{ckaExtractable, false},
+			{ckaSign, true},
+			{ckaLabel, keyName},
+			{ckaID, id},
+		})
+	if err != nil {
+		return crypto.Address{}, fmt.Errorf("could not generate %v key on PKCS#11 token: %v", curveType, err)
+	}
+	publicKey, _, err := kc.readPublicKey(publicObject)
+	if err != nil {
+		return crypto.Address{}, err
+	}
+	address := publicKey.Address()
+	kc.keys[address] = &pkcs11Key{publicKey: publicKey, privateKey: privateObject}
+	kc.logger.InfoMsg("Generated key on PKCS#11 token",
+		"key_name", keyName,
+		"curve_type", curveType,
+		"address", address)
+	return address, nil
+}
+
+func (kc *pkcs11KeyClient) HealthCheck() error {
+	kc.Lock()
+	defer kc.Unlock()
+	_, err := kc.session.Mechanisms()
+	return err
+}
+
+// Returns the key pair with address, searching the token for it if it has not been used before
+func (kc *pkcs11KeyClient) key(address crypto.Address) (*pkcs11Key, error) {
+	if key, ok := kc.keys[address]; ok {
+		return key, nil
+	}
+	publicObjects, err := kc.session.FindObjects([]*pkcs11Attribute{{ckaClass, uint(ckoPublicKey)}})
+	if err != nil {
+		return nil, err
+	}
+	for _, publicObject := range publicObjects {
+		publicKey, id, err := kc.readPublicKey(publicObject)
+		if err != nil {
+			// Not a key we can use
+			kc.logger.TraceMsg("Skipping public key on PKCS#11 token", "error", err)
+			continue
+		}
+		if publicKey.Address() != address {
+			continue
+		}
+		privateObjects, err := kc.session.FindObjects([]*pkcs11Attribute{
+			{ckaClass, uint(ckoPrivateKey)},
+			{ckaID, id},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(privateObjects) != 1 {
+			return nil, fmt.Errorf("expected one private key with CKA_ID %X for address %v on PKCS#11 token "+
+				"but found %d", id, address, len(privateObjects))
+		}
+		key := &pkcs11Key{publicKey: publicKey, privateKey: privateObjects[0]}
+		kc.keys[address] = key
+		return key, nil
+	}
+	return nil, fmt.Errorf("no key with address %v found on PKCS#11 token", address)
+}
+
+// Reads the public key and CKA_ID from publicObject
+func (kc *pkcs11KeyClient) readPublicKey(publicObject pkcs11Object) (crypto.PublicKey, []byte, error) {
+	values, err := kc.session.GetAttributeValues(publicObject, ckaECParams, ckaECPoint, ckaID)
+	if err != nil {
+		return crypto.PublicKey{}, nil, err
+	}
+	point := ecPoint(values[ckaECPoint])
+	switch params := values[ckaECParams]; {
+	case bytes.Equal(params, secp256k1Params):
+		pub, err := btcec.ParsePubKey(point, btcec.S256())
+		if err != nil {
+			return crypto.PublicKey{}, nil, err
+		}
+		publicKey, err := crypto.PublicKeyFromBytes(pub.SerializeCompressed(), crypto.CurveTypeSecp256k1)
+		return publicKey, values[ckaID], err
+	case bytes.Equal(params, ed25519Params):
+		publicKey, err := crypto.PublicKeyFromBytes(point, crypto.CurveTypeEd25519)
+		return publicKey, values[ckaID], err
+	default:
+		return crypto.PublicKey{}, nil, fmt.Errorf("unsupported curve with CKA_EC_PARAMS %X", params)
+	}
+}
+
+// CKA_EC_POINT should hold the point as a DER encoded octet string, but some tokens return it unwrapped
+func ecPoint(value []byte) []byte {
+	var point []byte
+	rest, err := asn1.Unmarshal(value, &point)
+	if err != nil || len(rest) > 0 {
+		return value
+	}
+	return point
+}
+
+func containsMechanism(mechanisms []uint, mechanism uint) bool {
+	for _, m := range mechanisms {
+		if m == mechanism {
+			return true
+		}
+	}
+	return false
+}
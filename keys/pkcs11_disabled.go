@@ -0,0 +1,10 @@
+// +build !pkcs11
+
+package keys
+
+import "fmt"
+
+func openPKCS11Session(conf *PKCS11Config) (pkcs11Session, error) {
+	return nil, fmt.Errorf("cannot use PKCS#11 module %s because burrow was built without PKCS#11 support, "+
+		"rebuild with '-tags pkcs11'", conf.Module)
+}
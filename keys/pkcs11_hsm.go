@@ -0,0 +1,158 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"fmt"
+
+	"github.com/miekg/pkcs11"
+)
+
+// The largest number of objects requested from the token at a time
+const pkcs11FindBatchSize = 100
+
+type hsmSession struct {
+	ctx     *pkcs11.Ctx
+	slot    uint
+	session pkcs11.SessionHandle
+}
+
+var _ pkcs11Session = (*hsmSession)(nil)
+
+// Loads the PKCS#11 module, opens a read-write session with the token labelled conf.TokenLabel, and logs in as user
+func openPKCS11Session(conf *PKCS11Config) (pkcs11Session, error) {
+	ctx := pkcs11.New(conf.Module)
+	if ctx == nil {
+		return nil, fmt.Errorf("could not load PKCS#11 module %s", conf.Module)
+	}
+	err := ctx.Initialize()
+	if err != nil {
+		ctx.Destroy()
+		return nil, fmt.Errorf("could not initialise PKCS#11 module %s: %v", conf.Module, err)
+	}
+	hs := &hsmSession{ctx: ctx}
+	hs.slot, err = findTokenSlot(ctx, conf.TokenLabel)
+	if err != nil {
+		hs.finalise()
+		return nil, err
+	}
+	hs.session, err = ctx.OpenSession(hs.slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		hs.finalise()
+		return nil, fmt.Errorf("could not open session with PKCS#11 token %s: %v", conf.TokenLabel, err)
+	}
+	err = ctx.Login(hs.session, pkcs11.CKU_USER, conf.PIN)
+	if err != nil && err != pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		ctx.CloseSession(hs.session)
+		hs.finalise()
+		return nil, fmt.Errorf("could not log in to PKCS#11 token %s: %v", conf.TokenLabel, err)
+	}
+	return hs, nil
+}
+
+func findTokenSlot(ctx *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return 0, err
+	}
+	for _, slot := range slots {
+		tokenInfo, err := ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, err
+		}
+		if tokenInfo.Label == tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, fmt.Errorf("no PKCS#11 token with label %s found", tokenLabel)
+}
+
+func (hs *hsmSession) Mechanisms() ([]uint, error) {
+	ms, err := hs.ctx.GetMechanismList(hs.slot)
+	if err != nil {
+		return nil, err
+	}
+	mechanisms := make([]uint, len(ms))
+	for i, m := range ms {
+		mechanisms[i] = m.Mechanism
+	}
+	return mechanisms, nil
+}
+
+func (hs *hsmSession) FindObjects(template []*pkcs11Attribute) ([]pkcs11Object, error) {
+	err := hs.ctx.FindObjectsInit(hs.session, attributes(template))
+	if err != nil {
+		return nil, err
+	}
+	var objects []pkcs11Object
+	for {
+		handles, _, err := hs.ctx.FindObjects(hs.session, pkcs11FindBatchSize)
+		if err != nil {
+			hs.ctx.FindObjectsFinal(hs.session)
+			return nil, err
+		}
+		for _, handle := range handles {
+			objects = append(objects, pkcs11Object(handle))
+		}
+		if len(handles) < pkcs11FindBatchSize {
+			break
+		}
+	}
+	return objects, hs.ctx.FindObjectsFinal(hs.session)
+}
+
+func (hs *hsmSession) GetAttributeValues(object pkcs11Object, types ...uint) (map[uint][]byte, error) {
+	template := make([]*pkcs11.Attribute, len(types))
+	for i, typ := range types {
+		template[i] = pkcs11.NewAttribute(typ, nil)
+	}
+	attrs, err := hs.ctx.GetAttributeValue(hs.session, pkcs11.ObjectHandle(object), template)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[uint][]byte, len(attrs))
+	for _, attr := range attrs {
+		values[attr.Type] = attr.Value
+	}
+	return values, nil
+}
+
+func (hs *hsmSession) GenerateKeyPair(mechanism uint, public,
+	private []*pkcs11Attribute) (pkcs11Object, pkcs11Object, error) {
+
+	publicHandle, privateHandle, err := hs.ctx.GenerateKeyPair(hs.session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)}, attributes(public), attributes(private))
+	if err != nil {
+		return 0, 0, err
+	}
+	return pkcs11Object(publicHandle), pkcs11Object(privateHandle), nil
+}
+
+func (hs *hsmSession) Sign(mechanism uint, privateKey pkcs11Object, message []byte) ([]byte, error) {
+	err := hs.ctx.SignInit(hs.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(mechanism, nil)},
+		pkcs11.ObjectHandle(privateKey))
+	if err != nil {
+		return nil, err
+	}
+	return hs.ctx.Sign(hs.session, message)
+}
+
+func (hs *hsmSession) Close() error {
+	hs.ctx.Logout(hs.session)
+	err := hs.ctx.CloseSession(hs.session)
+	hs.finalise()
+	return err
+}
+
+func (hs *hsmSession) finalise() {
+	hs.ctx.Finalize()
+	hs.ctx.Destroy()
+}
+
+func attributes(template []*pkcs11Attribute) []*pkcs11.Attribute {
+	attrs := make([]*pkcs11.Attribute, len(template))
+	for i, attr := range template {
+		attrs[i] = pkcs11.NewAttribute(attr.Type, attr.Value)
+	}
+	return attrs
+}
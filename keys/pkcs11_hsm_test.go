@@ -0,0 +1,47 @@
+// +build pkcs11
+
+package keys
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/require"
+)
+
+// Runs against a real token. `make test_pkcs11` runs it against a throwaway SoftHSM token, or against one initialised
+// by:
+//
+//   softhsm2-util --init-token --free --label burrow --pin 1234 --so-pin 1234
+//   BURROW_PKCS11_MODULE=/usr/lib/softhsm/libsofthsm2.so BURROW_PKCS11_TOKEN=burrow BURROW_PKCS11_PIN=1234 \
+//     go test -tags pkcs11 ./keys/ -run TestPKCS11KeyClient_Token
+func TestPKCS11KeyClient_Token(t *testing.T) {
+	module := os.Getenv("BURROW_PKCS11_MODULE")
+	if module == "" {
+		t.Skip("BURROW_PKCS11_MODULE not set")
+	}
+	kc, err := NewPKCS11KeyClient(&PKCS11Config{
+		Module:     module,
+		TokenLabel: os.Getenv("BURROW_PKCS11_TOKEN"),
+		PIN:        os.Getenv("BURROW_PKCS11_PIN"),
+	}, logging.NewNoopLogger())
+	require.NoError(t, err)
+	require.NoError(t, kc.HealthCheck())
+	message := sha3.Sha3([]byte("hello"))
+
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeSecp256k1, crypto.CurveTypeEd25519} {
+		address, err := kc.Generate("burrow-test", curveType)
+		if err != nil {
+			t.Logf("Skipping %v: %v", curveType, err)
+			continue
+		}
+		publicKey, err := kc.PublicKey(address)
+		require.NoError(t, err)
+		signature, err := kc.Sign(address, message)
+		require.NoError(t, err)
+		require.NoError(t, publicKey.Verify(message, signature))
+	}
+}
@@ -0,0 +1,203 @@
+package keys
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPKCS11KeyClient(t *testing.T) {
+	token := newSoftToken(ckmECKeyPairGen, ckmECDSA, ckmECEdwardsKeyPairGen, ckmEdDSA)
+	kc := newPKCS11KeyClient(token, logging.NewNoopLogger())
+	require.NoError(t, kc.HealthCheck())
+	message := sha3.Sha3([]byte("hello"))
+
+	for _, curveType := range []crypto.CurveType{crypto.CurveTypeSecp256k1, crypto.CurveTypeEd25519} {
+		address, err := kc.Generate("validator", curveType)
+		require.NoError(t, err)
+		publicKey, err := kc.PublicKey(address)
+		require.NoError(t, err)
+		assert.Equal(t, curveType, publicKey.CurveType)
+		assert.Equal(t, address, publicKey.Address())
+
+		signature, err := kc.Sign(address, message)
+		require.NoError(t, err)
+		require.NoError(t, publicKey.Verify(message, signature))
+
+		// A new client finds the key on the token
+		other := newPKCS11KeyClient(token, logging.NewNoopLogger())
+		otherPublicKey, err := other.PublicKey(address)
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, otherPublicKey)
+		signature, err = other.Sign(address, message)
+		require.NoError(t, err)
+		require.NoError(t, publicKey.Verify(message, signature))
+	}
+
+	_, err := kc.PublicKey(crypto.Address{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestPKCS11KeyClient_Unsupported(t *testing.T) {
+	token := newSoftToken(ckmECKeyPairGen, ckmECDSA)
+	kc := newPKCS11KeyClient(token, logging.NewNoopLogger())
+	_, err := kc.Generate("validator", crypto.CurveTypeEd25519)
+	assert.Error(t, err)
+	_, err = kc.Generate("validator", crypto.CurveTypeSecp256k1)
+	assert.NoError(t, err)
+}
+
+func TestECPoint(t *testing.T) {
+	point := []byte{4, 1, 2, 3}
+	wrapped, err := asn1.Marshal(point)
+	require.NoError(t, err)
+	assert.Equal(t, point, ecPoint(wrapped))
+	assert.Equal(t, point, ecPoint(point))
+}
+
+// An in-memory PKCS#11 token that signs in software
+type softToken struct {
+	mechanisms  []uint
+	objects     map[pkcs11Object][]*pkcs11Attribute
+	privateKeys map[pkcs11Object]crypto.PrivateKey
+	next        pkcs11Object
+}
+
+var _ pkcs11Session = (*softToken)(nil)
+
+func newSoftToken(mechanisms ...uint) *softToken {
+	return &softToken{
+		mechanisms:  mechanisms,
+		objects:     make(map[pkcs11Object][]*pkcs11Attribute),
+		privateKeys: make(map[pkcs11Object]crypto.PrivateKey),
+	}
+}
+
+func (st *softToken) Mechanisms() ([]uint, error) {
+	return st.mechanisms, nil
+}
+
+func (st *softToken) FindObjects(template []*pkcs11Attribute) ([]pkcs11Object, error) {
+	var objects []pkcs11Object
+	for object, attrs := range st.objects {
+		if matchAttributes(attrs, template) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+func (st *softToken) GetAttributeValues(object pkcs11Object, types ...uint) (map[uint][]byte, error) {
+	attrs, ok := st.objects[object]
+	if !ok {
+		return nil, fmt.Errorf("no object %d", object)
+	}
+	values := make(map[uint][]byte)
+	for _, attr := range attrs {
+		if bs, ok := attr.Value.([]byte); ok && containsMechanism(types, attr.Type) {
+			values[attr.Type] = bs
+		}
+	}
+	return values, nil
+}
+
+func (st *softToken) GenerateKeyPair(mechanism uint, public, private []*pkcs11Attribute) (pkcs11Object, pkcs11Object,
+	error) {
+
+	if !containsMechanism(st.mechanisms, mechanism) {
+		return 0, 0, fmt.Errorf("CKR_MECHANISM_INVALID")
+	}
+	curveType := crypto.CurveTypeSecp256k1
+	if mechanism == ckmECEdwardsKeyPairGen {
+		curveType = crypto.CurveTypeEd25519
+	}
+	privateKey, err := crypto.GeneratePrivateKey(nil, curveType)
+	if err != nil {
+		return 0, 0, err
+	}
+	point := privateKey.PublicKey
+	if curveType == crypto.CurveTypeSecp256k1 {
+		pub, err := btcec.ParsePubKey(point, btcec.S256())
+		if err != nil {
+			return 0, 0, err
+		}
+		point = pub.SerializeUncompressed()
+	}
+	ecPoint, err := asn1.Marshal(point)
+	if err != nil {
+		return 0, 0, err
+	}
+	publicObject := st.add(append(public, &pkcs11Attribute{ckaClass, uint(ckoPublicKey)},
+		&pkcs11Attribute{ckaECPoint, ecPoint}))
+	privateObject := st.add(append(private, &pkcs11Attribute{ckaClass, uint(ckoPrivateKey)}))
+	st.privateKeys[privateObject] = privateKey
+	return publicObject, privateObject, nil
+}
+
+func (st *softToken) Sign(mechanism uint, privateKey pkcs11Object, message []byte) ([]byte, error) {
+	key, ok := st.privateKeys[privateKey]
+	if !ok {
+		return nil, fmt.Errorf("no private key %d", privateKey)
+	}
+	switch mechanism {
+	case ckmECDSA:
+		priv, _ := btcec.PrivKeyFromBytes(btcec.S256(), key.RawBytes())
+		sig, err := priv.Sign(message)
+		if err != nil {
+			return nil, err
+		}
+		rs := make([]byte, 64)
+		r, s := sig.R.Bytes(), sig.S.Bytes()
+		copy(rs[32-len(r):], r)
+		copy(rs[64-len(s):], s)
+		return rs, nil
+	case ckmEdDSA:
+		sig, err := key.Sign(message)
+		if err != nil {
+			return nil, err
+		}
+		return sig.RawBytes(), nil
+	}
+	return nil, fmt.Errorf("CKR_MECHANISM_INVALID")
+}
+
+func (st *softToken) Close() error {
+	return nil
+}
+
+func (st *softToken) add(attrs []*pkcs11Attribute) pkcs11Object {
+	st.next++
+	st.objects[st.next] = attrs
+	return st.next
+}
+
+func matchAttributes(attrs, template []*pkcs11Attribute) bool {
+	for _, want := range template {
+		found := false
+		for _, attr := range attrs {
+			if attr.Type != want.Type {
+				continue
+			}
+			wantBytes, wantIsBytes := want.Value.([]byte)
+			attrBytes, attrIsBytes := attr.Value.([]byte)
+			if wantIsBytes && attrIsBytes {
+				found = bytes.Equal(wantBytes, attrBytes)
+			} else {
+				found = want.Value == attr.Value
+			}
+			break
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
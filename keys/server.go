@@ -12,6 +12,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
@@ -103,6 +104,15 @@ func (k *KeyStore) PublicKey(ctx context.Context, in *PubRequest) (*PubResponse,
 		return nil, err
 	}
 
+	var resp *PubResponse
+	unlocked, err := k.withUnlockedKey(addrB, func(key *Key) error {
+		resp = &PubResponse{CurveType: key.CurveType.String(), PublicKey: key.Pubkey()}
+		return nil
+	})
+	if unlocked {
+		return resp, err
+	}
+
 	// No phrase needed for public key. I hope.
 	key, err := k.GetKey("", addrB.Bytes())
 	if key == nil {
@@ -123,6 +133,22 @@ func (k *KeyStore) Sign(ctx context.Context, in *SignRequest) (*SignResponse, er
 		return nil, err
 	}
 
+	// An unlocked key signs without its passphrase
+	if in.GetPassphrase() == "" {
+		var resp *SignResponse
+		unlocked, err := k.withUnlockedKey(addrB, func(key *Key) error {
+			sig, err := key.Sign(in.GetMessage())
+			if err != nil {
+				return err
+			}
+			resp = &SignResponse{Signature: sig, CurveType: key.CurveType.String()}
+			return nil
+		})
+		if unlocked {
+			return resp, err
+		}
+	}
+
 	key, err := k.GetKey(in.GetPassphrase(), addrB[:])
 	if err != nil {
 		return nil, err
@@ -288,3 +314,82 @@ func (k *KeyStore) AddName(ctx context.Context, in *AddNameRequest) (*AddNameRes
 
 	return &AddNameResponse{}, coreNameAdd(k.keysDirPath, in.GetKeyname(), strings.ToUpper(in.GetAddress()))
 }
+
+func (k *KeyStore) GenerateMnemonic(ctx context.Context, in *MnemonicRequest) (*MnemonicResponse, error) {
+	bits := int(in.GetBits())
+	if bits == 0 {
+		bits = DefaultMnemonicBits
+	}
+	mnemonic, err := NewMnemonic(bits)
+	if err != nil {
+		return nil, err
+	}
+	return &MnemonicResponse{Mnemonic: mnemonic}, nil
+}
+
+func (k *KeyStore) ImportMnemonic(ctx context.Context, in *ImportMnemonicRequest) (*ImportResponse, error) {
+	curveT, err := crypto.CurveTypeFromString(in.GetCurveType())
+	if err != nil {
+		return nil, err
+	}
+	path := DefaultDerivationPath(curveT, 0)
+	if in.GetPath() != "" {
+		path, err = ParseDerivationPath(in.GetPath())
+		if err != nil {
+			return nil, err
+		}
+	}
+	seed, err := MnemonicToSeed(in.GetMnemonic(), in.GetMnemonicPassphrase())
+	if err != nil {
+		return nil, err
+	}
+	key, err := DeriveKey(seed, curveT, path)
+	if err != nil {
+		return nil, err
+	}
+
+	// store the derived key
+	if err = k.StoreKey(in.GetPassphrase(), key); err != nil {
+		return nil, err
+	}
+
+	if in.GetName() != "" {
+		if err := coreNameAdd(k.keysDirPath, in.GetName(), key.Address.String()); err != nil {
+			return nil, err
+		}
+	}
+	return &ImportResponse{Address: hex.EncodeUpperToString(key.Address[:])}, nil
+}
+
+func (k *KeyStore) UnlockKey(ctx context.Context, in *UnlockKeyRequest) (*UnlockedKey, error) {
+	addr, err := getNameAddr(k.keysDirPath, in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	addrB, err := crypto.AddressFromHexString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.startSession(in.GetPassphrase(), addrB, time.Duration(in.GetTimeout())*time.Second)
+}
+
+func (k *KeyStore) LockKey(ctx context.Context, in *LockKeyRequest) (*LockKeyResponse, error) {
+	addr, err := getNameAddr(k.keysDirPath, in.GetName(), in.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	addrB, err := crypto.AddressFromHexString(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	k.endSession(addrB)
+	return &LockKeyResponse{}, nil
+}
+
+func (k *KeyStore) ListUnlocked(ctx context.Context, in *ListUnlockedRequest) (*ListUnlockedResponse, error) {
+	return &ListUnlockedResponse{Keys: k.sessions()}, nil
+}
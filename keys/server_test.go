@@ -149,6 +149,42 @@ func TestServerHash(t *testing.T) {
 	}
 }
 
+func TestServerImportMnemonic(t *testing.T) {
+	c := grpcKeysClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	mnemonicResp, err := c.GenerateMnemonic(ctx, &MnemonicRequest{})
+	require.NoError(t, err)
+	mnemonic := mnemonicResp.GetMnemonic()
+	require.NoError(t, ValidateMnemonic(mnemonic))
+	seed, err := MnemonicToSeed(mnemonic, "")
+	require.NoError(t, err)
+
+	for _, typ := range KEY_TYPES {
+		curveType, err := crypto.CurveTypeFromString(typ)
+		require.NoError(t, err)
+		path := DefaultDerivationPath(curveType, 3)
+		key, err := DeriveKey(seed, curveType, path)
+		require.NoError(t, err)
+
+		resp, err := c.ImportMnemonic(ctx, &ImportMnemonicRequest{
+			CurveType: typ,
+			Mnemonic:  mnemonic,
+			Path:      path.String(),
+			Name:      "restored-" + typ,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, key.Address.String(), resp.GetAddress())
+
+		pubResp, err := c.PublicKey(ctx, &PubRequest{Name: "restored-" + typ})
+		require.NoError(t, err)
+		assert.Equal(t, key.Pubkey(), pubResp.GetPublicKey())
+	}
+
+	_, err = c.ImportMnemonic(ctx, &ImportMnemonicRequest{CurveType: "ed25519", Mnemonic: mnemonic + " abandon"})
+	assert.Error(t, err)
+}
+
 //---------------------------------------------------------------------------------
 
 func checkErrs(t *testing.T, errS string, err error) {
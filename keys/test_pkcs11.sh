@@ -0,0 +1,33 @@
+#! /bin/bash
+
+# Runs the PKCS#11 key client tests against a throwaway SoftHSM token
+# (needs softhsm2 installed, e.g. apt-get install softhsm2)
+
+set -e
+
+softhsm_lib=${BURROW_PKCS11_MODULE:-}
+if [ -z "$softhsm_lib" ]; then
+    for lib in /usr/lib/softhsm/libsofthsm2.so /usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so \
+        /usr/local/lib/softhsm/libsofthsm2.so; do
+        if [ -f "$lib" ]; then
+            softhsm_lib=$lib
+            break
+        fi
+    done
+fi
+if ! command -v softhsm2-util > /dev/null || [ -z "$softhsm_lib" ]; then
+    echo "FAILED dependency check: softhsm2 is missing (set BURROW_PKCS11_MODULE if libsofthsm2.so is elsewhere)"
+    exit 1
+fi
+
+test_dir="./keys/test_scratch/softhsm"
+rm -rf "$test_dir"
+mkdir -p "$test_dir/tokens"
+trap 'rm -rf "$test_dir"' EXIT
+
+export SOFTHSM2_CONF="$test_dir/softhsm2.conf"
+echo "directories.tokendir = $(cd "$test_dir/tokens" && pwd)" > "$SOFTHSM2_CONF"
+softhsm2-util --init-token --free --label burrow --pin 1234 --so-pin 1234
+
+BURROW_PKCS11_MODULE="$softhsm_lib" BURROW_PKCS11_TOKEN=burrow BURROW_PKCS11_PIN=1234 \
+    go test -v -tags pkcs11 ./keys/ -run PKCS11
@@ -0,0 +1,106 @@
+package keys
+
+import (
+	"sort"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// An encrypted key decrypted with its passphrase and held in memory for a session so that it can be used without the
+// passphrase
+type unlockedKey struct {
+	key *Key
+	// The zero time if the session lasts until the key is locked explicitly
+	expires time.Time
+	timer   *time.Timer
+}
+
+// Decrypts the key at address with passphrase and holds it in memory until timeout has passed, or until it is locked
+// if timeout is zero. Unlocking a key that is already unlocked replaces its session.
+func (ks *KeyStore) startSession(passphrase string, address crypto.Address, timeout time.Duration) (*UnlockedKey, error) {
+	// Always check the passphrase, even if the key is already unlocked
+	key, err := ks.GetKey(passphrase, address.Bytes())
+	if err != nil {
+		return nil, err
+	}
+	ks.unlockedMtx.Lock()
+	defer ks.unlockedMtx.Unlock()
+	ks.removeSession(address)
+	uk := &unlockedKey{key: key}
+	if timeout > 0 {
+		uk.expires = time.Now().Add(timeout)
+		uk.timer = time.AfterFunc(timeout, func() {
+			ks.unlockedMtx.Lock()
+			defer ks.unlockedMtx.Unlock()
+			// Only expire the session that set this timer
+			if ks.unlocked[address] == uk {
+				ks.removeSession(address)
+				ks.logger.InfoMsg("Key session expired", "address", address)
+			}
+		})
+	}
+	ks.unlocked[address] = uk
+	ks.logger.InfoMsg("Unlocked key", "address", address, "expires", uk.expires)
+	return uk.unlockedKey(address), nil
+}
+
+// Ends the session of the key at address, if any, and zeroes its decrypted private key
+func (ks *KeyStore) endSession(address crypto.Address) {
+	ks.unlockedMtx.Lock()
+	defer ks.unlockedMtx.Unlock()
+	if ks.removeSession(address) {
+		ks.logger.InfoMsg("Locked key", "address", address)
+	}
+}
+
+// Returns the keys that are unlocked ordered by address
+func (ks *KeyStore) sessions() []*UnlockedKey {
+	ks.unlockedMtx.Lock()
+	defer ks.unlockedMtx.Unlock()
+	keys := make([]*UnlockedKey, 0, len(ks.unlocked))
+	for address, uk := range ks.unlocked {
+		keys = append(keys, uk.unlockedKey(address))
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Address < keys[j].Address
+	})
+	return keys
+}
+
+// Calls fn with the key at address, holding the session lock so that the key cannot be locked in the meantime, if the
+// key is unlocked and returns whether it was. The key is used in place rather than copied so fn must not retain it or
+// any part of its private key.
+func (ks *KeyStore) withUnlockedKey(address crypto.Address, fn func(key *Key) error) (bool, error) {
+	ks.unlockedMtx.Lock()
+	defer ks.unlockedMtx.Unlock()
+	uk, ok := ks.unlocked[address]
+	if !ok {
+		return false, nil
+	}
+	return true, fn(uk.key)
+}
+
+// Zeroes and forgets the key at address returning whether it was unlocked, must hold unlockedMtx
+func (ks *KeyStore) removeSession(address crypto.Address) bool {
+	uk, ok := ks.unlocked[address]
+	if !ok {
+		return false
+	}
+	if uk.timer != nil {
+		uk.timer.Stop()
+	}
+	privateKey := uk.key.PrivateKey.PrivateKey
+	for i := range privateKey {
+		privateKey[i] = 0
+	}
+	delete(ks.unlocked, address)
+	return true
+}
+
+func (uk *unlockedKey) unlockedKey(address crypto.Address) *UnlockedKey {
+	return &UnlockedKey{
+		Address: address.String(),
+		Expires: uk.expires,
+	}
+}
@@ -0,0 +1,72 @@
+package keys
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStore_UnlockKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-keys-unlock")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ks := NewKeyStore(dir, false, logging.NewNoopLogger())
+	ctx := context.Background()
+	key, err := ks.Gen("secret", crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	address := key.Address.String()
+	message := sha3.Sha3([]byte("hello"))
+
+	// Encrypted keys need their passphrase until unlocked
+	_, err = ks.Sign(ctx, &SignRequest{Address: address, Message: message})
+	require.Error(t, err)
+	_, err = ks.UnlockKey(ctx, &UnlockKeyRequest{Address: address, Passphrase: "wrong"})
+	require.Error(t, err)
+
+	unlocked, err := ks.UnlockKey(ctx, &UnlockKeyRequest{Address: address, Passphrase: "secret"})
+	require.NoError(t, err)
+	assert.Equal(t, address, unlocked.Address)
+	assert.True(t, unlocked.Expires.IsZero())
+	resp, err := ks.Sign(ctx, &SignRequest{Address: address, Message: message})
+	require.NoError(t, err)
+	signature, err := crypto.SignatureFromBytes(resp.Signature, crypto.CurveTypeSecp256k1)
+	require.NoError(t, err)
+	require.NoError(t, key.PublicKey.Verify(message, signature))
+	pub, err := ks.PublicKey(ctx, &PubRequest{Address: address})
+	require.NoError(t, err)
+	assert.Equal(t, key.Pubkey(), pub.PublicKey)
+
+	list, err := ks.ListUnlocked(ctx, &ListUnlockedRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*UnlockedKey{unlocked}, list.Keys)
+
+	// Locking zeroes the decrypted key
+	privateKey := ks.unlocked[key.Address].key.PrivateKey.PrivateKey
+	_, err = ks.LockKey(ctx, &LockKeyRequest{Address: address})
+	require.NoError(t, err)
+	assert.Equal(t, make([]byte, len(privateKey)), []byte(privateKey))
+	_, err = ks.Sign(ctx, &SignRequest{Address: address, Message: message})
+	require.Error(t, err)
+	list, err = ks.ListUnlocked(ctx, &ListUnlockedRequest{})
+	require.NoError(t, err)
+	assert.Len(t, list.Keys, 0)
+
+	// Sessions expire
+	unlocked, err = ks.UnlockKey(ctx, &UnlockKeyRequest{Address: address, Passphrase: "secret", Timeout: 1})
+	require.NoError(t, err)
+	assert.False(t, unlocked.Expires.IsZero())
+	_, err = ks.Sign(ctx, &SignRequest{Address: address, Message: message})
+	require.NoError(t, err)
+	time.Sleep(1500 * time.Millisecond)
+	_, err = ks.Sign(ctx, &SignRequest{Address: address, Message: message})
+	require.Error(t, err)
+	assert.Len(t, ks.sessions(), 0)
+}
@@ -11,6 +11,7 @@ type RPCConfig struct {
 	Profiler *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
+	Web3     *ServerConfig  `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
@@ -41,6 +42,7 @@ func DefaultRPCConfig() *RPCConfig {
 		Profiler: DefaultProfilerConfig(),
 		GRPC:     DefaultGRPCConfig(),
 		Metrics:  DefaultMetricsConfig(),
+		Web3:     DefaultWeb3Config(),
 	}
 }
 
@@ -73,3 +75,10 @@ func DefaultMetricsConfig() *MetricsConfig {
 		BlockSampleSize: 100,
 	}
 }
+
+func DefaultWeb3Config() *ServerConfig {
+	return &ServerConfig{
+		Enabled:       false,
+		ListenAddress: fmt.Sprintf("tcp://%s:8545", localhost),
+	}
+}
@@ -0,0 +1,73 @@
+package rpcevents
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+const cursorLength = 3 * 8
+
+// The position of an event in the chain: the height of its block, the index of its transaction within the block, and
+// its index within the transaction. Cursors are ordered by their position.
+type Cursor struct {
+	Height     uint64
+	TxIndex    uint64
+	EventIndex uint64
+}
+
+// Encodes the cursor as fixed-width big-endian integers so that byte order matches position order
+func (c Cursor) Bytes() []byte {
+	bs := make([]byte, cursorLength)
+	binary.BigEndian.PutUint64(bs, c.Height)
+	binary.BigEndian.PutUint64(bs[8:], c.TxIndex)
+	binary.BigEndian.PutUint64(bs[16:], c.EventIndex)
+	return bs
+}
+
+func CursorFromBytes(bs []byte) (Cursor, error) {
+	if len(bs) != cursorLength {
+		return Cursor{}, fmt.Errorf("cursor should be %d bytes long but is %d bytes", cursorLength, len(bs))
+	}
+	return Cursor{
+		Height:     binary.BigEndian.Uint64(bs),
+		TxIndex:    binary.BigEndian.Uint64(bs[8:]),
+		EventIndex: binary.BigEndian.Uint64(bs[16:]),
+	}, nil
+}
+
+// Whether c is positioned after other
+func (c Cursor) After(other Cursor) bool {
+	if c.Height != other.Height {
+		return c.Height > other.Height
+	}
+	if c.TxIndex != other.TxIndex {
+		return c.TxIndex > other.TxIndex
+	}
+	return c.EventIndex > other.EventIndex
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("Cursor{Height: %d, TxIndex: %d, EventIndex: %d}", c.Height, c.TxIndex, c.EventIndex)
+}
+
+// Calls consumer with each event of be from a transaction that did not fail, along with the event's cursor
+func forEachEvent(be *exec.BlockExecution, consumer func(Cursor, *exec.Event) error) error {
+	for txIndex, txe := range be.TxExecutions {
+		if txe.Exception != nil {
+			continue
+		}
+		for eventIndex, ev := range txe.Events {
+			err := consumer(Cursor{
+				Height:     be.Height,
+				TxIndex:    uint64(txIndex),
+				EventIndex: uint64(eventIndex),
+			}, ev)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := Cursor{Height: 3, TxIndex: 1, EventIndex: 258}
+	decoded, err := CursorFromBytes(cursor.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+	_, err = CursorFromBytes([]byte{1, 2})
+	assert.Error(t, err)
+
+	assert.True(t, cursor.After(Cursor{Height: 3, TxIndex: 1, EventIndex: 257}))
+	assert.True(t, cursor.After(Cursor{Height: 2, TxIndex: 4, EventIndex: 300}))
+	assert.False(t, cursor.After(cursor))
+	assert.False(t, cursor.After(Cursor{Height: 3, TxIndex: 2}))
+}
+
+func TestForEachEvent(t *testing.T) {
+	be := &exec.BlockExecution{
+		Height: 7,
+		TxExecutions: []*exec.TxExecution{
+			{Events: []*exec.Event{{}, {}}},
+			{Events: []*exec.Event{{}}, Exception: errors.AsException(errors.ErrorCodeExecutionReverted)},
+			{Events: []*exec.Event{{}}},
+		},
+	}
+	var cursors []Cursor
+	err := forEachEvent(be, func(cursor Cursor, ev *exec.Event) error {
+		cursors = append(cursors, cursor)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Cursor{
+		{Height: 7, TxIndex: 0, EventIndex: 0},
+		{Height: 7, TxIndex: 0, EventIndex: 1},
+		{Height: 7, TxIndex: 2, EventIndex: 0},
+	}, cursors)
+}
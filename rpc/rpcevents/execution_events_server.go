@@ -2,14 +2,20 @@ package rpcevents
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"sync"
 
 	"io"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
 	"google.golang.org/grpc"
@@ -26,22 +32,54 @@ type Provider interface {
 	GetBlocks(startHeight, endHeight uint64, consumer func(*exec.BlockExecution) (stop bool)) (stopped bool, err error)
 }
 
+// Optionally implemented by a Provider that maintains an index of events
+type IndexedProvider interface {
+	Provider
+	// Like GetBlocks but may skip blocks that cannot contain events having all of the addresses and topics of terms
+	GetBlocksIndexed(startHeight, endHeight uint64, terms *exec.IndexTerms,
+		consumer func(*exec.BlockExecution) (stop bool)) (stopped bool, err error)
+}
+
+type blockIterator func(startHeight, endHeight uint64, consumer func(*exec.BlockExecution) (stop bool)) (stopped bool,
+	err error)
+
+type Replayer interface {
+	// Re-execute a committed transaction against the state prior to it with vmOptions applied
+	ReplayTx(txHash []byte, vmOptions ...func(*evm.VM)) (*exec.TxExecution, error)
+}
+
 type executionEventsServer struct {
 	eventsProvider Provider
+	replayer       Replayer
 	subscribable   event.Subscribable
 	tip            bcm.BlockchainInfo
 	logger         *logging.Logger
+	// Nil unless eventsProvider is also an AbiRegistry
+	decoder *logDecoder
+	// Open subscriptions by SubscriptionID
+	subscriptions map[string]*subscription
+	sync.Mutex
+}
+
+type subscription struct {
+	cancel context.CancelFunc
 }
 
-func NewExecutionEventsServer(eventsProvider Provider, subscribable event.Subscribable,
+func NewExecutionEventsServer(eventsProvider Provider, replayer Replayer, subscribable event.Subscribable,
 	tip bcm.BlockchainInfo, logger *logging.Logger) ExecutionEventsServer {
 
-	return &executionEventsServer{
+	ees := &executionEventsServer{
 		eventsProvider: eventsProvider,
+		replayer:       replayer,
 		subscribable:   subscribable,
 		tip:            tip,
 		logger:         logger.WithScope("NewExecutionEventsServer"),
+		subscriptions:  make(map[string]*subscription),
+	}
+	if registry, ok := eventsProvider.(AbiRegistry); ok {
+		ees.decoder = newLogDecoder(registry, ees.logger)
 	}
+	return ees
 }
 
 func (ees *executionEventsServer) GetBlock(ctx context.Context, request *GetBlockRequest) (*exec.BlockExecution, error) {
@@ -60,13 +98,14 @@ func (ees *executionEventsServer) GetBlock(ctx context.Context, request *GetBloc
 		return nil, fmt.Errorf("block at height %v not found in state but should have been! (last block height: %v)",
 			request.Height, ees.tip.LastBlockHeight())
 	}
-	err = ees.streamBlocks(ctx, &BlockRange{End: StreamBound()}, func(block *exec.BlockExecution) error {
-		if block.Height == request.Height {
-			be = block
-			return io.EOF
-		}
-		return nil
-	})
+	err = ees.streamBlocks(ctx, &BlockRange{End: StreamBound()}, ees.eventsProvider.GetBlocks,
+		func(block *exec.BlockExecution) error {
+			if block.Height == request.Height {
+				be = block
+				return io.EOF
+			}
+			return nil
+		})
 	if err != io.EOF {
 		return nil, err
 	}
@@ -78,12 +117,13 @@ func (ees *executionEventsServer) GetBlocks(request *BlocksRequest, stream Execu
 	if err != nil {
 		return fmt.Errorf("could not parse BlockExecution query: %v", err)
 	}
-	return ees.streamBlocks(stream.Context(), request.BlockRange, func(block *exec.BlockExecution) error {
-		if qry.Matches(block.Tagged()) {
-			return flush(stream, block)
-		}
-		return nil
-	})
+	return ees.streamBlocks(stream.Context(), request.BlockRange, ees.eventsProvider.GetBlocks,
+		func(block *exec.BlockExecution) error {
+			if qry.Matches(block.Tagged()) {
+				return flush(stream, block)
+			}
+			return nil
+		})
 }
 
 func (ees *executionEventsServer) GetTx(ctx context.Context, request *GetTxRequest) (*exec.TxExecution, error) {
@@ -114,22 +154,32 @@ func (ees *executionEventsServer) GetTx(ctx context.Context, request *GetTxReque
 	return nil, fmt.Errorf("subscription waiting for tx %v ended prematurely", request.TxHash)
 }
 
+func (ees *executionEventsServer) TraceTx(ctx context.Context, request *TraceTxRequest) (*exec.Trace, error) {
+	tracer := evm.NewStructLogger(request.TraceConfig)
+	_, err := ees.replayer.ReplayTx(request.TxHash, evm.TraceWith(tracer))
+	if err != nil {
+		return nil, fmt.Errorf("could not replay transaction %v: %v", request.TxHash, err)
+	}
+	return tracer.Trace(), nil
+}
+
 func (ees *executionEventsServer) GetTxs(request *BlocksRequest, stream ExecutionEvents_GetTxsServer) error {
 	qry, err := query.NewBuilder(request.Query).Query()
 	if err != nil {
 		return fmt.Errorf("could not parse TxExecution query: %v", err)
 	}
-	return ees.streamBlocks(stream.Context(), request.BlockRange, func(block *exec.BlockExecution) error {
-		txs := filterTxs(block, qry)
-		if len(txs) > 0 {
-			response := &GetTxsResponse{
-				Height:       block.Height,
-				TxExecutions: txs,
+	return ees.streamBlocks(stream.Context(), request.BlockRange, ees.eventsProvider.GetBlocks,
+		func(block *exec.BlockExecution) error {
+			txs := filterTxs(block, qry)
+			if len(txs) > 0 {
+				response := &GetTxsResponse{
+					Height:       block.Height,
+					TxExecutions: txs,
+				}
+				return flush(stream, response)
 			}
-			return flush(stream, response)
-		}
-		return nil
-	})
+			return nil
+		})
 }
 
 func (ees *executionEventsServer) GetEvents(request *BlocksRequest, stream ExecutionEvents_GetEventsServer) error {
@@ -137,20 +187,130 @@ func (ees *executionEventsServer) GetEvents(request *BlocksRequest, stream Execu
 	if err != nil {
 		return fmt.Errorf("could not parse Event query: %v", err)
 	}
-	return ees.streamBlocks(stream.Context(), request.BlockRange, func(block *exec.BlockExecution) error {
-		evs := filterEvents(block, qry)
-		if len(evs) == 0 {
-			return nil
+	return ees.streamBlocks(stream.Context(), request.BlockRange, ees.eventBlocks(qry),
+		func(block *exec.BlockExecution) error {
+			evs := ees.filterEvents(block, qry)
+			if len(evs) == 0 {
+				return nil
+			}
+			response := &GetEventsResponse{
+				Height: block.Height,
+				Events: evs,
+			}
+			return flush(stream, response)
+		})
+}
+
+func (ees *executionEventsServer) Subscribe(request *SubscribeRequest, stream ExecutionEvents_SubscribeServer) error {
+	qry, err := query.NewBuilder(request.Query).Query()
+	if err != nil {
+		return fmt.Errorf("could not parse Event query: %v", err)
+	}
+	start := request.Start
+	var resumeAfter *Cursor
+	if len(request.Cursor) > 0 {
+		cursor, err := CursorFromBytes(request.Cursor)
+		if err != nil {
+			return fmt.Errorf("could not resume subscription: %v", err)
 		}
-		response := &GetEventsResponse{
-			Height: block.Height,
-			Events: evs,
+		resumeAfter = &cursor
+		start = AbsoluteBound(cursor.Height)
+	}
+	subID := request.SubscriptionID
+	if subID == "" {
+		subID = event.GenSubID()
+	}
+	ctx, closeSubscription := ees.openSubscription(stream.Context(), subID)
+	defer closeSubscription()
+
+	err = ees.streamBlocks(ctx, NewBlockRange(start, StreamBound()), ees.eventBlocks(qry),
+		func(block *exec.BlockExecution) error {
+			return forEachEvent(block, func(cursor Cursor, ev *exec.Event) error {
+				if resumeAfter != nil && !cursor.After(*resumeAfter) {
+					return nil
+				}
+				ev = ees.decoder.decode(ev)
+				if !qry.Matches(ev.Tagged()) {
+					return nil
+				}
+				return flush(stream, &SubscribeResponse{
+					SubscriptionID: subID,
+					Cursor:         cursor.Bytes(),
+					Event:          ev,
+				})
+			})
+		})
+	if err == context.Canceled && stream.Context().Err() == nil {
+		return fmt.Errorf("subscription %s closed by another subscription with the same ID", subID)
+	}
+	return err
+}
+
+func (ees *executionEventsServer) PutAbi(ctx context.Context, request *ContractAbi) (*ContractAbi, error) {
+	if ees.decoder == nil {
+		return nil, fmt.Errorf("this node does not hold contract ABIs")
+	}
+	err := ees.decoder.registry.PutAbi(request.Address, request.Abi)
+	if err != nil {
+		return nil, err
+	}
+	ees.decoder.forget(request.Address)
+	return request, nil
+}
+
+func (ees *executionEventsServer) GetAbi(ctx context.Context, request *GetAbiRequest) (*ContractAbi, error) {
+	if ees.decoder == nil {
+		return nil, fmt.Errorf("this node does not hold contract ABIs")
+	}
+	abiJSON, err := ees.decoder.registry.GetAbi(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if abiJSON == "" {
+		return nil, fmt.Errorf("no ABI stored for contract %v", request.Address)
+	}
+	return &ContractAbi{
+		Address: request.Address,
+		Abi:     abiJSON,
+	}, nil
+}
+
+// Registers a subscription under subID, closing any subscription already open under it. Returns a context that is
+// cancelled if the subscription is closed in this way, and a function to call once the subscription has finished.
+func (ees *executionEventsServer) openSubscription(ctx context.Context, subID string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &subscription{cancel: cancel}
+	ees.Lock()
+	defer ees.Unlock()
+	if open, ok := ees.subscriptions[subID]; ok {
+		open.cancel()
+	}
+	ees.subscriptions[subID] = sub
+	return ctx, func() {
+		cancel()
+		ees.Lock()
+		defer ees.Unlock()
+		// The subscription may already have been replaced
+		if ees.subscriptions[subID] == sub {
+			delete(ees.subscriptions, subID)
 		}
-		return flush(stream, response)
-	})
+	}
+}
+
+// Returns an iterator over the blocks that may contain events matching qry, which will use the event index if the
+// Provider has one and qry constrains an address or log topic
+func (ees *executionEventsServer) eventBlocks(qry query.Query) blockIterator {
+	indexed, ok := ees.eventsProvider.(IndexedProvider)
+	if !ok {
+		return ees.eventsProvider.GetBlocks
+	}
+	terms := indexTerms(qry)
+	return func(startHeight, endHeight uint64, consumer func(*exec.BlockExecution) (stop bool)) (bool, error) {
+		return indexed.GetBlocksIndexed(startHeight, endHeight, terms, consumer)
+	}
 }
 
-func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *BlockRange,
+func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *BlockRange, getBlocks blockIterator,
 	consumer func(*exec.BlockExecution) error) error {
 
 	// Converts the bounds to half-open interval needed
@@ -159,7 +319,7 @@ func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *
 
 	// Pull blocks from state and receive the upper bound (exclusive) on the what we were able to send
 	// Set this to start since it will be the start of next streaming batch (if needed)
-	start, err := ees.iterateBlocks(start, end, consumer)
+	start, err := ees.iterateBlocks(start, end, getBlocks, consumer)
 
 	// If we are not streaming and all blocks requested were retrieved from state then we are done
 	if !streaming && start == end {
@@ -176,11 +336,14 @@ func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *
 	}
 	defer ees.subscribable.UnsubscribeAll(context.Background(), subID)
 
-	for msg := range out {
+	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		default:
+		case msg, ok := <-out:
+			if !ok {
+				return nil
+			}
 			block := msg.(*exec.BlockExecution)
 			streamEnd := block.Height
 
@@ -194,7 +357,7 @@ func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *
 				// we have not emitted so we will pull them from state. This can occur if a block is emitted during/after
 				// the initial streaming but before we have subscribed to block events or if we spill BlockExecutions
 				// when streaming them and need to catch up
-				_, err := ees.iterateBlocks(start, streamEnd, consumer)
+				_, err := ees.iterateBlocks(start, streamEnd, getBlocks, consumer)
 				if err != nil {
 					return err
 				}
@@ -210,17 +373,16 @@ func (ees *executionEventsServer) streamBlocks(ctx context.Context, blockRange *
 			start = block.Height + 1
 		}
 	}
-
-	return nil
 }
 
 // Converts blocks into responses and streams them returning the height one greater than the last seen block
 // that can be used as next start point (half-open interval)
-func (ees *executionEventsServer) iterateBlocks(start, end uint64, consumer func(*exec.BlockExecution) error) (uint64, error) {
+func (ees *executionEventsServer) iterateBlocks(start, end uint64, getBlocks blockIterator,
+	consumer func(*exec.BlockExecution) error) (uint64, error) {
 	var streamErr error
 	var lastHeightSeen uint64
 
-	_, err := ees.eventsProvider.GetBlocks(start, end,
+	stopped, err := getBlocks(start, end,
 		func(be *exec.BlockExecution) (stop bool) {
 			lastHeightSeen = be.Height
 			streamErr = consumer(be)
@@ -237,7 +399,53 @@ func (ees *executionEventsServer) iterateBlocks(start, end uint64, consumer func
 		return 0, streamErr
 	}
 	// Returns the appropriate starting block for the next stream
-	return lastHeightSeen + 1, nil
+	next := lastHeightSeen + 1
+	if !stopped {
+		// An indexed iteration may skip blocks, but if it ran to completion it has covered those up to the tip
+		covered := ees.tip.LastBlockHeight() + 1
+		if covered > end {
+			covered = end
+		}
+		if next < covered {
+			next = covered
+		}
+		if next < start {
+			next = start
+		}
+	}
+	return next, nil
+}
+
+// Extracts the addresses and log topics that the events matched by qry must have. Only conditions that the event index
+// can answer exactly are used.
+func indexTerms(qry query.Query) *exec.IndexTerms {
+	terms := new(exec.IndexTerms)
+	conditional, ok := qry.(interface {
+		Conditions() []query.Condition
+	})
+	if !ok {
+		return terms
+	}
+	for _, condition := range conditional.Conditions() {
+		operand, ok := condition.Operand.(string)
+		if !ok || condition.Op != query.OpEqual {
+			continue
+		}
+		switch {
+		case condition.Tag == event.AddressKey:
+			address, err := crypto.AddressFromHexString(operand)
+			if err == nil {
+				terms.Addresses = append(terms.Addresses, address)
+			}
+		case strings.HasPrefix(condition.Tag, exec.LogNKeyPrefix) && len(condition.Tag) == len(exec.LogNKey(0)):
+			topic, err := hex.DecodeString(operand)
+			// Absent topics read as zero so a zero topic may match logs that are not indexed under it
+			if err == nil && len(topic) == binary.Word256Length && !binary.IsZeros(topic) {
+				terms.Topics = append(terms.Topics, binary.LeftPadWord256(topic))
+			}
+		}
+	}
+	return terms
 }
 
 func filterTxs(be *exec.BlockExecution, qry query.Query) []*exec.TxExecution {
@@ -250,11 +458,12 @@ func filterTxs(be *exec.BlockExecution, qry query.Query) []*exec.TxExecution {
 	return txs
 }
 
-func filterEvents(be *exec.BlockExecution, qry query.Query) []*exec.Event {
+func (ees *executionEventsServer) filterEvents(be *exec.BlockExecution, qry query.Query) []*exec.Event {
 	var evs []*exec.Event
 	for _, txe := range be.TxExecutions {
 		if txe.Exception == nil {
 			for _, ev := range txe.Events {
+				ev = ees.decoder.decode(ev)
 				if qry.Matches(ev.Tagged()) {
 					evs = append(evs, ev)
 				}
@@ -0,0 +1,100 @@
+package rpcevents
+
+import (
+	"sync"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+// Optionally implemented by a Provider that holds the ABIs of contracts with which to decode their logs
+type AbiRegistry interface {
+	// Returns the empty string if no ABI is held for address
+	GetAbi(address crypto.Address) (string, error)
+	PutAbi(address crypto.Address, abiJSON string) error
+}
+
+// Decodes logs with the ABIs of an AbiRegistry, which are parsed on first use
+type logDecoder struct {
+	registry AbiRegistry
+	specs    map[crypto.Address]*abi.AbiSpec
+	logger   *logging.Logger
+	sync.Mutex
+}
+
+func newLogDecoder(registry AbiRegistry, logger *logging.Logger) *logDecoder {
+	return &logDecoder{
+		registry: registry,
+		specs:    make(map[crypto.Address]*abi.AbiSpec),
+		logger:   logger,
+	}
+}
+
+// Returns ev with its log decoded if it is a log of a contract with a known ABI that declares the logged event,
+// otherwise returns ev as is. Events may be shared so ev is never modified, instead a decoded copy is returned.
+func (ld *logDecoder) decode(ev *exec.Event) *exec.Event {
+	if ld == nil || ev.Log == nil || len(ev.Log.Topics) == 0 {
+		return ev
+	}
+	spec := ld.spec(ev.Log.Address)
+	if spec == nil {
+		return ev
+	}
+	eventSpec, ok := spec.EventsByID[ev.Log.Topics[0]]
+	if !ok {
+		return ev
+	}
+	vars, err := abi.UnpackEventVariables(eventSpec, ev.Log.Topics, ev.Log.Data)
+	if err != nil {
+		ld.logger.TraceMsg("Could not decode log", "address", ev.Log.Address, "event_name", eventSpec.Name,
+			structure.ErrorKey, err)
+		return ev
+	}
+	decoded := &exec.DecodedLog{
+		EventName: eventSpec.Name,
+		Arguments: make([]*exec.EventArgument, len(vars)),
+	}
+	for i, v := range vars {
+		decoded.Arguments[i] = &exec.EventArgument{
+			Name:  v.Name,
+			Type:  eventSpec.Inputs[i].TypeSignature(),
+			Value: v.Value,
+		}
+	}
+	log := *ev.Log
+	log.Decoded = decoded
+	decodedEv := *ev
+	decodedEv.Log = &log
+	return &decodedEv
+}
+
+// Forgets any ABI parsed for address so that the registry is consulted again
+func (ld *logDecoder) forget(address crypto.Address) {
+	ld.Lock()
+	defer ld.Unlock()
+	delete(ld.specs, address)
+}
+
+func (ld *logDecoder) spec(address crypto.Address) *abi.AbiSpec {
+	ld.Lock()
+	defer ld.Unlock()
+	spec, ok := ld.specs[address]
+	if ok {
+		return spec
+	}
+	abiJSON, err := ld.registry.GetAbi(address)
+	if err != nil || abiJSON == "" {
+		// Absent ABIs are not remembered since they may be added at any time
+		return nil
+	}
+	spec, err = abi.ReadAbiSpec([]byte(abiJSON))
+	if err != nil {
+		ld.logger.InfoMsg("Could not read ABI from registry", "address", address, structure.ErrorKey, err)
+		return nil
+	}
+	ld.specs[address] = spec
+	return spec
+}
@@ -0,0 +1,64 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAbi = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"to","type":"address"},
+{"indexed":false,"name":"value","type":"uint256"}],"name":"Transfer","type":"event"}]`
+
+func TestLogDecoder(t *testing.T) {
+	contract := crypto.Address{1}
+	to := crypto.Address{2}
+	registry := abiMap{}
+	decoder := newLogDecoder(registry, logging.NewNoopLogger())
+
+	spec, err := abi.ReadAbiSpec([]byte(testAbi))
+	require.NoError(t, err)
+	data, err := abi.Pack(spec.Events["Transfer"].Inputs[1:], uint64(42))
+	require.NoError(t, err)
+	ev := &exec.Event{
+		Header: &exec.Header{EventType: exec.TypeLog},
+		Log: &exec.LogEvent{
+			Address: contract,
+			Topics:  []binary.Word256{spec.Events["Transfer"].EventID, binary.LeftPadWord256(to.Bytes())},
+			Data:    data,
+		},
+	}
+	assert.Equal(t, ev, decoder.decode(ev), "should not decode without ABI")
+
+	require.NoError(t, registry.PutAbi(contract, testAbi))
+	decoded := decoder.decode(ev)
+	assert.Nil(t, ev.Log.Decoded, "should not modify event")
+	assert.Equal(t, &exec.DecodedLog{
+		EventName: "Transfer",
+		Arguments: []*exec.EventArgument{
+			{Name: "to", Type: "address", Value: to.String()},
+			{Name: "value", Type: "uint256", Value: "42"},
+		},
+	}, decoded.Log.Decoded)
+
+	registry[contract] = `[]`
+	assert.NotNil(t, decoder.decode(ev).Log.Decoded, "should use parsed ABI")
+	decoder.forget(contract)
+	assert.Nil(t, decoder.decode(ev).Log.Decoded)
+}
+
+type abiMap map[crypto.Address]string
+
+func (am abiMap) GetAbi(address crypto.Address) (string, error) {
+	return am[address], nil
+}
+
+func (am abiMap) PutAbi(address crypto.Address, abiJSON string) error {
+	am[address] = abiJSON
+	return nil
+}
@@ -10,7 +10,12 @@
 	It has these top-level messages:
 		GetBlockRequest
 		GetTxRequest
+		TraceTxRequest
 		BlocksRequest
+		SubscribeRequest
+		SubscribeResponse
+		ContractAbi
+		GetAbiRequest
 		GetEventsResponse
 		GetTxsResponse
 		Bound
@@ -26,6 +31,7 @@ import _ "github.com/gogo/protobuf/gogoproto"
 import exec "github.com/hyperledger/burrow/execution/exec"
 
 import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
+import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -77,7 +83,7 @@ var Bound_BoundType_value = map[string]int32{
 func (x Bound_BoundType) String() string {
 	return proto.EnumName(Bound_BoundType_name, int32(x))
 }
-func (Bound_BoundType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{5, 0} }
+func (Bound_BoundType) EnumDescriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{10, 0} }
 
 type GetBlockRequest struct {
 	// Height of block required
@@ -132,6 +138,27 @@ func (*GetTxRequest) XXX_MessageName() string {
 	return "rpcevents.GetTxRequest"
 }
 
+type TraceTxRequest struct {
+	TxHash      github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
+	TraceConfig *exec.TraceConfig                             `protobuf:"bytes,2,opt,name=TraceConfig" json:"TraceConfig,omitempty"`
+}
+
+func (m *TraceTxRequest) Reset()                    { *m = TraceTxRequest{} }
+func (m *TraceTxRequest) String() string            { return proto.CompactTextString(m) }
+func (*TraceTxRequest) ProtoMessage()               {}
+func (*TraceTxRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{2} }
+
+func (m *TraceTxRequest) GetTraceConfig() *exec.TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (*TraceTxRequest) XXX_MessageName() string {
+	return "rpcevents.TraceTxRequest"
+}
+
 type BlocksRequest struct {
 	BlockRange *BlockRange `protobuf:"bytes,1,opt,name=BlockRange" json:"BlockRange,omitempty"`
 	// Specify a query on which to match the tags of events.
@@ -167,15 +194,22 @@ type BlocksRequest struct {
 	// -----------------------------------------
 	// Exception  | String     | string
 	//
+	// -----------------------------------------
+	//   Log event decoded by ABI (see PutAbi)
+	// -----------------------------------------
+	// EventName    | String     | string
+	// <argument>   | String     | string (fixed-length bytes as hex)
+	//
 	// For example:
 	// EventType = 'LogEvent' AND EventID CONTAINS 'bar' AND TxHash = '020304' AND Height >= 34 AND Index < 3 AND Address = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
+	// EventName = 'Transfer' AND to = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
 	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
 }
 
 func (m *BlocksRequest) Reset()                    { *m = BlocksRequest{} }
 func (m *BlocksRequest) String() string            { return proto.CompactTextString(m) }
 func (*BlocksRequest) ProtoMessage()               {}
-func (*BlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{2} }
+func (*BlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{3} }
 
 func (m *BlocksRequest) GetBlockRange() *BlockRange {
 	if m != nil {
@@ -195,6 +229,112 @@ func (*BlocksRequest) XXX_MessageName() string {
 	return "rpcevents.BlocksRequest"
 }
 
+type SubscribeRequest struct {
+	// Identifies the subscription, one is generated if empty. Subscribing with the ID of an open subscription closes it.
+	SubscriptionID string `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+	// Query on the tags of events, as for BlocksRequest
+	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Resume after the event at this cursor (as returned in a SubscribeResponse), overrides Start
+	Cursor github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	// The height of the first block from which to stream events when there is no Cursor (latest if unset)
+	Start *Bound `protobuf:"bytes,4,opt,name=Start" json:"Start,omitempty"`
+}
+
+func (m *SubscribeRequest) Reset()                    { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()               {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{4} }
+
+func (m *SubscribeRequest) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SubscribeRequest) GetStart() *Bound {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (*SubscribeRequest) XXX_MessageName() string {
+	return "rpcevents.SubscribeRequest"
+}
+
+type SubscribeResponse struct {
+	SubscriptionID string `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+	// Opaque position of Event, encoding the height, transaction index, and event index
+	Cursor github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,2,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	Event  *exec.Event                                   `protobuf:"bytes,3,opt,name=Event" json:"Event,omitempty"`
+}
+
+func (m *SubscribeResponse) Reset()                    { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()               {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{5} }
+
+func (m *SubscribeResponse) GetSubscriptionID() string {
+	if m != nil {
+		return m.SubscriptionID
+	}
+	return ""
+}
+
+func (m *SubscribeResponse) GetEvent() *exec.Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (*SubscribeResponse) XXX_MessageName() string {
+	return "rpcevents.SubscribeResponse"
+}
+
+type ContractAbi struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// The JSON ABI as output by the Solidity compiler
+	Abi string `protobuf:"bytes,2,opt,name=Abi,proto3" json:"Abi,omitempty"`
+}
+
+func (m *ContractAbi) Reset()                    { *m = ContractAbi{} }
+func (m *ContractAbi) String() string            { return proto.CompactTextString(m) }
+func (*ContractAbi) ProtoMessage()               {}
+func (*ContractAbi) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{6} }
+
+func (m *ContractAbi) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (*ContractAbi) XXX_MessageName() string {
+	return "rpcevents.ContractAbi"
+}
+
+type GetAbiRequest struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+}
+
+func (m *GetAbiRequest) Reset()                    { *m = GetAbiRequest{} }
+func (m *GetAbiRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetAbiRequest) ProtoMessage()               {}
+func (*GetAbiRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{7} }
+
+func (*GetAbiRequest) XXX_MessageName() string {
+	return "rpcevents.GetAbiRequest"
+}
+
 type GetEventsResponse struct {
 	Height uint64        `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 	Events []*exec.Event `protobuf:"bytes,2,rep,name=Events" json:"Events,omitempty"`
@@ -203,7 +343,7 @@ type GetEventsResponse struct {
 func (m *GetEventsResponse) Reset()                    { *m = GetEventsResponse{} }
 func (m *GetEventsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetEventsResponse) ProtoMessage()               {}
-func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{3} }
+func (*GetEventsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{8} }
 
 func (m *GetEventsResponse) GetHeight() uint64 {
 	if m != nil {
@@ -231,7 +371,7 @@ type GetTxsResponse struct {
 func (m *GetTxsResponse) Reset()                    { *m = GetTxsResponse{} }
 func (m *GetTxsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GetTxsResponse) ProtoMessage()               {}
-func (*GetTxsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{4} }
+func (*GetTxsResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{9} }
 
 func (m *GetTxsResponse) GetHeight() uint64 {
 	if m != nil {
@@ -259,7 +399,7 @@ type Bound struct {
 func (m *Bound) Reset()                    { *m = Bound{} }
 func (m *Bound) String() string            { return proto.CompactTextString(m) }
 func (*Bound) ProtoMessage()               {}
-func (*Bound) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{5} }
+func (*Bound) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{10} }
 
 func (m *Bound) GetType() Bound_BoundType {
 	if m != nil {
@@ -293,7 +433,7 @@ type BlockRange struct {
 func (m *BlockRange) Reset()                    { *m = BlockRange{} }
 func (m *BlockRange) String() string            { return proto.CompactTextString(m) }
 func (*BlockRange) ProtoMessage()               {}
-func (*BlockRange) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{6} }
+func (*BlockRange) Descriptor() ([]byte, []int) { return fileDescriptorRpcevents, []int{11} }
 
 func (m *BlockRange) GetStart() *Bound {
 	if m != nil {
@@ -317,8 +457,18 @@ func init() {
 	golang_proto.RegisterType((*GetBlockRequest)(nil), "rpcevents.GetBlockRequest")
 	proto.RegisterType((*GetTxRequest)(nil), "rpcevents.GetTxRequest")
 	golang_proto.RegisterType((*GetTxRequest)(nil), "rpcevents.GetTxRequest")
+	proto.RegisterType((*TraceTxRequest)(nil), "rpcevents.TraceTxRequest")
+	golang_proto.RegisterType((*TraceTxRequest)(nil), "rpcevents.TraceTxRequest")
 	proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
 	golang_proto.RegisterType((*BlocksRequest)(nil), "rpcevents.BlocksRequest")
+	proto.RegisterType((*SubscribeRequest)(nil), "rpcevents.SubscribeRequest")
+	golang_proto.RegisterType((*SubscribeRequest)(nil), "rpcevents.SubscribeRequest")
+	proto.RegisterType((*SubscribeResponse)(nil), "rpcevents.SubscribeResponse")
+	golang_proto.RegisterType((*SubscribeResponse)(nil), "rpcevents.SubscribeResponse")
+	proto.RegisterType((*ContractAbi)(nil), "rpcevents.ContractAbi")
+	golang_proto.RegisterType((*ContractAbi)(nil), "rpcevents.ContractAbi")
+	proto.RegisterType((*GetAbiRequest)(nil), "rpcevents.GetAbiRequest")
+	golang_proto.RegisterType((*GetAbiRequest)(nil), "rpcevents.GetAbiRequest")
 	proto.RegisterType((*GetEventsResponse)(nil), "rpcevents.GetEventsResponse")
 	golang_proto.RegisterType((*GetEventsResponse)(nil), "rpcevents.GetEventsResponse")
 	proto.RegisterType((*GetTxsResponse)(nil), "rpcevents.GetTxsResponse")
@@ -353,6 +503,19 @@ type ExecutionEventsClient interface {
 	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
 	// are guaranteed to be delivered in each GetEventsResponse
 	GetEvents(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (ExecutionEvents_GetEventsClient, error)
+	// Subscribe streams each event matching a query along with a cursor marking its position in the chain. Passing the
+	// cursor of the last event processed resumes the subscription with the event following it, so no events are skipped
+	// across reconnections
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ExecutionEvents_SubscribeClient, error)
+	// Store the ABI of a contract with this node so that GetEvents and Subscribe can decode the contract's logs. The
+	// ABI is not part of the chain state and is not shared with other nodes. An ABI already stored for the contract, or
+	// committed with it, cannot be replaced.
+	PutAbi(ctx context.Context, in *ContractAbi, opts ...grpc.CallOption) (*ContractAbi, error)
+	// Get the ABI of a contract from the metadata committed when it was created, or failing that the ABI stored with
+	// this node
+	GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*ContractAbi, error)
+	// Re-execute a committed transaction against the state as it was at the time, returning a trace of the EVM
+	TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*exec.Trace, error)
 }
 
 type executionEventsClient struct {
@@ -477,6 +640,65 @@ func (x *executionEventsGetEventsClient) Recv() (*GetEventsResponse, error) {
 	return m, nil
 }
 
+func (c *executionEventsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ExecutionEvents_SubscribeClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_ExecutionEvents_serviceDesc.Streams[3], c.cc, "/rpcevents.ExecutionEvents/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &executionEventsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ExecutionEvents_SubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type executionEventsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *executionEventsSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *executionEventsClient) PutAbi(ctx context.Context, in *ContractAbi, opts ...grpc.CallOption) (*ContractAbi, error) {
+	out := new(ContractAbi)
+	err := grpc.Invoke(ctx, "/rpcevents.ExecutionEvents/PutAbi", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionEventsClient) GetAbi(ctx context.Context, in *GetAbiRequest, opts ...grpc.CallOption) (*ContractAbi, error) {
+	out := new(ContractAbi)
+	err := grpc.Invoke(ctx, "/rpcevents.ExecutionEvents/GetAbi", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *executionEventsClient) TraceTx(ctx context.Context, in *TraceTxRequest, opts ...grpc.CallOption) (*exec.Trace, error) {
+	out := new(exec.Trace)
+	err := grpc.Invoke(ctx, "/rpcevents.ExecutionEvents/TraceTx", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ExecutionEvents service
 
 type ExecutionEventsServer interface {
@@ -491,6 +713,19 @@ type ExecutionEventsServer interface {
 	// GetEvents provides events streaming one block at a time - that is all events emitted in a particular block
 	// are guaranteed to be delivered in each GetEventsResponse
 	GetEvents(*BlocksRequest, ExecutionEvents_GetEventsServer) error
+	// Subscribe streams each event matching a query along with a cursor marking its position in the chain. Passing the
+	// cursor of the last event processed resumes the subscription with the event following it, so no events are skipped
+	// across reconnections
+	Subscribe(*SubscribeRequest, ExecutionEvents_SubscribeServer) error
+	// Store the ABI of a contract with this node so that GetEvents and Subscribe can decode the contract's logs. The
+	// ABI is not part of the chain state and is not shared with other nodes. An ABI already stored for the contract, or
+	// committed with it, cannot be replaced.
+	PutAbi(context.Context, *ContractAbi) (*ContractAbi, error)
+	// Get the ABI of a contract from the metadata committed when it was created, or failing that the ABI stored with
+	// this node
+	GetAbi(context.Context, *GetAbiRequest) (*ContractAbi, error)
+	// Re-execute a committed transaction against the state as it was at the time, returning a trace of the EVM
+	TraceTx(context.Context, *TraceTxRequest) (*exec.Trace, error)
 }
 
 func RegisterExecutionEventsServer(s *grpc.Server, srv ExecutionEventsServer) {
@@ -596,6 +831,81 @@ func (x *executionEventsGetEventsServer) Send(m *GetEventsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ExecutionEvents_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ExecutionEventsServer).Subscribe(m, &executionEventsSubscribeServer{stream})
+}
+
+type ExecutionEvents_SubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type executionEventsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *executionEventsSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ExecutionEvents_PutAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractAbi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).PutAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/PutAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).PutAbi(ctx, req.(*ContractAbi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionEvents_GetAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).GetAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/GetAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).GetAbi(ctx, req.(*GetAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExecutionEvents_TraceTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExecutionEventsServer).TraceTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcevents.ExecutionEvents/TraceTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExecutionEventsServer).TraceTx(ctx, req.(*TraceTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ExecutionEvents_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcevents.ExecutionEvents",
 	HandlerType: (*ExecutionEventsServer)(nil),
@@ -608,6 +918,18 @@ var _ExecutionEvents_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTx",
 			Handler:    _ExecutionEvents_GetTx_Handler,
 		},
+		{
+			MethodName: "PutAbi",
+			Handler:    _ExecutionEvents_PutAbi_Handler,
+		},
+		{
+			MethodName: "GetAbi",
+			Handler:    _ExecutionEvents_GetAbi_Handler,
+		},
+		{
+			MethodName: "TraceTx",
+			Handler:    _ExecutionEvents_TraceTx_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -625,6 +947,11 @@ var _ExecutionEvents_serviceDesc = grpc.ServiceDesc{
 			Handler:       _ExecutionEvents_GetEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Subscribe",
+			Handler:       _ExecutionEvents_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpcevents.proto",
 }
@@ -698,6 +1025,42 @@ func (m *GetTxRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *TraceTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcevents(dAtA, i, uint64(m.TxHash.Size()))
+	n2, err := m.TxHash.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if m.TraceConfig != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.TraceConfig.Size()))
+		n3, err := m.TraceConfig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
 func (m *BlocksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -717,11 +1080,11 @@ func (m *BlocksRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpcevents(dAtA, i, uint64(m.BlockRange.Size()))
-		n2, err := m.BlockRange.MarshalTo(dAtA[i:])
+		n4, err := m.BlockRange.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n4
 	}
 	if len(m.Query) > 0 {
 		dAtA[i] = 0x12
@@ -732,7 +1095,7 @@ func (m *BlocksRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *GetEventsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -742,32 +1105,45 @@ func (m *GetEventsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		dAtA[i] = 0x8
+	if len(m.SubscriptionID) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.SubscriptionID)))
+		i += copy(dAtA[i:], m.SubscriptionID)
 	}
-	if len(m.Events) > 0 {
-		for _, msg := range m.Events {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRpcevents(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if len(m.Query) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintRpcevents(dAtA, i, uint64(m.Cursor.Size()))
+	n5, err := m.Cursor.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n5
+	if m.Start != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Start.Size()))
+		n6, err := m.Start.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
 	}
 	return i, nil
 }
 
-func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+func (m *SubscribeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -777,32 +1153,39 @@ func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SubscribeResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		dAtA[i] = 0x8
+	if len(m.SubscriptionID) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.SubscriptionID)))
+		i += copy(dAtA[i:], m.SubscriptionID)
 	}
-	if len(m.TxExecutions) > 0 {
-		for _, msg := range m.TxExecutions {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRpcevents(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcevents(dAtA, i, uint64(m.Cursor.Size()))
+	n7, err := m.Cursor.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	if m.Event != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Event.Size()))
+		n8, err := m.Event.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n8
 	}
 	return i, nil
 }
 
-func (m *Bound) Marshal() (dAtA []byte, err error) {
+func (m *ContractAbi) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -812,7 +1195,135 @@ func (m *Bound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContractAbi) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcevents(dAtA, i, uint64(m.Address.Size()))
+	n9, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if len(m.Abi) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(len(m.Abi)))
+		i += copy(dAtA[i:], m.Abi)
+	}
+	return i, nil
+}
+
+func (m *GetAbiRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAbiRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcevents(dAtA, i, uint64(m.Address.Size()))
+	n10, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	return i, nil
+}
+
+func (m *GetEventsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEventsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+	}
+	if len(m.Events) > 0 {
+		for _, msg := range m.Events {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcevents(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *GetTxsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTxsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcevents(dAtA, i, uint64(m.Height))
+	}
+	if len(m.TxExecutions) > 0 {
+		for _, msg := range m.TxExecutions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcevents(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Bound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Bound) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -849,21 +1360,21 @@ func (m *BlockRange) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpcevents(dAtA, i, uint64(m.Start.Size()))
-		n3, err := m.Start.MarshalTo(dAtA[i:])
+		n11, err := m.Start.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n11
 	}
 	if m.End != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpcevents(dAtA, i, uint64(m.End.Size()))
-		n4, err := m.End.MarshalTo(dAtA[i:])
+		n12, err := m.End.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n12
 	}
 	return i, nil
 }
@@ -900,6 +1411,18 @@ func (m *GetTxRequest) Size() (n int) {
 	return n
 }
 
+func (m *TraceTxRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.TxHash.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	return n
+}
+
 func (m *BlocksRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -914,6 +1437,62 @@ func (m *BlocksRequest) Size() (n int) {
 	return n
 }
 
+func (m *SubscribeRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SubscriptionID)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.SubscriptionID)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractAbi) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovRpcevents(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAbiRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
+	return n
+}
+
 func (m *GetEventsResponse) Size() (n int) {
 	var l int
 	_ = l
@@ -1172,7 +1751,7 @@ func (m *GetTxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *TraceTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1195,17 +1774,17 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TraceTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TraceTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1215,30 +1794,27 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRange == nil {
-				m.BlockRange = &BlockRange{}
-			}
-			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TxHash.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcevents
@@ -1248,20 +1824,638 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpcevents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Query = string(dAtA[iNdEx:postIndex])
+			if m.TraceConfig == nil {
+				m.TraceConfig = &exec.TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockRange == nil {
+				m.BlockRange = &BlockRange{}
+			}
+			if err := m.BlockRange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &Bound{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubscriptionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubscriptionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &exec.Event{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractAbi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractAbi: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractAbi: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcevents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAbiRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcevents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAbiRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAbiRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1797,42 +2991,57 @@ func init() { proto.RegisterFile("rpcevents.proto", fileDescriptorRpcevents) }
 func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptorRpcevents) }
 
 var fileDescriptorRpcevents = []byte{
-	// 584 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5f, 0x8f, 0xd2, 0x40,
-	0x10, 0xbf, 0xe5, 0x4f, 0x03, 0x03, 0x1e, 0xbd, 0xcd, 0xa9, 0x48, 0x0c, 0x47, 0x6a, 0x62, 0x48,
-	0x8c, 0x85, 0x70, 0xe1, 0xcd, 0x8b, 0xa1, 0x49, 0x05, 0x0c, 0x17, 0x75, 0xa9, 0x7f, 0x62, 0x4c,
-	0x0c, 0x94, 0xb5, 0x10, 0xcf, 0xb6, 0xd7, 0x6e, 0xb5, 0x7c, 0x14, 0xbf, 0x8d, 0x8f, 0x24, 0xbe,
-	0xf8, 0xec, 0xc3, 0xc5, 0x70, 0x5f, 0xc4, 0x74, 0x17, 0x7a, 0x05, 0xef, 0xf0, 0xa5, 0x99, 0xd9,
-	0xf9, 0xcd, 0x6f, 0x66, 0x7f, 0x33, 0x5b, 0x28, 0x79, 0xae, 0x49, 0xbf, 0x52, 0x9b, 0xf9, 0xaa,
-	0xeb, 0x39, 0xcc, 0xc1, 0xf9, 0xf8, 0xa0, 0xf2, 0xd8, 0x9a, 0xb1, 0x69, 0x30, 0x56, 0x4d, 0xe7,
-	0x4b, 0xc3, 0x72, 0x2c, 0xa7, 0xc1, 0x11, 0xe3, 0xe0, 0x13, 0xf7, 0xb8, 0xc3, 0x2d, 0x91, 0x59,
-	0x01, 0x1a, 0x52, 0x53, 0xd8, 0xca, 0x09, 0x94, 0xba, 0x94, 0x69, 0x67, 0x8e, 0xf9, 0x99, 0xd0,
-	0xf3, 0x80, 0xfa, 0x0c, 0xdf, 0x01, 0xa9, 0x47, 0x67, 0xd6, 0x94, 0x95, 0x51, 0x0d, 0xd5, 0x33,
-	0x64, 0xe5, 0x61, 0x0c, 0x99, 0xb7, 0xa3, 0x19, 0x2b, 0xa7, 0x6a, 0xa8, 0x9e, 0x23, 0xdc, 0x56,
-	0xce, 0xa1, 0xd8, 0xa5, 0xcc, 0x08, 0xd7, 0xb9, 0xa7, 0x20, 0x19, 0x61, 0x6f, 0xe4, 0x4f, 0x79,
-	0x6e, 0x51, 0x6b, 0x2f, 0x2e, 0x8e, 0xf6, 0x7e, 0x5f, 0x1c, 0x25, 0x3b, 0x9c, 0xce, 0x5d, 0xea,
-	0x9d, 0xd1, 0x89, 0x45, 0xbd, 0xc6, 0x38, 0xf0, 0x3c, 0xe7, 0x5b, 0x63, 0x3c, 0xb3, 0x47, 0xde,
-	0x5c, 0xed, 0xd1, 0x50, 0x9b, 0x33, 0xea, 0x93, 0x15, 0xc9, 0xb5, 0x25, 0x3f, 0xc0, 0x2d, 0xde,
-	0xae, 0xbf, 0xae, 0xd9, 0x06, 0x10, 0xfd, 0x8f, 0x6c, 0x8b, 0xf2, 0xba, 0x85, 0xd6, 0x6d, 0xf5,
-	0x4a, 0xae, 0xab, 0x20, 0x49, 0x00, 0xf1, 0x21, 0x64, 0x5f, 0x05, 0xd4, 0x9b, 0x73, 0xf2, 0x3c,
-	0x11, 0x8e, 0xf2, 0x12, 0x0e, 0xba, 0x94, 0xe9, 0x3c, 0x93, 0x50, 0xdf, 0x75, 0x6c, 0x9f, 0xde,
-	0xa8, 0xc8, 0x03, 0x90, 0x04, 0xb2, 0x9c, 0xaa, 0xa5, 0xeb, 0x85, 0x56, 0x41, 0xe5, 0xca, 0xf2,
-	0x33, 0xb2, 0x0a, 0x29, 0x1f, 0x61, 0x9f, 0x4b, 0xf4, 0x7f, 0xba, 0x36, 0x14, 0x8d, 0x50, 0x0f,
-	0xa9, 0x19, 0xb0, 0x99, 0x63, 0xaf, 0x49, 0x0f, 0x04, 0x69, 0x22, 0x42, 0x36, 0x60, 0xca, 0x77,
-	0x04, 0x59, 0xcd, 0x09, 0xec, 0x09, 0x56, 0x21, 0x63, 0xcc, 0x5d, 0xa1, 0xc1, 0x7e, 0xab, 0x92,
-	0xd4, 0x20, 0x8a, 0x8b, 0x6f, 0x84, 0x20, 0x1c, 0x17, 0x49, 0xd0, 0xb7, 0x27, 0x34, 0xe4, 0x12,
-	0x64, 0x88, 0x70, 0x94, 0xe7, 0x90, 0x8f, 0x81, 0xb8, 0x08, 0xb9, 0x8e, 0x36, 0x7c, 0x31, 0x78,
-	0x6d, 0xe8, 0xf2, 0x5e, 0xe4, 0x11, 0x7d, 0xd0, 0x31, 0xfa, 0x6f, 0x74, 0x19, 0xe1, 0x3c, 0x64,
-	0x9f, 0xf5, 0xc9, 0xd0, 0x90, 0x53, 0x18, 0x40, 0x1a, 0x74, 0x0c, 0x7d, 0x68, 0xc8, 0xe9, 0xc8,
-	0x1e, 0x1a, 0x44, 0xef, 0x9c, 0xca, 0x19, 0xe5, 0x5d, 0x72, 0x36, 0xf8, 0x21, 0x64, 0x87, 0x6c,
-	0xe4, 0xb1, 0xd5, 0x90, 0xe4, 0xed, 0x06, 0x89, 0x08, 0x63, 0x05, 0xd2, 0xba, 0x3d, 0xe1, 0x5d,
-	0x5d, 0x87, 0x8a, 0x82, 0xad, 0x9f, 0x29, 0x28, 0xc5, 0x22, 0x08, 0xa9, 0xf1, 0x13, 0xc8, 0xad,
-	0x97, 0x19, 0x27, 0x6f, 0xbf, 0xb5, 0xe1, 0x95, 0x43, 0x21, 0x29, 0x3f, 0x8b, 0x39, 0xf0, 0x09,
-	0xe4, 0xd7, 0x40, 0x1f, 0x97, 0xb7, 0x17, 0xc8, 0xdf, 0x99, 0xdc, 0x44, 0xf8, 0x18, 0xb2, 0x7c,
-	0xce, 0xf8, 0xee, 0x66, 0xe5, 0xf8, 0x71, 0x54, 0xfe, 0x9d, 0x24, 0x7e, 0x0a, 0x92, 0x58, 0x8e,
-	0x1d, 0x05, 0xef, 0x6d, 0xf3, 0xc5, 0x9b, 0xd4, 0x44, 0x58, 0xe7, 0x4d, 0xaf, 0xee, 0x7f, 0x33,
-	0xc7, 0xfd, 0x4d, 0x8e, 0xcd, 0xfd, 0x6e, 0x22, 0xad, 0xb3, 0x58, 0x56, 0xd1, 0xaf, 0x65, 0x15,
-	0xfd, 0x59, 0x56, 0xd1, 0x8f, 0xcb, 0x2a, 0x5a, 0x5c, 0x56, 0xd1, 0xfb, 0x47, 0xbb, 0x5f, 0xad,
-	0xe7, 0x9a, 0x8d, 0x98, 0x76, 0x2c, 0xf1, 0x1f, 0xca, 0xf1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x5d, 0x9d, 0x65, 0x82, 0xa9, 0x04, 0x00, 0x00,
+	// 821 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdb, 0x6e, 0xf3, 0x44,
+	0x10, 0xfe, 0x37, 0xa7, 0x36, 0x93, 0x34, 0x75, 0x57, 0xa5, 0x18, 0x53, 0xa5, 0xc5, 0x48, 0x55,
+	0x25, 0x20, 0x29, 0x29, 0x95, 0x10, 0xa2, 0x42, 0x4e, 0x31, 0x49, 0x50, 0x0b, 0x65, 0x63, 0x0e,
+	0x42, 0x48, 0x55, 0xec, 0x6c, 0x13, 0x8b, 0x62, 0xbb, 0xeb, 0x35, 0x24, 0xef, 0xc1, 0x0d, 0x0f,
+	0xc1, 0x2b, 0x20, 0xee, 0xe8, 0x25, 0xd7, 0x5c, 0x54, 0xa8, 0x7d, 0x11, 0xe4, 0xf5, 0x21, 0x4e,
+	0x68, 0x02, 0x12, 0xe5, 0x26, 0xda, 0xdd, 0x99, 0xf9, 0x66, 0xe6, 0x9b, 0x83, 0x03, 0x9b, 0xcc,
+	0xb3, 0xe8, 0xf7, 0xd4, 0xe1, 0x7e, 0xc3, 0x63, 0x2e, 0x77, 0x71, 0x39, 0x7d, 0x50, 0xde, 0x1a,
+	0xd9, 0x7c, 0x1c, 0x98, 0x0d, 0xcb, 0xfd, 0xae, 0x39, 0x72, 0x47, 0x6e, 0x53, 0x68, 0x98, 0xc1,
+	0xb5, 0xb8, 0x89, 0x8b, 0x38, 0x45, 0x96, 0x0a, 0xd0, 0x09, 0xb5, 0xa2, 0xb3, 0x7a, 0x0a, 0x9b,
+	0x1d, 0xca, 0xdb, 0x37, 0xae, 0xf5, 0x2d, 0xa1, 0xb7, 0x01, 0xf5, 0x39, 0xde, 0x81, 0x52, 0x97,
+	0xda, 0xa3, 0x31, 0x97, 0xd1, 0x3e, 0x3a, 0x2c, 0x90, 0xf8, 0x86, 0x31, 0x14, 0xbe, 0x1c, 0xd8,
+	0x5c, 0xce, 0xed, 0xa3, 0xc3, 0x75, 0x22, 0xce, 0xea, 0x2d, 0x54, 0x3b, 0x94, 0x1b, 0x93, 0xc4,
+	0xf6, 0x02, 0x4a, 0xc6, 0xa4, 0x3b, 0xf0, 0xc7, 0xc2, 0xb6, 0xda, 0x3e, 0xb9, 0xbb, 0xdf, 0x7b,
+	0xf1, 0xc7, 0xfd, 0x5e, 0x36, 0xc2, 0xf1, 0xd4, 0xa3, 0xec, 0x86, 0x0e, 0x47, 0x94, 0x35, 0xcd,
+	0x80, 0x31, 0xf7, 0x87, 0xa6, 0x69, 0x3b, 0x03, 0x36, 0x6d, 0x74, 0xe9, 0xa4, 0x3d, 0xe5, 0xd4,
+	0x27, 0x31, 0xc8, 0x93, 0x2e, 0x7f, 0x44, 0x50, 0x33, 0xd8, 0xc0, 0xa2, 0xff, 0x9b, 0xd7, 0x63,
+	0xa8, 0x08, 0x07, 0x67, 0xae, 0x73, 0x6d, 0x8f, 0x84, 0xf3, 0x4a, 0x6b, 0xab, 0x21, 0x58, 0xcb,
+	0x08, 0x48, 0x56, 0x4b, 0xfd, 0x06, 0x36, 0x04, 0x8b, 0x7e, 0x12, 0xd4, 0x09, 0x40, 0x44, 0xeb,
+	0xc0, 0x19, 0x51, 0x11, 0x58, 0xa5, 0xf5, 0x52, 0x63, 0x56, 0xc5, 0x99, 0x90, 0x64, 0x14, 0xf1,
+	0x36, 0x14, 0x3f, 0x0b, 0x28, 0x9b, 0x0a, 0xb7, 0x65, 0x12, 0x5d, 0xd4, 0xdf, 0x10, 0x48, 0xfd,
+	0xc0, 0xf4, 0x2d, 0x66, 0x9b, 0x34, 0xf1, 0x70, 0x00, 0xb5, 0xf8, 0xcd, 0xe3, 0xb6, 0xeb, 0xf4,
+	0x3e, 0x14, 0x5e, 0xca, 0x64, 0xe1, 0xf5, 0x69, 0xc8, 0x90, 0xb4, 0xb3, 0x80, 0xf9, 0x2e, 0x93,
+	0xf3, 0xff, 0x89, 0xb4, 0x08, 0x04, 0x1f, 0x40, 0xb1, 0xcf, 0x07, 0x8c, 0xcb, 0x05, 0x91, 0xa9,
+	0x94, 0xcd, 0xd4, 0x0d, 0x9c, 0x21, 0x89, 0xc4, 0xea, 0xcf, 0x08, 0xb6, 0x32, 0x99, 0xf8, 0x9e,
+	0xeb, 0xf8, 0xf4, 0x5f, 0xa7, 0x32, 0x0b, 0x3a, 0xf7, 0x1c, 0x41, 0xbf, 0x06, 0x45, 0x3d, 0x8c,
+	0x51, 0x50, 0x50, 0x69, 0x55, 0xa2, 0x1a, 0x8b, 0x27, 0x12, 0x49, 0x54, 0x17, 0x2a, 0x67, 0xae,
+	0xc3, 0xd9, 0xc0, 0xe2, 0x9a, 0x69, 0xe3, 0x4f, 0x60, 0x4d, 0x1b, 0x0e, 0x19, 0xf5, 0xfd, 0xb8,
+	0xd7, 0xde, 0x89, 0x23, 0x78, 0x73, 0x75, 0x04, 0x16, 0x9b, 0x7a, 0xdc, 0x6d, 0xc4, 0xb6, 0x24,
+	0x01, 0xc1, 0x12, 0xe4, 0x35, 0xd3, 0x8e, 0x2b, 0x13, 0x1e, 0xd5, 0x2b, 0xd8, 0xe8, 0xd0, 0xd0,
+	0x57, 0x52, 0xe6, 0x67, 0x76, 0xa9, 0x5e, 0xc2, 0x56, 0x87, 0x72, 0x91, 0x9d, 0x9f, 0x16, 0x60,
+	0xd9, 0xd0, 0xbf, 0x0e, 0xa5, 0x48, 0x53, 0xce, 0xed, 0xe7, 0x17, 0x29, 0x8a, 0x45, 0xea, 0x15,
+	0xd4, 0xc4, 0x16, 0xf8, 0x67, 0xb8, 0x13, 0xa8, 0x1a, 0x13, 0x7d, 0x42, 0xad, 0x20, 0x2c, 0x68,
+	0x02, 0x9a, 0xcc, 0xd6, 0x4c, 0x42, 0xe6, 0xd4, 0xd4, 0x9f, 0x10, 0x14, 0x45, 0x17, 0xe1, 0x06,
+	0x14, 0x8c, 0xa9, 0x17, 0xcd, 0x53, 0xad, 0xa5, 0x2c, 0x76, 0x59, 0xf4, 0x1b, 0x6a, 0x10, 0xa1,
+	0x17, 0xf6, 0x7e, 0xcf, 0x19, 0xd2, 0x89, 0x60, 0xb8, 0x40, 0xa2, 0x8b, 0xfa, 0x31, 0x94, 0x53,
+	0x45, 0x5c, 0x85, 0x75, 0xad, 0xdd, 0xff, 0xf4, 0xfc, 0x73, 0x43, 0x97, 0x5e, 0x84, 0x37, 0xa2,
+	0x9f, 0x6b, 0x46, 0xef, 0x0b, 0x5d, 0x42, 0xb8, 0x0c, 0xc5, 0x8f, 0x7a, 0xa4, 0x6f, 0x48, 0x39,
+	0x0c, 0x50, 0x3a, 0xd7, 0x0c, 0xbd, 0x6f, 0x48, 0xf9, 0xf0, 0xdc, 0x37, 0x88, 0xae, 0x5d, 0x48,
+	0x05, 0xf5, 0xab, 0xec, 0x9c, 0xcf, 0xc6, 0x00, 0xad, 0x1c, 0x03, 0xac, 0x42, 0x5e, 0x77, 0x86,
+	0x72, 0x6e, 0x89, 0x56, 0x28, 0x6c, 0xfd, 0x52, 0x80, 0xcd, 0x94, 0x84, 0x88, 0x6a, 0xfc, 0x3e,
+	0xac, 0x27, 0xfb, 0x1a, 0x67, 0xb3, 0x5f, 0x58, 0xe2, 0xca, 0x76, 0x44, 0xa9, 0x78, 0x4b, 0x31,
+	0xf0, 0x29, 0x94, 0x13, 0x45, 0x1f, 0xcb, 0x8b, 0xcb, 0xc8, 0x5f, 0x69, 0x7c, 0x84, 0xf0, 0x31,
+	0x14, 0x45, 0x9d, 0xf1, 0xcb, 0xf3, 0x9e, 0xd3, 0x4d, 0xac, 0xfc, 0xbd, 0x92, 0xf8, 0x03, 0x28,
+	0x45, 0xcd, 0xb1, 0xc2, 0xe1, 0x2b, 0x8b, 0x78, 0x69, 0x27, 0x1d, 0x21, 0xac, 0x8b, 0xa0, 0xe3,
+	0xfc, 0x97, 0x63, 0xec, 0xce, 0x63, 0xcc, 0xf7, 0xf7, 0x11, 0xc2, 0x5d, 0x28, 0xa7, 0x7b, 0x07,
+	0xbf, 0x9a, 0x51, 0x5e, 0xdc, 0xab, 0xca, 0xee, 0xd3, 0xc2, 0x14, 0xe9, 0x5d, 0x28, 0x5d, 0x06,
+	0x62, 0x1b, 0xec, 0x64, 0x34, 0x33, 0x5b, 0x42, 0x59, 0xf2, 0x8e, 0xdf, 0x13, 0x5c, 0x84, 0x27,
+	0x79, 0x3e, 0xda, 0xd9, 0xb8, 0x2f, 0xb5, 0x7d, 0x1b, 0xd6, 0xe2, 0xcf, 0x1e, 0xce, 0xd2, 0x35,
+	0xff, 0x29, 0x54, 0x2a, 0x99, 0xcf, 0x54, 0x5b, 0xbb, 0x7b, 0xa8, 0xa3, 0xdf, 0x1f, 0xea, 0xe8,
+	0xcf, 0x87, 0x3a, 0xfa, 0xf5, 0xb1, 0x8e, 0xee, 0x1e, 0xeb, 0xe8, 0xeb, 0x37, 0x56, 0xaf, 0x0d,
+	0xe6, 0x59, 0xcd, 0x14, 0xde, 0x2c, 0x89, 0xbf, 0x09, 0xc7, 0x7f, 0x0d, 0x00, 0x0a, 0x1f, 0x08,
+	0x03, 0x7f, 0x08, 0x00, 0x00,
 }
@@ -9,14 +9,21 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 )
 
+// Provides access to state as it was at previous heights
+type History interface {
+	AtHeight(height uint64) (*execution.ReadState, error)
+}
+
 type queryServer struct {
 	accounts   state.IterableReader
 	nameReg    names.IterableReader
+	history    History
 	blockchain bcm.BlockchainInfo
 	nodeView   *tendermint.NodeView
 	logger     *logging.Logger
@@ -24,11 +31,12 @@ type queryServer struct {
 
 var _ QueryServer = &queryServer{}
 
-func NewQueryServer(state state.IterableReader, nameReg names.IterableReader, blockchain bcm.BlockchainInfo,
-	nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
+func NewQueryServer(state state.IterableReader, nameReg names.IterableReader, history History,
+	blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
 	return &queryServer{
 		accounts:   state,
 		nameReg:    nameReg,
+		history:    history,
 		blockchain: blockchain,
 		nodeView:   nodeView,
 		logger:     logger,
@@ -42,7 +50,11 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.ConcreteAccount, error) {
-	acc, err := qs.accounts.GetAccount(param.Address)
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := accounts.GetAccount(param.Address)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +63,15 @@ func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (
 
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewBuilder(param.Query).Query()
+	if err != nil {
+		return err
+	}
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return err
+	}
 	var streamErr error
-	_, err = qs.accounts.IterateAccounts(func(acc acm.Account) (stop bool) {
+	_, err = accounts.IterateAccounts(func(acc acm.Account) (stop bool) {
 		if qry.Matches(acc.Tagged()) {
 			streamErr = stream.Send(acm.AsConcreteAccount(acc))
 			if streamErr != nil {
@@ -67,22 +86,95 @@ func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListA
 	return streamErr
 }
 
+func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
+	if !param.Prove {
+		accounts, err := qs.accountsAt(param.Height)
+		if err != nil {
+			return nil, err
+		}
+		value, err := accounts.GetStorage(param.Address, param.Key)
+		if err != nil {
+			return nil, err
+		}
+		return &StorageValue{Value: value}, nil
+	}
+	st, height, err := qs.provableAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	value, prf, err := st.GetStorageWithProof(param.Address, param.Key, height)
+	if err != nil {
+		return nil, err
+	}
+	return &StorageValue{Value: value, Proof: prf}, nil
+}
+
+func (qs *queryServer) GetAccountWithProof(ctx context.Context, param *GetAccountParam) (*AccountWithProof, error) {
+	st, height, err := qs.provableAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc, prf, err := st.GetAccountWithProof(param.Address, height)
+	if err != nil {
+		return nil, err
+	}
+	return &AccountWithProof{Account: acm.AsConcreteAccount(acc), Proof: prf}, nil
+}
+
+// Contract metadata
+
+func (qs *queryServer) GetContractMeta(ctx context.Context, param *GetContractMetaParam) (*acm.ContractMeta, error) {
+	accounts, err := qs.accountsAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	getter, ok := accounts.(state.ContractMetaGetter)
+	if !ok {
+		return nil, fmt.Errorf("contract metadata is not available from this node")
+	}
+	meta, err := getter.GetContractMeta(param.Address)
+	if meta == nil && err == nil {
+		err = fmt.Errorf("no contract metadata found for %v", param.Address)
+	}
+	return meta, err
+}
+
 // Name registry
 func (qs *queryServer) GetName(ctx context.Context, param *GetNameParam) (entry *names.Entry, err error) {
-	entry, err = qs.nameReg.GetName(param.Name)
+	nameReg, err := qs.namesAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	entry, err = nameReg.GetName(param.Name)
 	if entry == nil && err == nil {
 		err = fmt.Errorf("name %s not found", param.Name)
 	}
 	return
 }
 
+func (qs *queryServer) GetNameWithProof(ctx context.Context, param *GetNameParam) (*NameWithProof, error) {
+	st, height, err := qs.provableAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	entry, prf, err := st.GetNameWithProof(param.Name, height)
+	if err != nil {
+		return nil, err
+	}
+	return &NameWithProof{Entry: entry, Proof: prf}, nil
+}
+
 func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesServer) error {
 	qry, err := query.NewBuilder(param.Query).Query()
 	if err != nil {
 		return err
 	}
+	nameReg, err := qs.namesAt(param.Height)
+	if err != nil {
+		return err
+	}
 	var streamErr error
-	_, err = qs.nameReg.IterateNames(func(entry *names.Entry) (stop bool) {
+	_, err = nameReg.IterateNames(func(entry *names.Entry) (stop bool) {
 		if qry.Matches(entry.Tagged()) {
 			streamErr = stream.Send(entry)
 			if streamErr != nil {
@@ -97,6 +189,35 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	return streamErr
 }
 
+// Returns the accounts at height or the latest accounts if height is zero
+func (qs *queryServer) accountsAt(height uint64) (state.IterableReader, error) {
+	if height == 0 {
+		return qs.accounts, nil
+	}
+	return qs.history.AtHeight(height)
+}
+
+// Returns the names at height or the latest names if height is zero
+func (qs *queryServer) namesAt(height uint64) (names.IterableReader, error) {
+	if height == 0 {
+		return qs.nameReg, nil
+	}
+	return qs.history.AtHeight(height)
+}
+
+// Returns the state at height, or at the last block height if height is zero, along with the height used. Proofs are
+// always made against the state at an explicit height so they can be checked against the corresponding header.
+func (qs *queryServer) provableAt(height uint64) (*execution.ReadState, uint64, error) {
+	if height == 0 {
+		height = qs.blockchain.LastBlockHeight()
+	}
+	st, err := qs.history.AtHeight(height)
+	if err != nil {
+		return nil, 0, err
+	}
+	return st, height, nil
+}
+
 func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorSetParam) (*ValidatorSet, error) {
 	set, deltas, height := qs.blockchain.ValidatorsHistory()
 	vs := &ValidatorSet{
@@ -11,7 +11,12 @@
 		StatusParam
 		GetAccountParam
 		ListAccountsParam
+		GetStorageParam
+		StorageValue
+		AccountWithProof
+		GetContractMetaParam
 		GetNameParam
+		NameWithProof
 		ListNamesParam
 		GetValidatorSetParam
 		ValidatorSet
@@ -28,8 +33,10 @@ import names "github.com/hyperledger/burrow/execution/names"
 import acm "github.com/hyperledger/burrow/acm"
 import validator "github.com/hyperledger/burrow/acm/validator"
 import rpc "github.com/hyperledger/burrow/rpc"
+import proof "github.com/hyperledger/burrow/execution/proof"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
+import github_com_hyperledger_burrow_binary "github.com/hyperledger/burrow/binary"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
@@ -78,6 +85,7 @@ func (*StatusParam) XXX_MessageName() string {
 
 type GetAccountParam struct {
 	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Height  uint64                                       `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
 func (m *GetAccountParam) Reset()                    { *m = GetAccountParam{} }
@@ -85,12 +93,20 @@ func (m *GetAccountParam) String() string            { return proto.CompactTextS
 func (*GetAccountParam) ProtoMessage()               {}
 func (*GetAccountParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{1} }
 
+func (m *GetAccountParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetAccountParam) XXX_MessageName() string {
 	return "rpcquery.GetAccountParam"
 }
 
 type ListAccountsParam struct {
-	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
 func (m *ListAccountsParam) Reset()                    { *m = ListAccountsParam{} }
@@ -105,18 +121,128 @@ func (m *ListAccountsParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*ListAccountsParam) XXX_MessageName() string {
 	return "rpcquery.ListAccountsParam"
 }
 
+type GetStorageParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	Height  uint64                                       `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	// Whether to return a proof of the value
+	Prove bool `protobuf:"varint,4,opt,name=Prove,proto3" json:"Prove,omitempty"`
+}
+
+func (m *GetStorageParam) Reset()                    { *m = GetStorageParam{} }
+func (m *GetStorageParam) String() string            { return proto.CompactTextString(m) }
+func (*GetStorageParam) ProtoMessage()               {}
+func (*GetStorageParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{3} }
+
+func (m *GetStorageParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetStorageParam) GetProve() bool {
+	if m != nil {
+		return m.Prove
+	}
+	return false
+}
+
+func (*GetStorageParam) XXX_MessageName() string {
+	return "rpcquery.GetStorageParam"
+}
+
+type StorageValue struct {
+	Value github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,1,opt,name=Value,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Value"`
+	Proof *proof.Proof                                 `protobuf:"bytes,2,opt,name=Proof" json:"Proof,omitempty"`
+}
+
+func (m *StorageValue) Reset()                    { *m = StorageValue{} }
+func (m *StorageValue) String() string            { return proto.CompactTextString(m) }
+func (*StorageValue) ProtoMessage()               {}
+func (*StorageValue) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+
+func (m *StorageValue) GetProof() *proof.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (*StorageValue) XXX_MessageName() string {
+	return "rpcquery.StorageValue"
+}
+
+type AccountWithProof struct {
+	// Nil if there is no account at the address
+	Account *acm.ConcreteAccount `protobuf:"bytes,1,opt,name=Account" json:"Account,omitempty"`
+	Proof   *proof.Proof         `protobuf:"bytes,2,opt,name=Proof" json:"Proof,omitempty"`
+}
+
+func (m *AccountWithProof) Reset()                    { *m = AccountWithProof{} }
+func (m *AccountWithProof) String() string            { return proto.CompactTextString(m) }
+func (*AccountWithProof) ProtoMessage()               {}
+func (*AccountWithProof) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
+
+func (m *AccountWithProof) GetAccount() *acm.ConcreteAccount {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AccountWithProof) GetProof() *proof.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (*AccountWithProof) XXX_MessageName() string {
+	return "rpcquery.AccountWithProof"
+}
+
+type GetContractMetaParam struct {
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Height  uint64                                       `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+}
+
+func (m *GetContractMetaParam) Reset()                    { *m = GetContractMetaParam{} }
+func (m *GetContractMetaParam) String() string            { return proto.CompactTextString(m) }
+func (*GetContractMetaParam) ProtoMessage()               {}
+func (*GetContractMetaParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
+
+func (m *GetContractMetaParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*GetContractMetaParam) XXX_MessageName() string {
+	return "rpcquery.GetContractMetaParam"
+}
+
 type GetNameParam struct {
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
 func (m *GetNameParam) Reset()                    { *m = GetNameParam{} }
 func (m *GetNameParam) String() string            { return proto.CompactTextString(m) }
 func (*GetNameParam) ProtoMessage()               {}
-func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{3} }
+func (*GetNameParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
 
 func (m *GetNameParam) GetName() string {
 	if m != nil {
@@ -125,18 +251,55 @@ func (m *GetNameParam) GetName() string {
 	return ""
 }
 
+func (m *GetNameParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetNameParam) XXX_MessageName() string {
 	return "rpcquery.GetNameParam"
 }
 
+type NameWithProof struct {
+	// Nil if the name is not registered
+	Entry *names.Entry `protobuf:"bytes,1,opt,name=Entry" json:"Entry,omitempty"`
+	Proof *proof.Proof `protobuf:"bytes,2,opt,name=Proof" json:"Proof,omitempty"`
+}
+
+func (m *NameWithProof) Reset()                    { *m = NameWithProof{} }
+func (m *NameWithProof) String() string            { return proto.CompactTextString(m) }
+func (*NameWithProof) ProtoMessage()               {}
+func (*NameWithProof) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{8} }
+
+func (m *NameWithProof) GetEntry() *names.Entry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+func (m *NameWithProof) GetProof() *proof.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (*NameWithProof) XXX_MessageName() string {
+	return "rpcquery.NameWithProof"
+}
+
 type ListNamesParam struct {
-	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query  string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
 func (m *ListNamesParam) Reset()                    { *m = ListNamesParam{} }
 func (m *ListNamesParam) String() string            { return proto.CompactTextString(m) }
 func (*ListNamesParam) ProtoMessage()               {}
-func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{4} }
+func (*ListNamesParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{9} }
 
 func (m *ListNamesParam) GetQuery() string {
 	if m != nil {
@@ -145,6 +308,13 @@ func (m *ListNamesParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListNamesParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*ListNamesParam) XXX_MessageName() string {
 	return "rpcquery.ListNamesParam"
 }
@@ -156,7 +326,7 @@ type GetValidatorSetParam struct {
 func (m *GetValidatorSetParam) Reset()                    { *m = GetValidatorSetParam{} }
 func (m *GetValidatorSetParam) String() string            { return proto.CompactTextString(m) }
 func (*GetValidatorSetParam) ProtoMessage()               {}
-func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{5} }
+func (*GetValidatorSetParam) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{10} }
 
 func (m *GetValidatorSetParam) GetIncludeHistory() bool {
 	if m != nil {
@@ -178,7 +348,7 @@ type ValidatorSet struct {
 func (m *ValidatorSet) Reset()                    { *m = ValidatorSet{} }
 func (m *ValidatorSet) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSet) ProtoMessage()               {}
-func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{6} }
+func (*ValidatorSet) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{11} }
 
 func (m *ValidatorSet) GetHeight() uint64 {
 	if m != nil {
@@ -212,7 +382,7 @@ type ValidatorSetDeltas struct {
 func (m *ValidatorSetDeltas) Reset()                    { *m = ValidatorSetDeltas{} }
 func (m *ValidatorSetDeltas) String() string            { return proto.CompactTextString(m) }
 func (*ValidatorSetDeltas) ProtoMessage()               {}
-func (*ValidatorSetDeltas) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{7} }
+func (*ValidatorSetDeltas) Descriptor() ([]byte, []int) { return fileDescriptorRpcquery, []int{12} }
 
 func (m *ValidatorSetDeltas) GetValidators() []*validator.Validator {
 	if m != nil {
@@ -231,8 +401,18 @@ func init() {
 	golang_proto.RegisterType((*GetAccountParam)(nil), "rpcquery.GetAccountParam")
 	proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
 	golang_proto.RegisterType((*ListAccountsParam)(nil), "rpcquery.ListAccountsParam")
+	proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
+	golang_proto.RegisterType((*GetStorageParam)(nil), "rpcquery.GetStorageParam")
+	proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	golang_proto.RegisterType((*StorageValue)(nil), "rpcquery.StorageValue")
+	proto.RegisterType((*AccountWithProof)(nil), "rpcquery.AccountWithProof")
+	golang_proto.RegisterType((*AccountWithProof)(nil), "rpcquery.AccountWithProof")
+	proto.RegisterType((*GetContractMetaParam)(nil), "rpcquery.GetContractMetaParam")
+	golang_proto.RegisterType((*GetContractMetaParam)(nil), "rpcquery.GetContractMetaParam")
 	proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
 	golang_proto.RegisterType((*GetNameParam)(nil), "rpcquery.GetNameParam")
+	proto.RegisterType((*NameWithProof)(nil), "rpcquery.NameWithProof")
+	golang_proto.RegisterType((*NameWithProof)(nil), "rpcquery.NameWithProof")
 	proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
 	golang_proto.RegisterType((*ListNamesParam)(nil), "rpcquery.ListNamesParam")
 	proto.RegisterType((*GetValidatorSetParam)(nil), "rpcquery.GetValidatorSetParam")
@@ -257,8 +437,15 @@ type QueryClient interface {
 	Status(ctx context.Context, in *StatusParam, opts ...grpc.CallOption) (*rpc.ResultStatus, error)
 	GetAccount(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*acm.ConcreteAccount, error)
 	ListAccounts(ctx context.Context, in *ListAccountsParam, opts ...grpc.CallOption) (Query_ListAccountsClient, error)
+	GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error)
 	GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error)
 	ListNames(ctx context.Context, in *ListNamesParam, opts ...grpc.CallOption) (Query_ListNamesClient, error)
+	// As GetAccount and GetName but also returning a proof of the (possibly absent) value against the state hash at the
+	// height queried
+	GetAccountWithProof(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*AccountWithProof, error)
+	GetNameWithProof(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*NameWithProof, error)
+	// Get the metadata stored on-chain when a contract was created
+	GetContractMeta(ctx context.Context, in *GetContractMetaParam, opts ...grpc.CallOption) (*acm.ContractMeta, error)
 	GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error)
 }
 
@@ -320,6 +507,15 @@ func (x *queryListAccountsClient) Recv() (*acm.ConcreteAccount, error) {
 	return m, nil
 }
 
+func (c *queryClient) GetStorage(ctx context.Context, in *GetStorageParam, opts ...grpc.CallOption) (*StorageValue, error) {
+	out := new(StorageValue)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetStorage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetName(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*names.Entry, error) {
 	out := new(names.Entry)
 	err := grpc.Invoke(ctx, "/rpcquery.Query/GetName", in, out, c.cc, opts...)
@@ -361,6 +557,33 @@ func (x *queryListNamesClient) Recv() (*names.Entry, error) {
 	return m, nil
 }
 
+func (c *queryClient) GetAccountWithProof(ctx context.Context, in *GetAccountParam, opts ...grpc.CallOption) (*AccountWithProof, error) {
+	out := new(AccountWithProof)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetAccountWithProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetNameWithProof(ctx context.Context, in *GetNameParam, opts ...grpc.CallOption) (*NameWithProof, error) {
+	out := new(NameWithProof)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetNameWithProof", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetContractMeta(ctx context.Context, in *GetContractMetaParam, opts ...grpc.CallOption) (*acm.ContractMeta, error) {
+	out := new(acm.ContractMeta)
+	err := grpc.Invoke(ctx, "/rpcquery.Query/GetContractMeta", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetValidatorSet(ctx context.Context, in *GetValidatorSetParam, opts ...grpc.CallOption) (*ValidatorSet, error) {
 	out := new(ValidatorSet)
 	err := grpc.Invoke(ctx, "/rpcquery.Query/GetValidatorSet", in, out, c.cc, opts...)
@@ -376,8 +599,15 @@ type QueryServer interface {
 	Status(context.Context, *StatusParam) (*rpc.ResultStatus, error)
 	GetAccount(context.Context, *GetAccountParam) (*acm.ConcreteAccount, error)
 	ListAccounts(*ListAccountsParam, Query_ListAccountsServer) error
+	GetStorage(context.Context, *GetStorageParam) (*StorageValue, error)
 	GetName(context.Context, *GetNameParam) (*names.Entry, error)
 	ListNames(*ListNamesParam, Query_ListNamesServer) error
+	// As GetAccount and GetName but also returning a proof of the (possibly absent) value against the state hash at the
+	// height queried
+	GetAccountWithProof(context.Context, *GetAccountParam) (*AccountWithProof, error)
+	GetNameWithProof(context.Context, *GetNameParam) (*NameWithProof, error)
+	// Get the metadata stored on-chain when a contract was created
+	GetContractMeta(context.Context, *GetContractMetaParam) (*acm.ContractMeta, error)
 	GetValidatorSet(context.Context, *GetValidatorSetParam) (*ValidatorSet, error)
 }
 
@@ -442,6 +672,24 @@ func (x *queryListAccountsServer) Send(m *acm.ConcreteAccount) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetStorage(ctx, req.(*GetStorageParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNameParam)
 	if err := dec(in); err != nil {
@@ -481,6 +729,60 @@ func (x *queryListNamesServer) Send(m *names.Entry) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_GetAccountWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetAccountWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountWithProof(ctx, req.(*GetAccountParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetNameWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNameParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNameWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetNameWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNameWithProof(ctx, req.(*GetNameParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetContractMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractMetaParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetContractMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcquery.Query/GetContractMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetContractMeta(ctx, req.(*GetContractMetaParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidatorSetParam)
 	if err := dec(in); err != nil {
@@ -511,10 +813,26 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAccount",
 			Handler:    _Query_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetStorage",
+			Handler:    _Query_GetStorage_Handler,
+		},
 		{
 			MethodName: "GetName",
 			Handler:    _Query_GetName_Handler,
 		},
+		{
+			MethodName: "GetAccountWithProof",
+			Handler:    _Query_GetAccountWithProof_Handler,
+		},
+		{
+			MethodName: "GetNameWithProof",
+			Handler:    _Query_GetNameWithProof_Handler,
+		},
+		{
+			MethodName: "GetContractMeta",
+			Handler:    _Query_GetContractMeta_Handler,
+		},
 		{
 			MethodName: "GetValidatorSet",
 			Handler:    _Query_GetValidatorSet_Handler,
@@ -588,6 +906,11 @@ func (m *GetAccountParam) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n1
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
 	return i, nil
 }
 
@@ -612,10 +935,15 @@ func (m *ListAccountsParam) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
 		i += copy(dAtA[i:], m.Query)
 	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
 	return i, nil
 }
 
-func (m *GetNameParam) Marshal() (dAtA []byte, err error) {
+func (m *GetStorageParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -625,21 +953,46 @@ func (m *GetNameParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetNameParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetStorageParam) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Address.Size()))
+	n2, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Key.Size()))
+	n3, err := m.Key.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Height != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
+	if m.Prove {
+		dAtA[i] = 0x20
+		i++
+		if m.Prove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i++
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
 	}
 	return i, nil
 }
 
-func (m *ListNamesParam) Marshal() (dAtA []byte, err error) {
+func (m *StorageValue) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -649,21 +1002,33 @@ func (m *ListNamesParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *StorageValue) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Query) > 0 {
-		dAtA[i] = 0xa
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Value.Size()))
+	n4, err := m.Value.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if m.Proof != nil {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
-		i += copy(dAtA[i:], m.Query)
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Proof.Size()))
+		n5, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	return i, nil
 }
 
-func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
+func (m *AccountWithProof) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -673,25 +1038,35 @@ func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetValidatorSetParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *AccountWithProof) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeHistory {
-		dAtA[i] = 0x8
+	if m.Account != nil {
+		dAtA[i] = 0xa
 		i++
-		if m.IncludeHistory {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Account.Size()))
+		n6, err := m.Account.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n6
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x12
 		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Proof.Size()))
+		n7, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
 	return i, nil
 }
 
-func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
+func (m *GetContractMetaParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -701,26 +1076,181 @@ func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetContractMetaParam) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintRpcquery(dAtA, i, uint64(m.Address.Size()))
+	n8, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
 	if m.Height != 0 {
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
 		i++
 		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
 	}
-	if len(m.Set) > 0 {
-		for _, msg := range m.Set {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintRpcquery(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return i, nil
+}
+
+func (m *GetNameParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNameParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *NameWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameWithProof) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Entry != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Entry.Size()))
+		n9, err := m.Entry.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Proof != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Proof.Size()))
+		n10, err := m.Proof.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	return i, nil
+}
+
+func (m *ListNamesParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNamesParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Query) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(len(m.Query)))
+		i += copy(dAtA[i:], m.Query)
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
+func (m *GetValidatorSetParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorSetParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeHistory {
+		dAtA[i] = 0x8
+		i++
+		if m.IncludeHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ValidatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSet) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpcquery(dAtA, i, uint64(m.Height))
+	}
+	if len(m.Set) > 0 {
+		for _, msg := range m.Set {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintRpcquery(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if len(m.History) > 0 {
@@ -796,6 +1326,9 @@ func (m *GetAccountParam) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -806,6 +1339,62 @@ func (m *ListAccountsParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *GetStorageParam) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	l = m.Key.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	if m.Prove {
+		n += 2
+	}
+	return n
+}
+
+func (m *StorageValue) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Value.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountWithProof) Size() (n int) {
+	var l int
+	_ = l
+	if m.Account != nil {
+		l = m.Account.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetContractMetaParam) Size() (n int) {
+	var l int
+	_ = l
+	l = m.Address.Size()
+	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -816,6 +1405,23 @@ func (m *GetNameParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *NameWithProof) Size() (n int) {
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
 	return n
 }
 
@@ -826,6 +1432,9 @@ func (m *ListNamesParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	return n
 }
 
@@ -1051,6 +1660,25 @@ func (m *GetAccountParam) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1130,6 +1758,25 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1151,7 +1798,7 @@ func (m *ListAccountsParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+func (m *GetStorageParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,17 +1821,17 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetStorageParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetStorageParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpcquery
@@ -1194,21 +1841,633 @@ func (m *GetNameParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRpcquery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Key.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Prove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Account == nil {
+				m.Account = &acm.ConcreteAccount{}
+			}
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetContractMetaParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetContractMetaParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetContractMetaParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetNameParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetNameParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetNameParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpcquery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameWithProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpcquery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &names.Entry{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpcquery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1288,6 +2547,25 @@ func (m *ListNamesParam) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcquery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcquery(dAtA[iNdEx:])
@@ -1700,40 +2978,55 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptorRpcquery) }
 
 var fileDescriptorRpcquery = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x8e, 0xd2, 0x40,
-	0x18, 0xb5, 0xb0, 0xc2, 0xf2, 0x41, 0x20, 0x3b, 0x22, 0xc1, 0x6a, 0xba, 0x9b, 0x5e, 0x10, 0x34,
-	0xda, 0x12, 0x5c, 0xbd, 0xd3, 0xb8, 0xac, 0x06, 0xd7, 0x98, 0x8d, 0x16, 0xa3, 0x89, 0x77, 0x65,
-	0x18, 0xa1, 0xb1, 0xed, 0xd4, 0xe9, 0x54, 0xc3, 0x0b, 0xf8, 0x5c, 0x5e, 0x72, 0xe9, 0xb5, 0x17,
-	0x1b, 0xc3, 0xbe, 0x81, 0x4f, 0x60, 0x3a, 0x9d, 0xd2, 0xf2, 0x13, 0xee, 0xbe, 0xf3, 0xcd, 0x39,
-	0xdf, 0xe9, 0xcc, 0x9c, 0x29, 0xd4, 0x59, 0x80, 0xbf, 0x45, 0x84, 0xcd, 0x8d, 0x80, 0x51, 0x4e,
-	0xd1, 0x61, 0x8a, 0xd5, 0x47, 0x53, 0x87, 0xcf, 0xa2, 0xb1, 0x81, 0xa9, 0x67, 0x4e, 0xe9, 0x94,
-	0x9a, 0x82, 0x30, 0x8e, 0xbe, 0x08, 0x24, 0x80, 0xa8, 0x12, 0xa1, 0x5a, 0xf5, 0x6d, 0x8f, 0x84,
-	0x12, 0x54, 0x6c, 0xec, 0xc9, 0xb2, 0xf1, 0xdd, 0x76, 0x9d, 0x89, 0xcd, 0x29, 0x4b, 0xd7, 0x58,
-	0x80, 0x93, 0x52, 0x77, 0xa0, 0x3a, 0xe2, 0x36, 0x8f, 0xc2, 0x77, 0x36, 0xb3, 0x3d, 0xd4, 0x85,
-	0xc6, 0xc0, 0xa5, 0xf8, 0xeb, 0x07, 0xc7, 0x23, 0x9f, 0x1c, 0x3e, 0x73, 0xfc, 0xb6, 0x72, 0xa2,
-	0x74, 0x2b, 0xd6, 0x66, 0x1b, 0xf5, 0xe0, 0x96, 0x68, 0x8d, 0x08, 0xf1, 0x73, 0xec, 0x82, 0x60,
-	0xef, 0x5a, 0xd2, 0x6d, 0x68, 0x0c, 0x09, 0x3f, 0xc3, 0x98, 0x46, 0x3e, 0x4f, 0xec, 0x2e, 0xa1,
-	0x7c, 0x36, 0x99, 0x30, 0x12, 0x86, 0xc2, 0xa6, 0x36, 0x38, 0x5d, 0x5c, 0x1d, 0xdf, 0xf8, 0x73,
-	0x75, 0xfc, 0x30, 0xb7, 0xf3, 0xd9, 0x3c, 0x20, 0xcc, 0x25, 0x93, 0x29, 0x61, 0xe6, 0x38, 0x62,
-	0x8c, 0xfe, 0x30, 0x31, 0x9b, 0x07, 0x9c, 0x1a, 0x52, 0x6b, 0xa5, 0x43, 0xf4, 0xfb, 0x70, 0xf4,
-	0xd6, 0x09, 0x53, 0x0f, 0xb9, 0xa7, 0x26, 0xdc, 0x7c, 0x1f, 0x1f, 0xa7, 0xdc, 0x49, 0x02, 0x74,
-	0x1d, 0x6a, 0x43, 0xc2, 0x2f, 0x6d, 0x8f, 0x24, 0x2c, 0x04, 0x07, 0x31, 0x90, 0x24, 0x51, 0xeb,
-	0x1d, 0xa8, 0xc7, 0xe3, 0xe2, 0x7a, 0xef, 0xac, 0xe7, 0xd0, 0x1c, 0x12, 0xfe, 0x31, 0x3d, 0xe5,
-	0x11, 0x91, 0xdb, 0xeb, 0x40, 0xfd, 0xc2, 0xc7, 0x6e, 0x34, 0x21, 0xaf, 0x9d, 0x90, 0x53, 0x29,
-	0x3b, 0xb4, 0x36, 0xba, 0xfa, 0x4f, 0x05, 0x6a, 0x79, 0x35, 0x6a, 0x41, 0x69, 0x46, 0x9c, 0xe9,
-	0x8c, 0x0b, 0xc1, 0x81, 0x25, 0x11, 0xea, 0x40, 0x71, 0x44, 0x78, 0xbb, 0x70, 0x52, 0xec, 0x56,
-	0xfb, 0x4d, 0x23, 0xbb, 0xd7, 0x95, 0xda, 0x8a, 0x09, 0xe8, 0x29, 0x94, 0x53, 0xc7, 0xa2, 0xe0,
-	0xde, 0x33, 0x56, 0x21, 0xcb, 0x1b, 0xbd, 0x24, 0x2e, 0xb7, 0x43, 0x2b, 0x25, 0xeb, 0x6f, 0x00,
-	0x6d, 0x2f, 0xa3, 0x53, 0x80, 0x55, 0x37, 0xdc, 0x6b, 0x9e, 0xe3, 0xf5, 0xff, 0x15, 0xe4, 0x59,
-	0xa1, 0x3e, 0x94, 0x92, 0x8c, 0xa1, 0xdb, 0xd9, 0x67, 0xe4, 0x52, 0xa7, 0x1e, 0xc5, 0x6d, 0xc3,
-	0x22, 0x61, 0xe4, 0x72, 0xc9, 0x7c, 0x06, 0x90, 0x85, 0x05, 0xdd, 0xc9, 0x74, 0x1b, 0x11, 0x52,
-	0x9b, 0x46, 0x1c, 0xf4, 0x73, 0xea, 0x63, 0x46, 0x38, 0x49, 0x05, 0xe7, 0x50, 0xcb, 0x07, 0x01,
-	0xdd, 0xcd, 0x06, 0x6c, 0x05, 0x64, 0xf7, 0x88, 0x9e, 0x82, 0x4c, 0x28, 0xcb, 0x88, 0xa0, 0xd6,
-	0xda, 0x07, 0xac, 0x52, 0xa3, 0xd6, 0x8c, 0xe4, 0xcd, 0xbd, 0xf2, 0x39, 0x9b, 0xa3, 0x27, 0x50,
-	0x59, 0xe5, 0x05, 0xb5, 0xd7, 0x2d, 0xb3, 0x10, 0xad, 0x8b, 0x7a, 0x0a, 0xba, 0x10, 0x0f, 0x63,
-	0x2d, 0x00, 0xda, 0x9a, 0xdf, 0x56, 0xb2, 0xd4, 0xd6, 0xee, 0xfb, 0x1c, 0xbc, 0x58, 0x2c, 0x35,
-	0xe5, 0xf7, 0x52, 0x53, 0xfe, 0x2e, 0x35, 0xe5, 0xd7, 0xb5, 0xa6, 0x2c, 0xae, 0x35, 0xe5, 0xf3,
-	0x83, 0xfd, 0xaf, 0x89, 0x05, 0xd8, 0x4c, 0xc7, 0x8d, 0x4b, 0xe2, 0xbf, 0xf0, 0xf8, 0x7f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xce, 0xc2, 0x7a, 0x96, 0x04, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4f, 0x13, 0x4d,
+	0x18, 0x7f, 0x97, 0x52, 0x0a, 0x4f, 0xfb, 0x52, 0x18, 0x78, 0x79, 0xeb, 0x6a, 0x0a, 0xd9, 0x03,
+	0x21, 0x46, 0xb7, 0xa4, 0x02, 0x07, 0x13, 0x51, 0x28, 0xca, 0x87, 0x48, 0x70, 0x6b, 0x20, 0xf1,
+	0xb6, 0xdd, 0x4e, 0xdb, 0x8d, 0xed, 0x4e, 0x9d, 0x9d, 0xc5, 0xf4, 0xc2, 0xd1, 0x93, 0x7f, 0x94,
+	0x47, 0x8e, 0xde, 0x4c, 0x3c, 0x10, 0x03, 0xff, 0x88, 0xd9, 0x99, 0xd9, 0xee, 0x6c, 0x0b, 0x04,
+	0x35, 0x7a, 0x9b, 0xe7, 0xfb, 0x63, 0x7f, 0xcf, 0x2f, 0x0b, 0x93, 0xb4, 0xeb, 0xbc, 0x0f, 0x30,
+	0xed, 0x99, 0x5d, 0x4a, 0x18, 0x41, 0xe3, 0x91, 0xac, 0x3f, 0x6c, 0xba, 0xac, 0x15, 0xd4, 0x4c,
+	0x87, 0x74, 0x4a, 0x4d, 0xd2, 0x24, 0x25, 0xee, 0x50, 0x0b, 0x1a, 0x5c, 0xe2, 0x02, 0x7f, 0x89,
+	0x40, 0x3d, 0xeb, 0xd9, 0x1d, 0xec, 0x4b, 0x61, 0xc2, 0x76, 0x3a, 0xf2, 0x99, 0x3f, 0xb1, 0xdb,
+	0x6e, 0xdd, 0x66, 0x84, 0x46, 0x36, 0xda, 0x75, 0xa2, 0x98, 0x2e, 0x25, 0xa4, 0x21, 0x04, 0xc3,
+	0x85, 0x6c, 0x95, 0xd9, 0x2c, 0xf0, 0x0f, 0x6d, 0x6a, 0x77, 0xd0, 0x12, 0xe4, 0x37, 0xdb, 0xc4,
+	0x79, 0xf7, 0xc6, 0xed, 0xe0, 0x63, 0x97, 0xb5, 0x5c, 0xaf, 0xa0, 0x2d, 0x68, 0x4b, 0x13, 0xd6,
+	0xa0, 0x1a, 0x2d, 0xc3, 0x0c, 0x57, 0x55, 0x31, 0xf6, 0x14, 0xef, 0x11, 0xee, 0x7d, 0x95, 0xc9,
+	0xe8, 0x41, 0x7e, 0x1b, 0xb3, 0x0d, 0xc7, 0x21, 0x81, 0xc7, 0x44, 0xb9, 0x03, 0xc8, 0x6c, 0xd4,
+	0xeb, 0x14, 0xfb, 0x3e, 0x2f, 0x93, 0xdb, 0x5c, 0x39, 0x3b, 0x9f, 0xff, 0xe7, 0xdb, 0xf9, 0xfc,
+	0x03, 0x65, 0x0d, 0xad, 0x5e, 0x17, 0xd3, 0x36, 0xae, 0x37, 0x31, 0x2d, 0xd5, 0x02, 0x4a, 0xc9,
+	0x87, 0x92, 0x43, 0x7b, 0x5d, 0x46, 0x4c, 0x19, 0x6b, 0x45, 0x49, 0xd0, 0x1c, 0x8c, 0xed, 0x60,
+	0xb7, 0xd9, 0x62, 0xbc, 0x8f, 0x51, 0x4b, 0x4a, 0xc6, 0x06, 0x4c, 0xef, 0xbb, 0x7e, 0x54, 0x5b,
+	0xce, 0x3a, 0x0b, 0xe9, 0xd7, 0xe1, 0xce, 0xe5, 0x84, 0x42, 0xb8, 0x36, 0xc5, 0x57, 0x8d, 0xb7,
+	0x5f, 0x65, 0x84, 0xda, 0x4d, 0xfc, 0x67, 0xda, 0x7f, 0x01, 0xa9, 0x97, 0xb8, 0x57, 0x18, 0xf9,
+	0x99, 0x5c, 0x35, 0xd7, 0xb3, 0x69, 0xcf, 0x3c, 0x26, 0xb4, 0x5e, 0x5e, 0x5d, 0xb3, 0xc2, 0x04,
+	0xca, 0x0c, 0x29, 0x75, 0x86, 0x70, 0xe2, 0x43, 0x4a, 0x4e, 0x70, 0x61, 0x74, 0x41, 0x5b, 0x1a,
+	0xb7, 0x84, 0x60, 0x9c, 0x42, 0x4e, 0x4e, 0x75, 0x64, 0xb7, 0x03, 0x8c, 0xf6, 0x20, 0xcd, 0x1f,
+	0x05, 0xed, 0x37, 0xfa, 0x10, 0x29, 0x90, 0xc1, 0x2b, 0x92, 0x06, 0x9f, 0x29, 0x5b, 0xce, 0x99,
+	0x02, 0x7b, 0x5c, 0x67, 0x09, 0x93, 0xd1, 0x80, 0x29, 0xf9, 0x61, 0x42, 0xa0, 0x70, 0x1d, 0x32,
+	0x21, 0x23, 0x75, 0xbc, 0x8b, 0x6c, 0x79, 0xd6, 0x0c, 0xc1, 0x5d, 0x21, 0x9e, 0x43, 0x31, 0xc3,
+	0xd2, 0x66, 0x45, 0x4e, 0xb7, 0xaa, 0x73, 0x0a, 0xb3, 0xdb, 0x98, 0x55, 0x88, 0xc7, 0xa8, 0xed,
+	0xb0, 0x57, 0x98, 0xd9, 0x7f, 0x17, 0x84, 0x8f, 0x21, 0xb7, 0x8d, 0xd9, 0x81, 0xdd, 0x91, 0xe8,
+	0x41, 0x30, 0x1a, 0x0a, 0x12, 0x7e, 0xfc, 0x7d, 0x6d, 0xec, 0x31, 0xfc, 0x1b, 0xda, 0xe3, 0x05,
+	0x19, 0x90, 0x7e, 0xee, 0x31, 0x09, 0xde, 0x70, 0x60, 0x41, 0x04, 0x5c, 0x67, 0x09, 0xd3, 0xad,
+	0x96, 0xb2, 0x0e, 0x93, 0xe1, 0x65, 0x84, 0xc9, 0x7f, 0xe9, 0x2c, 0xd6, 0xf9, 0x52, 0x8f, 0x22,
+	0xb6, 0xa9, 0x62, 0x79, 0xd9, 0x8b, 0x30, 0xb9, 0xeb, 0x39, 0xed, 0xa0, 0x8e, 0x77, 0x5c, 0x9f,
+	0x11, 0x99, 0x6e, 0xdc, 0x1a, 0xd0, 0x1a, 0x1f, 0x35, 0xc8, 0xa9, 0xd1, 0x61, 0xa1, 0x96, 0x28,
+	0xa4, 0x89, 0x42, 0x42, 0x42, 0x8b, 0x90, 0xaa, 0xe2, 0xb0, 0x7a, 0x8a, 0xa3, 0x21, 0xe6, 0xb7,
+	0x7e, 0xb4, 0x15, 0x3a, 0xa0, 0x35, 0xc8, 0x44, 0x15, 0x53, 0xdc, 0xf7, 0x9e, 0xd9, 0x27, 0x5b,
+	0xb5, 0xd0, 0x16, 0x6e, 0x33, 0xdb, 0xb7, 0x22, 0x67, 0x63, 0x0f, 0xd0, 0xb0, 0x19, 0xad, 0x00,
+	0xf4, 0xb5, 0xfe, 0x8d, 0xc5, 0x15, 0xbf, 0xf2, 0xa7, 0xb4, 0xdc, 0x21, 0x2a, 0xc3, 0x98, 0xa0,
+	0x57, 0xf4, 0x5f, 0xdc, 0x86, 0x42, 0xb8, 0xfa, 0x74, 0xa8, 0x36, 0x2d, 0xec, 0x07, 0x6d, 0x26,
+	0x3d, 0x9f, 0x00, 0xc4, 0x3c, 0x89, 0xee, 0xc4, 0x71, 0x03, 0xec, 0xa9, 0x5f, 0x79, 0x13, 0xa8,
+	0x02, 0x39, 0x95, 0xeb, 0xd0, 0xdd, 0x38, 0xc1, 0x10, 0x07, 0x5e, 0x9d, 0x62, 0x59, 0x43, 0x4f,
+	0x79, 0x0f, 0x92, 0x16, 0x06, 0x7a, 0x50, 0x29, 0x50, 0x9f, 0x53, 0xc7, 0x52, 0x48, 0xa4, 0x04,
+	0x19, 0x09, 0x76, 0x34, 0x97, 0x88, 0xee, 0xe3, 0x5f, 0x4f, 0x60, 0x16, 0xad, 0xc2, 0x44, 0x1f,
+	0x88, 0xa8, 0x90, 0xec, 0x39, 0x46, 0x67, 0x32, 0x68, 0x59, 0x43, 0xfb, 0x30, 0x13, 0xaf, 0x25,
+	0x3e, 0x8f, 0x1b, 0xb6, 0xa6, 0xc7, 0xa6, 0xa1, 0xb0, 0x0a, 0x4c, 0xc9, 0x16, 0x63, 0xdd, 0x75,
+	0xed, 0xff, 0x1f, 0xeb, 0x93, 0x01, 0x5b, 0x90, 0x1f, 0xe0, 0x19, 0x54, 0x4c, 0xe4, 0x18, 0xa2,
+	0x20, 0x7d, 0x3a, 0xfa, 0x0c, 0x71, 0xc8, 0x2e, 0xcf, 0x92, 0x38, 0x8d, 0x64, 0x96, 0xa1, 0x9b,
+	0x53, 0xbf, 0x85, 0x6a, 0xdc, 0x7c, 0x76, 0x76, 0x51, 0xd4, 0xbe, 0x5c, 0x14, 0xb5, 0xef, 0x17,
+	0x45, 0xed, 0xf3, 0x65, 0x51, 0x3b, 0xbb, 0x2c, 0x6a, 0x6f, 0xef, 0xdf, 0xcc, 0x6e, 0xb4, 0xeb,
+	0x94, 0xa2, 0x74, 0xb5, 0x31, 0xfe, 0xb3, 0xf0, 0xe8, 0xc7, 0x00, 0x86, 0x98, 0x32, 0xb9, 0xb8,
+	0x08, 0x00, 0x00,
 }
@@ -8,9 +8,16 @@
 		rpctransact.proto
 
 	It has these top-level messages:
+		CallTxSimParam
 		CallCodeParam
+		CallTxTraceParam
+		GasEstimate
 		TxEnvelope
 		TxEnvelopeParam
+		TxBatchParam
+		TxBatchResult
+		QueuedTxsParam
+		QueuedTx
 */
 package rpctransact
 
@@ -19,16 +26,21 @@ import golang_proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import _ "github.com/golang/protobuf/ptypes/timestamp"
+import errors "github.com/hyperledger/burrow/execution/errors"
 import exec "github.com/hyperledger/burrow/execution/exec"
 import payload "github.com/hyperledger/burrow/txs/payload"
 import txs "github.com/hyperledger/burrow/txs"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
 import github_com_hyperledger_burrow_txs "github.com/hyperledger/burrow/txs"
+import time "time"
 
 import context "golang.org/x/net/context"
 import grpc "google.golang.org/grpc"
 
+import types "github.com/gogo/protobuf/types"
+
 import io "io"
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -36,6 +48,7 @@ var _ = proto.Marshal
 var _ = golang_proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -43,16 +56,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type CallTxSimParam struct {
+	CallTx *payload.CallTx `protobuf:"bytes,1,opt,name=CallTx" json:"CallTx,omitempty"`
+	// Simulate against the state as it was once the block at Height was committed (zero for the latest state)
+	Height uint64 `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+}
+
+func (m *CallTxSimParam) Reset()                    { *m = CallTxSimParam{} }
+func (m *CallTxSimParam) String() string            { return proto.CompactTextString(m) }
+func (*CallTxSimParam) ProtoMessage()               {}
+func (*CallTxSimParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{0} }
+
+func (m *CallTxSimParam) GetCallTx() *payload.CallTx {
+	if m != nil {
+		return m.CallTx
+	}
+	return nil
+}
+
+func (m *CallTxSimParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (*CallTxSimParam) XXX_MessageName() string {
+	return "rpctransact.CallTxSimParam"
+}
+
 type CallCodeParam struct {
 	FromAddress github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=FromAddress,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"FromAddress"`
 	Code        []byte                                       `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
 	Data        []byte                                       `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	// Simulate against the state as it was once the block at Height was committed (zero for the latest state)
+	Height uint64 `protobuf:"varint,4,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
 func (m *CallCodeParam) Reset()                    { *m = CallCodeParam{} }
 func (m *CallCodeParam) String() string            { return proto.CompactTextString(m) }
 func (*CallCodeParam) ProtoMessage()               {}
-func (*CallCodeParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{0} }
+func (*CallCodeParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{1} }
 
 func (m *CallCodeParam) GetCode() []byte {
 	if m != nil {
@@ -68,10 +112,93 @@ func (m *CallCodeParam) GetData() []byte {
 	return nil
 }
 
+func (m *CallCodeParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*CallCodeParam) XXX_MessageName() string {
 	return "rpctransact.CallCodeParam"
 }
 
+type CallTxTraceParam struct {
+	CallTx      *payload.CallTx   `protobuf:"bytes,1,opt,name=CallTx" json:"CallTx,omitempty"`
+	TraceConfig *exec.TraceConfig `protobuf:"bytes,2,opt,name=TraceConfig" json:"TraceConfig,omitempty"`
+}
+
+func (m *CallTxTraceParam) Reset()                    { *m = CallTxTraceParam{} }
+func (m *CallTxTraceParam) String() string            { return proto.CompactTextString(m) }
+func (*CallTxTraceParam) ProtoMessage()               {}
+func (*CallTxTraceParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{2} }
+
+func (m *CallTxTraceParam) GetCallTx() *payload.CallTx {
+	if m != nil {
+		return m.CallTx
+	}
+	return nil
+}
+
+func (m *CallTxTraceParam) GetTraceConfig() *exec.TraceConfig {
+	if m != nil {
+		return m.TraceConfig
+	}
+	return nil
+}
+
+func (*CallTxTraceParam) XXX_MessageName() string {
+	return "rpctransact.CallTxTraceParam"
+}
+
+type GasEstimate struct {
+	// The smallest gas limit with which the transaction succeeds (zero if it fails with the maximum gas limit)
+	GasLimit uint64 `protobuf:"varint,1,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	// The gas used by the transaction when run with GasLimit (or with the maximum gas limit if it failed)
+	GasUsed uint64 `protobuf:"varint,2,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	// The exception with which the transaction fails with the maximum gas limit
+	Exception *errors.Exception `protobuf:"bytes,3,opt,name=Exception" json:"Exception,omitempty"`
+	// The reason given by the contract if it reverted
+	RevertReason string `protobuf:"bytes,4,opt,name=RevertReason,proto3" json:"RevertReason,omitempty"`
+}
+
+func (m *GasEstimate) Reset()                    { *m = GasEstimate{} }
+func (m *GasEstimate) String() string            { return proto.CompactTextString(m) }
+func (*GasEstimate) ProtoMessage()               {}
+func (*GasEstimate) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{3} }
+
+func (m *GasEstimate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *GasEstimate) GetException() *errors.Exception {
+	if m != nil {
+		return m.Exception
+	}
+	return nil
+}
+
+func (m *GasEstimate) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (*GasEstimate) XXX_MessageName() string {
+	return "rpctransact.GasEstimate"
+}
+
 type TxEnvelope struct {
 	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
 }
@@ -79,7 +206,7 @@ type TxEnvelope struct {
 func (m *TxEnvelope) Reset()                    { *m = TxEnvelope{} }
 func (m *TxEnvelope) String() string            { return proto.CompactTextString(m) }
 func (*TxEnvelope) ProtoMessage()               {}
-func (*TxEnvelope) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{1} }
+func (*TxEnvelope) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{4} }
 
 func (*TxEnvelope) XXX_MessageName() string {
 	return "rpctransact.TxEnvelope"
@@ -95,7 +222,7 @@ type TxEnvelopeParam struct {
 func (m *TxEnvelopeParam) Reset()                    { *m = TxEnvelopeParam{} }
 func (m *TxEnvelopeParam) String() string            { return proto.CompactTextString(m) }
 func (*TxEnvelopeParam) ProtoMessage()               {}
-func (*TxEnvelopeParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{2} }
+func (*TxEnvelopeParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{5} }
 
 func (m *TxEnvelopeParam) GetPayload() *payload.Any {
 	if m != nil {
@@ -107,13 +234,122 @@ func (m *TxEnvelopeParam) GetPayload() *payload.Any {
 func (*TxEnvelopeParam) XXX_MessageName() string {
 	return "rpctransact.TxEnvelopeParam"
 }
+
+type TxBatchParam struct {
+	Txs    []*TxEnvelopeParam `protobuf:"bytes,1,rep,name=Txs" json:"Txs,omitempty"`
+	Atomic bool               `protobuf:"varint,2,opt,name=Atomic,proto3" json:"Atomic,omitempty"`
+}
+
+func (m *TxBatchParam) Reset()                    { *m = TxBatchParam{} }
+func (m *TxBatchParam) String() string            { return proto.CompactTextString(m) }
+func (*TxBatchParam) ProtoMessage()               {}
+func (*TxBatchParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{6} }
+
+func (m *TxBatchParam) GetTxs() []*TxEnvelopeParam {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *TxBatchParam) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (*TxBatchParam) XXX_MessageName() string {
+	return "rpctransact.TxBatchParam"
+}
+
+type TxBatchResult struct {
+	// The execution of each transaction, or of the single BatchTx if the batch was Atomic
+	TxExecutions []*exec.TxExecution `protobuf:"bytes,1,rep,name=TxExecutions" json:"TxExecutions,omitempty"`
+}
+
+func (m *TxBatchResult) Reset()                    { *m = TxBatchResult{} }
+func (m *TxBatchResult) String() string            { return proto.CompactTextString(m) }
+func (*TxBatchResult) ProtoMessage()               {}
+func (*TxBatchResult) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{7} }
+
+func (m *TxBatchResult) GetTxExecutions() []*exec.TxExecution {
+	if m != nil {
+		return m.TxExecutions
+	}
+	return nil
+}
+
+func (*TxBatchResult) XXX_MessageName() string {
+	return "rpctransact.TxBatchResult"
+}
+
+type QueuedTxsParam struct {
+	// Only list transactions queued on the sequence of this input address (all are listed if omitted)
+	Address *github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
+}
+
+func (m *QueuedTxsParam) Reset()                    { *m = QueuedTxsParam{} }
+func (m *QueuedTxsParam) String() string            { return proto.CompactTextString(m) }
+func (*QueuedTxsParam) ProtoMessage()               {}
+func (*QueuedTxsParam) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{8} }
+
+func (*QueuedTxsParam) XXX_MessageName() string {
+	return "rpctransact.QueuedTxsParam"
+}
+
+type QueuedTx struct {
+	Envelope *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,1,opt,name=Envelope,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	// The input whose sequence number is ahead of the mempool
+	Address  github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,2,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Sequence uint64                                       `protobuf:"varint,3,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// When the transaction will be dropped if it is still queued
+	Expires time.Time `protobuf:"bytes,4,opt,name=Expires,stdtime" json:"Expires"`
+}
+
+func (m *QueuedTx) Reset()                    { *m = QueuedTx{} }
+func (m *QueuedTx) String() string            { return proto.CompactTextString(m) }
+func (*QueuedTx) ProtoMessage()               {}
+func (*QueuedTx) Descriptor() ([]byte, []int) { return fileDescriptorRpctransact, []int{9} }
+
+func (m *QueuedTx) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *QueuedTx) GetExpires() time.Time {
+	if m != nil {
+		return m.Expires
+	}
+	return time.Time{}
+}
+
+func (*QueuedTx) XXX_MessageName() string {
+	return "rpctransact.QueuedTx"
+}
 func init() {
+	proto.RegisterType((*CallTxSimParam)(nil), "rpctransact.CallTxSimParam")
+	golang_proto.RegisterType((*CallTxSimParam)(nil), "rpctransact.CallTxSimParam")
 	proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
 	golang_proto.RegisterType((*CallCodeParam)(nil), "rpctransact.CallCodeParam")
+	proto.RegisterType((*CallTxTraceParam)(nil), "rpctransact.CallTxTraceParam")
+	golang_proto.RegisterType((*CallTxTraceParam)(nil), "rpctransact.CallTxTraceParam")
+	proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
+	golang_proto.RegisterType((*GasEstimate)(nil), "rpctransact.GasEstimate")
 	proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	golang_proto.RegisterType((*TxEnvelope)(nil), "rpctransact.TxEnvelope")
 	proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
 	golang_proto.RegisterType((*TxEnvelopeParam)(nil), "rpctransact.TxEnvelopeParam")
+	proto.RegisterType((*TxBatchParam)(nil), "rpctransact.TxBatchParam")
+	golang_proto.RegisterType((*TxBatchParam)(nil), "rpctransact.TxBatchParam")
+	proto.RegisterType((*TxBatchResult)(nil), "rpctransact.TxBatchResult")
+	golang_proto.RegisterType((*TxBatchResult)(nil), "rpctransact.TxBatchResult")
+	proto.RegisterType((*QueuedTxsParam)(nil), "rpctransact.QueuedTxsParam")
+	golang_proto.RegisterType((*QueuedTxsParam)(nil), "rpctransact.QueuedTxsParam")
+	proto.RegisterType((*QueuedTx)(nil), "rpctransact.QueuedTx")
+	golang_proto.RegisterType((*QueuedTx)(nil), "rpctransact.QueuedTx")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -132,6 +368,10 @@ type TransactClient interface {
 	BroadcastTxSync(ctx context.Context, in *TxEnvelopeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Broadcast a transaction to the mempool - if the transaction is not signed signing will be attempted server-side
 	BroadcastTxAsync(ctx context.Context, in *TxEnvelopeParam, opts ...grpc.CallOption) (*txs.Receipt, error)
+	// Broadcast several transactions in order (signing server-side any that are not signed) and wait for them all to be
+	// included in blocks. If Atomic the unsigned transactions are instead combined into a single BatchTx that is signed
+	// server-side so that either all of them take effect or none do.
+	BroadcastTxBatch(ctx context.Context, in *TxBatchParam, opts ...grpc.CallOption) (*TxBatchResult, error)
 	// Sign transaction server-side
 	SignTx(ctx context.Context, in *TxEnvelopeParam, opts ...grpc.CallOption) (*TxEnvelope, error)
 	// Formulate a transaction from a Payload and retrun the envelop with the Tx bytes ready to sign
@@ -141,10 +381,17 @@ type TransactClient interface {
 	// Formulate and sign a CallTx transaction signed server-side
 	CallTxAsync(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*txs.Receipt, error)
 	// Perform a 'simulated' call of a contract against the current committed EVM state without any changes been saved
-	// and wait for the transaction to be included in a block
 	CallTxSim(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
-	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
+	// Perform a 'simulated' call of a contract as with CallTxSim but against the state as it was at a historical height
+	CallTxSimAtHeight(ctx context.Context, in *CallTxSimParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Perform a 'simulated' execution of provided code against the current (or historical) committed EVM state without
+	// any changes been saved
 	CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error)
+	// Perform a 'simulated' call as with CallTxSim returning a trace of the EVM
+	CallTxSimTrace(ctx context.Context, in *CallTxTraceParam, opts ...grpc.CallOption) (*exec.Trace, error)
+	// Find the smallest gas limit with which a CallTx (that may create a contract) succeeds against the current state
+	// by simulating it repeatedly. The search is bounded above by the GasLimit of the CallTx if it is set.
+	EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -153,6 +400,9 @@ type TransactClient interface {
 	NameTxSync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*exec.TxExecution, error)
 	// Formulate a NameTx signed server-side
 	NameTxAsync(ctx context.Context, in *payload.NameTx, opts ...grpc.CallOption) (*txs.Receipt, error)
+	// List the signed transactions held back from the mempool (when the future transaction queue is enabled) because
+	// the sequence number of one of their inputs is ahead of the mempool. They are broadcast once the gap is filled.
+	ListQueuedTxs(ctx context.Context, in *QueuedTxsParam, opts ...grpc.CallOption) (Transact_ListQueuedTxsClient, error)
 }
 
 type transactClient struct {
@@ -181,6 +431,15 @@ func (c *transactClient) BroadcastTxAsync(ctx context.Context, in *TxEnvelopePar
 	return out, nil
 }
 
+func (c *transactClient) BroadcastTxBatch(ctx context.Context, in *TxBatchParam, opts ...grpc.CallOption) (*TxBatchResult, error) {
+	out := new(TxBatchResult)
+	err := grpc.Invoke(ctx, "/rpctransact.Transact/BroadcastTxBatch", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) SignTx(ctx context.Context, in *TxEnvelopeParam, opts ...grpc.CallOption) (*TxEnvelope, error) {
 	out := new(TxEnvelope)
 	err := grpc.Invoke(ctx, "/rpctransact.Transact/SignTx", in, out, c.cc, opts...)
@@ -226,6 +485,15 @@ func (c *transactClient) CallTxSim(ctx context.Context, in *payload.CallTx, opts
 	return out, nil
 }
 
+func (c *transactClient) CallTxSimAtHeight(ctx context.Context, in *CallTxSimParam, opts ...grpc.CallOption) (*exec.TxExecution, error) {
+	out := new(exec.TxExecution)
+	err := grpc.Invoke(ctx, "/rpctransact.Transact/CallTxSimAtHeight", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := grpc.Invoke(ctx, "/rpctransact.Transact/CallCodeSim", in, out, c.cc, opts...)
@@ -235,6 +503,24 @@ func (c *transactClient) CallCodeSim(ctx context.Context, in *CallCodeParam, opt
 	return out, nil
 }
 
+func (c *transactClient) CallTxSimTrace(ctx context.Context, in *CallTxTraceParam, opts ...grpc.CallOption) (*exec.Trace, error) {
+	out := new(exec.Trace)
+	err := grpc.Invoke(ctx, "/rpctransact.Transact/CallTxSimTrace", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactClient) EstimateGas(ctx context.Context, in *payload.CallTx, opts ...grpc.CallOption) (*GasEstimate, error) {
+	out := new(GasEstimate)
+	err := grpc.Invoke(ctx, "/rpctransact.Transact/EstimateGas", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transactClient) SendTxSync(ctx context.Context, in *payload.SendTx, opts ...grpc.CallOption) (*exec.TxExecution, error) {
 	out := new(exec.TxExecution)
 	err := grpc.Invoke(ctx, "/rpctransact.Transact/SendTxSync", in, out, c.cc, opts...)
@@ -271,6 +557,38 @@ func (c *transactClient) NameTxAsync(ctx context.Context, in *payload.NameTx, op
 	return out, nil
 }
 
+func (c *transactClient) ListQueuedTxs(ctx context.Context, in *QueuedTxsParam, opts ...grpc.CallOption) (Transact_ListQueuedTxsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Transact_serviceDesc.Streams[0], c.cc, "/rpctransact.Transact/ListQueuedTxs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transactListQueuedTxsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Transact_ListQueuedTxsClient interface {
+	Recv() (*QueuedTx, error)
+	grpc.ClientStream
+}
+
+type transactListQueuedTxsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transactListQueuedTxsClient) Recv() (*QueuedTx, error) {
+	m := new(QueuedTx)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Transact service
 
 type TransactServer interface {
@@ -279,6 +597,10 @@ type TransactServer interface {
 	BroadcastTxSync(context.Context, *TxEnvelopeParam) (*exec.TxExecution, error)
 	// Broadcast a transaction to the mempool - if the transaction is not signed signing will be attempted server-side
 	BroadcastTxAsync(context.Context, *TxEnvelopeParam) (*txs.Receipt, error)
+	// Broadcast several transactions in order (signing server-side any that are not signed) and wait for them all to be
+	// included in blocks. If Atomic the unsigned transactions are instead combined into a single BatchTx that is signed
+	// server-side so that either all of them take effect or none do.
+	BroadcastTxBatch(context.Context, *TxBatchParam) (*TxBatchResult, error)
 	// Sign transaction server-side
 	SignTx(context.Context, *TxEnvelopeParam) (*TxEnvelope, error)
 	// Formulate a transaction from a Payload and retrun the envelop with the Tx bytes ready to sign
@@ -288,10 +610,17 @@ type TransactServer interface {
 	// Formulate and sign a CallTx transaction signed server-side
 	CallTxAsync(context.Context, *payload.CallTx) (*txs.Receipt, error)
 	// Perform a 'simulated' call of a contract against the current committed EVM state without any changes been saved
-	// and wait for the transaction to be included in a block
 	CallTxSim(context.Context, *payload.CallTx) (*exec.TxExecution, error)
-	// Perform a 'simulated' execution of provided code against the current committed EVM state without any changes been saved
+	// Perform a 'simulated' call of a contract as with CallTxSim but against the state as it was at a historical height
+	CallTxSimAtHeight(context.Context, *CallTxSimParam) (*exec.TxExecution, error)
+	// Perform a 'simulated' execution of provided code against the current (or historical) committed EVM state without
+	// any changes been saved
 	CallCodeSim(context.Context, *CallCodeParam) (*exec.TxExecution, error)
+	// Perform a 'simulated' call as with CallTxSim returning a trace of the EVM
+	CallTxSimTrace(context.Context, *CallTxTraceParam) (*exec.Trace, error)
+	// Find the smallest gas limit with which a CallTx (that may create a contract) succeeds against the current state
+	// by simulating it repeatedly. The search is bounded above by the GasLimit of the CallTx if it is set.
+	EstimateGas(context.Context, *payload.CallTx) (*GasEstimate, error)
 	// Formulate a SendTx transaction signed server-side and wait for it to be included in a block, retrieving response
 	SendTxSync(context.Context, *payload.SendTx) (*exec.TxExecution, error)
 	// Formulate and  SendTx transaction signed server-side
@@ -300,6 +629,9 @@ type TransactServer interface {
 	NameTxSync(context.Context, *payload.NameTx) (*exec.TxExecution, error)
 	// Formulate a NameTx signed server-side
 	NameTxAsync(context.Context, *payload.NameTx) (*txs.Receipt, error)
+	// List the signed transactions held back from the mempool (when the future transaction queue is enabled) because
+	// the sequence number of one of their inputs is ahead of the mempool. They are broadcast once the gap is filled.
+	ListQueuedTxs(*QueuedTxsParam, Transact_ListQueuedTxsServer) error
 }
 
 func RegisterTransactServer(s *grpc.Server, srv TransactServer) {
@@ -342,6 +674,24 @@ func _Transact_BroadcastTxAsync_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_BroadcastTxBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxBatchParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).BroadcastTxBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/BroadcastTxBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).BroadcastTxBatch(ctx, req.(*TxBatchParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SignTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TxEnvelopeParam)
 	if err := dec(in); err != nil {
@@ -432,6 +782,24 @@ func _Transact_CallTxSim_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_CallTxSimAtHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallTxSimParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxSimAtHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxSimAtHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxSimAtHeight(ctx, req.(*CallTxSimParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CallCodeParam)
 	if err := dec(in); err != nil {
@@ -450,6 +818,42 @@ func _Transact_CallCodeSim_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_CallTxSimTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CallTxTraceParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).CallTxSimTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/CallTxSimTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).CallTxSimTrace(ctx, req.(*CallTxTraceParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transact_EstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(payload.CallTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactServer).EstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpctransact.Transact/EstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactServer).EstimateGas(ctx, req.(*payload.CallTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Transact_SendTxSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(payload.SendTx)
 	if err := dec(in); err != nil {
@@ -522,6 +926,27 @@ func _Transact_NameTxAsync_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Transact_ListQueuedTxs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueuedTxsParam)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactServer).ListQueuedTxs(m, &transactListQueuedTxsServer{stream})
+}
+
+type Transact_ListQueuedTxsServer interface {
+	Send(*QueuedTx) error
+	grpc.ServerStream
+}
+
+type transactListQueuedTxsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transactListQueuedTxsServer) Send(m *QueuedTx) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Transact_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpctransact.Transact",
 	HandlerType: (*TransactServer)(nil),
@@ -534,6 +959,10 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTxAsync",
 			Handler:    _Transact_BroadcastTxAsync_Handler,
 		},
+		{
+			MethodName: "BroadcastTxBatch",
+			Handler:    _Transact_BroadcastTxBatch_Handler,
+		},
 		{
 			MethodName: "SignTx",
 			Handler:    _Transact_SignTx_Handler,
@@ -554,10 +983,22 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CallTxSim",
 			Handler:    _Transact_CallTxSim_Handler,
 		},
+		{
+			MethodName: "CallTxSimAtHeight",
+			Handler:    _Transact_CallTxSimAtHeight_Handler,
+		},
 		{
 			MethodName: "CallCodeSim",
 			Handler:    _Transact_CallCodeSim_Handler,
 		},
+		{
+			MethodName: "CallTxSimTrace",
+			Handler:    _Transact_CallTxSimTrace_Handler,
+		},
+		{
+			MethodName: "EstimateGas",
+			Handler:    _Transact_EstimateGas_Handler,
+		},
 		{
 			MethodName: "SendTxSync",
 			Handler:    _Transact_SendTxSync_Handler,
@@ -575,10 +1016,49 @@ var _Transact_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Transact_NameTxAsync_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListQueuedTxs",
+			Handler:       _Transact_ListQueuedTxs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rpctransact.proto",
 }
 
+func (m *CallTxSimParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CallTxSimParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.CallTx != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.CallTx.Size()))
+		n1, err := m.CallTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Height != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Height))
+	}
+	return i, nil
+}
+
 func (m *CallCodeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -597,11 +1077,11 @@ func (m *CallCodeParam) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintRpctransact(dAtA, i, uint64(m.FromAddress.Size()))
-	n1, err := m.FromAddress.MarshalTo(dAtA[i:])
+	n2, err := m.FromAddress.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n1
+	i += n2
 	if len(m.Code) > 0 {
 		dAtA[i] = 0x12
 		i++
@@ -614,10 +1094,15 @@ func (m *CallCodeParam) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.Data)))
 		i += copy(dAtA[i:], m.Data)
 	}
+	if m.Height != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Height))
+	}
 	return i, nil
 }
 
-func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
+func (m *CallTxTraceParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -627,25 +1112,35 @@ func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
+func (m *CallTxTraceParam) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Envelope != nil {
+	if m.CallTx != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n2, err := m.Envelope.MarshalTo(dAtA[i:])
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.CallTx.Size()))
+		n3, err := m.CallTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.TraceConfig != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.TraceConfig.Size()))
+		n4, err := m.TraceConfig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n4
 	}
 	return i, nil
 }
 
-func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
+func (m *GasEstimate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -655,31 +1150,250 @@ func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
+func (m *GasEstimate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Envelope != nil {
-		dAtA[i] = 0xa
+	if m.GasLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.GasUsed))
+	}
+	if m.Exception != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Exception.Size()))
+		n5, err := m.Exception.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.RevertReason) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(len(m.RevertReason)))
+		i += copy(dAtA[i:], m.RevertReason)
+	}
+	return i, nil
+}
+
+func (m *TxEnvelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEnvelope) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
-		n3, err := m.Envelope.MarshalTo(dAtA[i:])
+		n6, err := m.Envelope.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n6
+	}
+	return i, nil
+}
+
+func (m *TxEnvelopeParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxEnvelopeParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
+		n7, err := m.Envelope.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
 	if m.Payload != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpctransact(dAtA, i, uint64(m.Payload.Size()))
-		n4, err := m.Payload.MarshalTo(dAtA[i:])
+		n8, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *TxBatchParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxBatchParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpctransact(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Atomic {
+		dAtA[i] = 0x10
+		i++
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *TxBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxExecutions) > 0 {
+		for _, msg := range m.TxExecutions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRpctransact(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *QueuedTxsParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTxsParam) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Address != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Address.Size()))
+		n9, err := m.Address.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *QueuedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Envelope.Size()))
+		n10, err := m.Envelope.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(m.Address.Size()))
+	n11, err := m.Address.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n11
+	if m.Sequence != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpctransact(dAtA, i, uint64(m.Sequence))
+	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintRpctransact(dAtA, i, uint64(types.SizeOfStdTime(m.Expires)))
+	n12, err := types.StdTimeMarshalTo(m.Expires, dAtA[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n12
 	return i, nil
 }
 
@@ -692,6 +1406,19 @@ func encodeVarintRpctransact(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return offset + 1
 }
+func (m *CallTxSimParam) Size() (n int) {
+	var l int
+	_ = l
+	if m.CallTx != nil {
+		l = m.CallTx.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRpctransact(uint64(m.Height))
+	}
+	return n
+}
+
 func (m *CallCodeParam) Size() (n int) {
 	var l int
 	_ = l
@@ -705,6 +1432,43 @@ func (m *CallCodeParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpctransact(uint64(l))
 	}
+	if m.Height != 0 {
+		n += 1 + sovRpctransact(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *CallTxTraceParam) Size() (n int) {
+	var l int
+	_ = l
+	if m.CallTx != nil {
+		l = m.CallTx.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	if m.TraceConfig != nil {
+		l = m.TraceConfig.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	return n
+}
+
+func (m *GasEstimate) Size() (n int) {
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovRpctransact(uint64(m.GasUsed))
+	}
+	if m.Exception != nil {
+		l = m.Exception.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
 	return n
 }
 
@@ -732,6 +1496,60 @@ func (m *TxEnvelopeParam) Size() (n int) {
 	return n
 }
 
+func (m *TxBatchParam) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxBatchResult) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.TxExecutions) > 0 {
+		for _, e := range m.TxExecutions {
+			l = e.Size()
+			n += 1 + l + sovRpctransact(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedTxsParam) Size() (n int) {
+	var l int
+	_ = l
+	if m.Address != nil {
+		l = m.Address.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedTx) Size() (n int) {
+	var l int
+	_ = l
+	if m.Envelope != nil {
+		l = m.Envelope.Size()
+		n += 1 + l + sovRpctransact(uint64(l))
+	}
+	l = m.Address.Size()
+	n += 1 + l + sovRpctransact(uint64(l))
+	if m.Sequence != 0 {
+		n += 1 + sovRpctransact(uint64(m.Sequence))
+	}
+	l = types.SizeOfStdTime(m.Expires)
+	n += 1 + l + sovRpctransact(uint64(l))
+	return n
+}
+
 func sovRpctransact(x uint64) (n int) {
 	for {
 		n++
@@ -740,12 +1558,624 @@ func sovRpctransact(x uint64) (n int) {
 			break
 		}
 	}
-	return n
-}
-func sozRpctransact(x uint64) (n int) {
-	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return n
+}
+func sozRpctransact(x uint64) (n int) {
+	return sovRpctransact(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CallTxSimParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxSimParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxSimParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallTx == nil {
+				m.CallTx = &payload.CallTx{}
+			}
+			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CallTxTraceParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CallTxTraceParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CallTxTraceParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CallTx == nil {
+				m.CallTx = &payload.CallTx{}
+			}
+			if err := m.CallTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TraceConfig == nil {
+				m.TraceConfig = &exec.TraceConfig{}
+			}
+			if err := m.TraceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exception", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Exception == nil {
+				m.Exception = &errors.Exception{}
+			}
+			if err := m.Exception.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
+func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -768,17 +2198,17 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CallCodeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxEnvelopeParam: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CallCodeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxEnvelopeParam: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -788,27 +2218,30 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FromAddress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Envelope == nil {
+				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			}
+			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -818,28 +2251,80 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
-			if m.Code == nil {
-				m.Code = []byte{}
+			if m.Payload == nil {
+				m.Payload = &payload.Any{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxBatchParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxBatchParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxBatchParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRpctransact
@@ -849,23 +2334,43 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRpctransact
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Txs = append(m.Txs, &TxEnvelopeParam{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpctransact(dAtA[iNdEx:])
@@ -887,7 +2392,7 @@ func (m *CallCodeParam) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
+func (m *TxBatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -910,15 +2415,15 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEnvelope: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxBatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Envelope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxExecutions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -942,10 +2447,90 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Envelope == nil {
-				m.Envelope = &github_com_hyperledger_burrow_txs.Envelope{}
+			m.TxExecutions = append(m.TxExecutions, &exec.TxExecution{})
+			if err := m.TxExecutions[len(m.TxExecutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Envelope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpctransact(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTxsParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpctransact
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTxsParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTxsParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_hyperledger_burrow_crypto.Address
+			m.Address = &v
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -970,7 +2555,7 @@ func (m *TxEnvelope) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
+func (m *QueuedTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -993,10 +2578,10 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxEnvelopeParam: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueuedTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxEnvelopeParam: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueuedTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1034,7 +2619,56 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpctransact
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Address.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpctransact
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expires", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1058,10 +2692,7 @@ func (m *TxEnvelopeParam) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Payload == nil {
-				m.Payload = &payload.Any{}
-			}
-			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := types.StdTimeUnmarshal(&m.Expires, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1195,37 +2826,63 @@ func init() { proto.RegisterFile("rpctransact.proto", fileDescriptorRpctransact)
 func init() { golang_proto.RegisterFile("rpctransact.proto", fileDescriptorRpctransact) }
 
 var fileDescriptorRpctransact = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0x25, 0xfc, 0x18, 0xdb, 0x97, 0x56, 0x65, 0xbe, 0x50, 0x55, 0x28, 0x45, 0x3d, 0x20, 0x84,
-	0xb6, 0xa4, 0x2a, 0x3b, 0x22, 0x50, 0x53, 0xb6, 0x23, 0x9a, 0xd2, 0x08, 0x09, 0x6e, 0xae, 0x63,
-	0xb2, 0x48, 0x49, 0x1c, 0x39, 0x0e, 0x24, 0x7f, 0x05, 0x97, 0xfd, 0x41, 0x1c, 0x7b, 0xe4, 0xbc,
-	0x43, 0x85, 0xba, 0x7f, 0x04, 0xc5, 0x4e, 0x47, 0xd2, 0xae, 0x2b, 0x97, 0xdd, 0x9e, 0xdf, 0x97,
-	0xf7, 0xfc, 0x3d, 0x7f, 0x76, 0xe0, 0x90, 0x27, 0x44, 0x70, 0x1c, 0xa7, 0x98, 0x08, 0x33, 0xe1,
-	0x4c, 0x30, 0xa4, 0xd7, 0xa8, 0xde, 0xb1, 0x1f, 0x88, 0x8b, 0x6c, 0x66, 0x12, 0x16, 0x59, 0x3e,
-	0xf3, 0x99, 0x25, 0xbf, 0x99, 0x65, 0xdf, 0xe4, 0x4a, 0x2e, 0x24, 0x52, 0xda, 0x1e, 0xd0, 0x9c,
-	0x92, 0x0a, 0xb7, 0x13, 0x5c, 0x84, 0x0c, 0x7b, 0xd5, 0xf2, 0x40, 0xe4, 0xa9, 0x82, 0x83, 0x9f,
-	0x1a, 0xb4, 0x27, 0x38, 0x0c, 0x27, 0xcc, 0xa3, 0xe7, 0x98, 0xe3, 0x08, 0x7d, 0x06, 0xfd, 0x8c,
-	0xb3, 0x68, 0xec, 0x79, 0x9c, 0xa6, 0x69, 0x57, 0x7b, 0xa9, 0xbd, 0x6e, 0xd9, 0x27, 0xf3, 0x45,
-	0xff, 0xc1, 0xd5, 0xa2, 0x7f, 0x54, 0xeb, 0xe1, 0xa2, 0x48, 0x28, 0x0f, 0xa9, 0xe7, 0x53, 0x6e,
-	0xcd, 0x32, 0xce, 0xd9, 0x0f, 0x8b, 0xf0, 0x22, 0x11, 0xcc, 0xac, 0xb4, 0x4e, 0xdd, 0x08, 0x21,
-	0x78, 0x5c, 0x6e, 0xd2, 0x7d, 0x58, 0x1a, 0x3a, 0x12, 0x97, 0xdc, 0x47, 0x2c, 0x70, 0xf7, 0x91,
-	0xe2, 0x4a, 0x3c, 0xf0, 0x01, 0xdc, 0xfc, 0x34, 0xfe, 0x4e, 0x43, 0x96, 0x50, 0xf4, 0x05, 0xf6,
-	0x57, 0x58, 0xb6, 0xa2, 0x8f, 0xda, 0x66, 0xd9, 0xfd, 0x8a, 0xb4, 0xcd, 0xab, 0x45, 0xff, 0xcd,
-	0xdd, 0x5d, 0xd5, 0xbf, 0x77, 0x6e, 0xec, 0x06, 0x97, 0x1a, 0x74, 0xfe, 0xed, 0xa4, 0xc2, 0xdf,
-	0xdf, 0x76, 0xe8, 0x15, 0x3c, 0x3d, 0x57, 0x53, 0x90, 0x47, 0xa0, 0x8f, 0x5a, 0xe6, 0x6a, 0x2a,
-	0xe3, 0xb8, 0x70, 0x56, 0xc5, 0xd1, 0xe5, 0x13, 0xd8, 0x77, 0xab, 0x99, 0x23, 0x1b, 0x3a, 0x36,
-	0x67, 0xd8, 0x23, 0x38, 0x15, 0x6e, 0x3e, 0x2d, 0x62, 0x82, 0x5e, 0x98, 0xf5, 0x7b, 0xb2, 0x16,
-	0xa0, 0x77, 0x68, 0xca, 0xb1, 0xbb, 0xf9, 0x69, 0x4e, 0x49, 0x26, 0x02, 0x16, 0xa3, 0xf7, 0xf0,
-	0xac, 0xe6, 0x31, 0x4e, 0x77, 0x9b, 0xb4, 0x64, 0x66, 0x87, 0x12, 0x1a, 0x24, 0x02, 0x7d, 0x80,
-	0xbd, 0x69, 0xe0, 0xc7, 0x6e, 0xbe, 0x43, 0xf5, 0x7c, 0x4b, 0x15, 0x9d, 0x80, 0x7e, 0xc6, 0x78,
-	0x94, 0x85, 0x58, 0x50, 0x37, 0x47, 0x8d, 0xdc, 0xdb, 0x55, 0x43, 0x80, 0xf2, 0x62, 0x56, 0xa9,
-	0x3b, 0x37, 0x22, 0x45, 0xde, 0x16, 0xf4, 0x08, 0x74, 0x55, 0x54, 0x19, 0x37, 0x24, 0xcd, 0x58,
-	0x16, 0x1c, 0x54, 0xfe, 0x41, 0xf4, 0x5f, 0xf6, 0xef, 0x94, 0x7d, 0x79, 0x71, 0x4b, 0x49, 0xaf,
-	0xd1, 0x78, 0xe3, 0x0d, 0xdd, 0xa6, 0x1e, 0x02, 0x4c, 0x69, 0xec, 0x6d, 0xc4, 0x51, 0xe4, 0x96,
-	0x38, 0xaa, 0xb8, 0x1e, 0xa7, 0x92, 0x34, 0xe3, 0x0c, 0x01, 0x3e, 0xe1, 0x88, 0x6e, 0xf8, 0x2b,
-	0x72, 0x8b, 0xbf, 0x2a, 0xae, 0xfb, 0x57, 0x92, 0x86, 0xbf, 0x3d, 0x99, 0x2f, 0x0d, 0xed, 0xf7,
-	0xd2, 0xd0, 0xfe, 0x2c, 0x0d, 0xed, 0xd7, 0xb5, 0xa1, 0xcd, 0xaf, 0x0d, 0xed, 0xeb, 0xf1, 0xdd,
-	0x4f, 0x81, 0x27, 0xc4, 0xaa, 0x9d, 0xd2, 0x6c, 0x4f, 0xfe, 0x74, 0xde, 0xfe, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0x01, 0x16, 0xdf, 0xeb, 0x04, 0x00, 0x00,
+	// 915 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0xc7, 0xbb, 0xd1, 0x26, 0xfb, 0x9c, 0x74, 0xbb, 0x23, 0x01, 0xc1, 0x40, 0x52, 0xf9, 0x00,
+	0x2b, 0xd4, 0x3a, 0x51, 0x5a, 0x24, 0x0e, 0xa8, 0x28, 0x09, 0xd9, 0x05, 0x54, 0x55, 0xed, 0xc4,
+	0x54, 0x02, 0x71, 0x99, 0x75, 0xa6, 0x5e, 0x4b, 0xb1, 0xc7, 0xcc, 0x4c, 0x8a, 0xf3, 0x2d, 0x40,
+	0xe2, 0xc0, 0x37, 0xe0, 0x6b, 0x70, 0xdc, 0x23, 0xe7, 0x1e, 0x16, 0xb4, 0xfb, 0x45, 0x90, 0x67,
+	0x6c, 0xc7, 0x4e, 0xb2, 0x5b, 0xaa, 0xaa, 0xb7, 0x79, 0xff, 0x7e, 0xef, 0xcd, 0xfb, 0x0b, 0x87,
+	0x3c, 0xf6, 0x24, 0x27, 0x91, 0x20, 0x9e, 0x74, 0x62, 0xce, 0x24, 0x43, 0x66, 0x89, 0x65, 0xdd,
+	0xf3, 0x03, 0x79, 0xb6, 0x38, 0x75, 0x3c, 0x16, 0xf6, 0x7c, 0xe6, 0xb3, 0x9e, 0xd2, 0x39, 0x5d,
+	0x3c, 0x57, 0x94, 0x22, 0xd4, 0x4b, 0xdb, 0x5a, 0x5d, 0x9f, 0x31, 0x7f, 0x4e, 0x57, 0x5a, 0x32,
+	0x08, 0xa9, 0x90, 0x24, 0x8c, 0x33, 0x85, 0x26, 0xe5, 0x9c, 0x71, 0x91, 0x51, 0x40, 0x13, 0xea,
+	0x65, 0xef, 0x56, 0x4c, 0x96, 0x73, 0x46, 0x66, 0x19, 0xb9, 0x2f, 0x93, 0x4c, 0xcb, 0x7e, 0x0a,
+	0xb7, 0xc6, 0x64, 0x3e, 0x77, 0x93, 0x69, 0x10, 0x3e, 0x21, 0x9c, 0x84, 0xe8, 0x53, 0xd8, 0xd3,
+	0x9c, 0xb6, 0x71, 0xc7, 0x38, 0x32, 0x07, 0x07, 0x4e, 0x6e, 0xac, 0xd9, 0x38, 0x13, 0xa3, 0xf7,
+	0x60, 0xef, 0x1b, 0x1a, 0xf8, 0x67, 0xb2, 0xbd, 0x73, 0xc7, 0x38, 0xaa, 0xe1, 0x8c, 0xb2, 0xff,
+	0x34, 0xa0, 0x95, 0xaa, 0x8c, 0xd9, 0x8c, 0x6a, 0xc8, 0x67, 0x60, 0x1e, 0x73, 0x16, 0x0e, 0x67,
+	0x33, 0x4e, 0x85, 0x50, 0xb8, 0xcd, 0xd1, 0x83, 0xf3, 0x8b, 0xee, 0x3b, 0x2f, 0x2f, 0xba, 0x77,
+	0x4b, 0x59, 0x38, 0x5b, 0xc6, 0x94, 0xcf, 0xe9, 0xcc, 0xa7, 0xbc, 0x77, 0xba, 0xe0, 0x9c, 0xfd,
+	0xd2, 0xf3, 0xf8, 0x32, 0x96, 0xcc, 0xc9, 0x6c, 0x71, 0x19, 0x08, 0x21, 0xa8, 0xa5, 0x4e, 0x94,
+	0xff, 0x26, 0x56, 0xef, 0x94, 0xf7, 0x35, 0x91, 0xa4, 0xbd, 0xab, 0x79, 0xe9, 0xbb, 0x14, 0x69,
+	0xad, 0x12, 0x69, 0x0c, 0xb7, 0xf5, 0x5f, 0x5c, 0x4e, 0x3c, 0xfa, 0x9a, 0xdf, 0xbf, 0x0f, 0xa6,
+	0x32, 0x1b, 0xb3, 0xe8, 0x79, 0xe0, 0xab, 0x18, 0xcc, 0xc1, 0xa1, 0xa3, 0xb2, 0x5e, 0x12, 0xe0,
+	0xb2, 0x96, 0xfd, 0x87, 0x01, 0xe6, 0x09, 0x11, 0x13, 0x21, 0x83, 0x90, 0x48, 0x8a, 0x2c, 0x68,
+	0x9c, 0x10, 0xf1, 0x28, 0x08, 0x03, 0xa9, 0xfc, 0xd5, 0x70, 0x41, 0xa3, 0x36, 0xd4, 0x4f, 0x88,
+	0xf8, 0x5e, 0xd0, 0x59, 0x96, 0xe0, 0x9c, 0x44, 0x3d, 0xd8, 0x9f, 0x24, 0x1e, 0x8d, 0x65, 0xc0,
+	0xa2, 0xf6, 0x6e, 0xee, 0x58, 0x17, 0xbf, 0x10, 0xe0, 0x95, 0x0e, 0xb2, 0xa1, 0x89, 0xe9, 0x0b,
+	0xca, 0x25, 0xa6, 0x44, 0xb0, 0x48, 0xa5, 0x61, 0x1f, 0x57, 0x78, 0xb6, 0x0f, 0xe0, 0x26, 0x93,
+	0xe8, 0x05, 0x9d, 0xb3, 0x98, 0xa2, 0x1f, 0xa0, 0x91, 0xbf, 0xb3, 0x44, 0xb4, 0x9c, 0xb4, 0x6b,
+	0x72, 0xe6, 0xc8, 0x79, 0x79, 0xd1, 0xfd, 0xec, 0xe6, 0xd2, 0x95, 0xf5, 0x71, 0x01, 0x67, 0xff,
+	0x6e, 0xc0, 0xc1, 0xca, 0x93, 0xce, 0xfa, 0xdb, 0x73, 0x87, 0x3e, 0x81, 0xfa, 0x13, 0x5d, 0xc1,
+	0xac, 0x46, 0xcd, 0xa2, 0xa2, 0xc3, 0x68, 0x89, 0x73, 0xa1, 0xfd, 0x0c, 0x9a, 0x6e, 0x32, 0x22,
+	0xd2, 0x3b, 0xd3, 0x21, 0x39, 0xb0, 0xeb, 0x26, 0x69, 0xb3, 0xee, 0x1e, 0x99, 0x83, 0x8f, 0x9c,
+	0xf2, 0x2c, 0xaf, 0x45, 0x8f, 0x53, 0xc5, 0xb4, 0xc9, 0x86, 0x92, 0x85, 0x81, 0xa7, 0xdc, 0x34,
+	0x70, 0x46, 0xd9, 0xc7, 0xd0, 0xca, 0x70, 0x31, 0x15, 0x8b, 0xb9, 0x44, 0x9f, 0xa7, 0x8e, 0x26,
+	0x09, 0xf5, 0x16, 0x69, 0x6d, 0x72, 0x0f, 0x79, 0xe7, 0xac, 0x24, 0xb8, 0xa2, 0x66, 0xff, 0x04,
+	0xb7, 0x9e, 0x2e, 0xe8, 0x82, 0xce, 0xdc, 0x44, 0xe8, 0x08, 0xbf, 0x83, 0x7a, 0x75, 0xa4, 0xfa,
+	0xaf, 0x3d, 0x4e, 0x39, 0x80, 0xfd, 0xdb, 0x0e, 0x34, 0x72, 0xf8, 0xb7, 0x59, 0x8d, 0xc7, 0xab,
+	0x98, 0x77, 0xde, 0x60, 0x0d, 0xe4, 0x20, 0xe9, 0x00, 0x4d, 0xe9, 0xcf, 0x0b, 0x1a, 0x79, 0x54,
+	0x4d, 0x42, 0x0d, 0x17, 0x34, 0x7a, 0x08, 0xf5, 0x49, 0x12, 0x07, 0x9c, 0x0a, 0xd5, 0xf0, 0xe6,
+	0xc0, 0x72, 0xf4, 0x0a, 0x75, 0xf2, 0x15, 0xea, 0xb8, 0xf9, 0x0a, 0x1d, 0x35, 0xd2, 0x38, 0x7e,
+	0xfd, 0xa7, 0x6b, 0xe0, 0xdc, 0x68, 0x70, 0x55, 0x87, 0x86, 0x9b, 0xd5, 0x1c, 0x8d, 0xe0, 0x60,
+	0xc4, 0x19, 0x99, 0x79, 0x44, 0x48, 0x37, 0x99, 0x2e, 0x23, 0x0f, 0xdd, 0xd8, 0x14, 0xd6, 0x66,
+	0x41, 0xd1, 0x43, 0xb8, 0x5d, 0xc2, 0x18, 0x8a, 0x57, 0x83, 0x34, 0x55, 0xde, 0x31, 0xf5, 0x68,
+	0x10, 0x4b, 0xf4, 0x6d, 0xc5, 0x5e, 0xf5, 0x14, 0xfa, 0x60, 0xcd, 0x7e, 0xd5, 0xc1, 0x96, 0xb5,
+	0x4d, 0x94, 0x35, 0xe1, 0x57, 0xb0, 0x37, 0x0d, 0xfc, 0xc8, 0x4d, 0x5e, 0x11, 0xc0, 0xfb, 0xd7,
+	0x48, 0xd1, 0x03, 0x30, 0x8f, 0x19, 0x0f, 0x17, 0x73, 0x22, 0xa9, 0x9b, 0xa0, 0xca, 0x50, 0x5d,
+	0x6f, 0xd5, 0x07, 0xc8, 0xce, 0x4d, 0xfa, 0xf7, 0xf5, 0xdd, 0xba, 0x2d, 0x67, 0x77, 0xc1, 0xd4,
+	0xc2, 0xa1, 0xd8, 0x6a, 0x52, 0xcd, 0x50, 0x0f, 0xf6, 0x8b, 0x73, 0xf6, 0xbf, 0xe0, 0xc7, 0x70,
+	0x58, 0x18, 0x0c, 0xa5, 0xbe, 0x0b, 0xe8, 0xc3, 0x4a, 0xf8, 0xd5, 0xfb, 0xb8, 0x0d, 0xe4, 0x4b,
+	0x1d, 0x63, 0x7a, 0x7f, 0x52, 0xbf, 0xd6, 0x86, 0x79, 0x71, 0x0a, 0xb7, 0x5b, 0xaf, 0x4e, 0xb0,
+	0xba, 0x15, 0xe8, 0xe3, 0x2d, 0xfe, 0x57, 0x27, 0xca, 0x32, 0x4b, 0x47, 0x06, 0x7d, 0x01, 0x66,
+	0x7e, 0x4d, 0x4e, 0x88, 0xd8, 0xfc, 0x73, 0xbb, 0x82, 0x55, 0xbe, 0x3d, 0x7d, 0x80, 0x29, 0x8d,
+	0x66, 0x1b, 0xb5, 0xd0, 0xcc, 0x6b, 0x6a, 0xa1, 0x85, 0xeb, 0xb5, 0xc8, 0x4c, 0xaa, 0xb5, 0xe8,
+	0x03, 0x3c, 0x26, 0x21, 0xdd, 0xc0, 0xd7, 0xcc, 0x6b, 0xf0, 0xb5, 0x70, 0x1d, 0x3f, 0x33, 0xa9,
+	0xe2, 0x4f, 0xa0, 0xf5, 0x28, 0x10, 0xb2, 0x58, 0x8a, 0x6b, 0x65, 0xab, 0x2e, 0x4b, 0xeb, 0xdd,
+	0xad, 0xc2, 0xbe, 0x31, 0x1a, 0x9f, 0x5f, 0x76, 0x8c, 0xbf, 0x2f, 0x3b, 0xc6, 0xbf, 0x97, 0x1d,
+	0xe3, 0xaf, 0xab, 0x8e, 0x71, 0x7e, 0xd5, 0x31, 0x7e, 0xbc, 0x77, 0xf3, 0x3a, 0xe2, 0xb1, 0xd7,
+	0x2b, 0xe1, 0x9d, 0xee, 0xa9, 0x8d, 0x72, 0xff, 0xbf, 0x01, 0x00, 0xc0, 0x69, 0xc9, 0xfe, 0xf3,
+	0x09, 0x00, 0x00,
 }
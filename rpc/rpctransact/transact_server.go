@@ -4,7 +4,11 @@ import (
 	"fmt"
 
 	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"golang.org/x/net/context"
@@ -12,13 +16,18 @@ import (
 
 type transactServer struct {
 	transactor *execution.Transactor
+	history    *execution.Replayer
 	txCodec    txs.Codec
+	logger     *logging.Logger
 }
 
-func NewTransactServer(transactor *execution.Transactor, txCodec txs.Codec) TransactServer {
+func NewTransactServer(transactor *execution.Transactor, history *execution.Replayer, txCodec txs.Codec,
+	logger *logging.Logger) TransactServer {
 	return &transactServer{
 		transactor: transactor,
+		history:    history,
 		txCodec:    txCodec,
+		logger:     logger.WithScope("NewTransactServer"),
 	}
 }
 
@@ -38,6 +47,37 @@ func (ts *transactServer) BroadcastTxAsync(ctx context.Context, param *TxEnvelop
 	return ts.transactor.BroadcastTxAsync(txEnv)
 }
 
+func (ts *transactServer) BroadcastTxBatch(ctx context.Context, param *TxBatchParam) (*TxBatchResult, error) {
+	chainID := ts.transactor.Tip.ChainID()
+	txEnvs := make([]*txs.Envelope, len(param.Txs))
+	for i, txParam := range param.Txs {
+		txEnvs[i] = txParam.GetEnvelope(chainID)
+		if txEnvs[i] == nil {
+			return nil, fmt.Errorf("no transaction envelope or payload provided for transaction %d of batch", i)
+		}
+	}
+	if !param.Atomic {
+		txes, err := ts.transactor.BroadcastTxBatchSync(ctx, txEnvs)
+		if err != nil {
+			return nil, err
+		}
+		return &TxBatchResult{TxExecutions: txes}, nil
+	}
+	batchTx := &payload.BatchTx{}
+	for i, txEnv := range txEnvs {
+		if len(txEnv.Signatories) > 0 {
+			return nil, fmt.Errorf("transaction %d of atomic batch is signed but atomic batches are signed "+
+				"server-side, broadcast a signed BatchTx instead", i)
+		}
+		batchTx.Add(txEnv.Tx.Payload)
+	}
+	txe, err := ts.transactor.BroadcastTxSync(ctx, txs.Enclose(chainID, batchTx))
+	if err != nil {
+		return nil, err
+	}
+	return &TxBatchResult{TxExecutions: []*exec.TxExecution{txe}}, nil
+}
+
 func (ts *transactServer) SignTx(ctx context.Context, param *TxEnvelopeParam) (*TxEnvelope, error) {
 	txEnv := param.GetEnvelope(ts.transactor.Tip.ChainID())
 	if txEnv == nil {
@@ -71,14 +111,68 @@ func (ts *transactServer) CallTxAsync(ctx context.Context, param *payload.CallTx
 }
 
 func (ts *transactServer) CallTxSim(ctx context.Context, param *payload.CallTx) (*exec.TxExecution, error) {
-	if param.Address == nil {
+	return ts.CallTxSimAtHeight(ctx, &CallTxSimParam{CallTx: param})
+}
+
+func (ts *transactServer) CallTxSimAtHeight(ctx context.Context, param *CallTxSimParam) (*exec.TxExecution, error) {
+	tx := param.CallTx
+	if tx == nil || tx.Address == nil {
 		return nil, fmt.Errorf("CallSim requires a non-nil address from which to retrieve code")
 	}
-	return ts.transactor.CallSim(param.Input.Address, *param.Address, param.Data)
+	if param.Height == 0 {
+		return ts.transactor.CallSim(tx.Input.Address, *tx.Address, tx.Data)
+	}
+	st, tip, err := ts.history.AtHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return ts.transactor.CallSimAt(st, tip, tx.Input.Address, *tx.Address, tx.Data)
+}
+
+func (ts *transactServer) CallTxSimTrace(ctx context.Context, param *CallTxTraceParam) (*exec.Trace, error) {
+	if param.CallTx == nil || param.CallTx.Address == nil {
+		return nil, fmt.Errorf("CallTxSimTrace requires a CallTx with a non-nil address from which to retrieve code")
+	}
+	tracer := evm.NewStructLogger(param.TraceConfig)
+	_, err := ts.transactor.CallSim(param.CallTx.Input.Address, *param.CallTx.Address, param.CallTx.Data,
+		evm.TraceWith(tracer))
+	if err != nil {
+		return nil, err
+	}
+	return tracer.Trace(), nil
+}
+
+func (ts *transactServer) EstimateGas(ctx context.Context, param *payload.CallTx) (*GasEstimate, error) {
+	if param.Input == nil {
+		return nil, fmt.Errorf("EstimateGas requires a CallTx with an Input from which to make the call")
+	}
+	gasLimit, txe, err := ts.transactor.EstimateGas(param)
+	if err != nil {
+		return nil, err
+	}
+	estimate := &GasEstimate{
+		GasLimit:  gasLimit,
+		GasUsed:   txe.GetResult().GetGasUsed(),
+		Exception: txe.Exception,
+	}
+	if txe.Exception != nil && txe.Exception.ErrorCode() == errors.ErrorCodeExecutionReverted {
+		reason, err := abi.UnpackRevert(txe.GetResult().GetReturn())
+		if err == nil && reason != nil {
+			estimate.RevertReason = *reason
+		}
+	}
+	return estimate, nil
 }
 
 func (ts *transactServer) CallCodeSim(ctx context.Context, param *CallCodeParam) (*exec.TxExecution, error) {
-	return ts.transactor.CallCodeSim(param.FromAddress, param.Code, param.Data)
+	if param.Height == 0 {
+		return ts.transactor.CallCodeSim(param.FromAddress, param.Code, param.Data)
+	}
+	st, tip, err := ts.history.AtHeight(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	return ts.transactor.CallCodeSimAt(st, tip, param.FromAddress, param.Code, param.Data)
 }
 
 func (ts *transactServer) SendTxSync(ctx context.Context, param *payload.SendTx) (*exec.TxExecution, error) {
@@ -97,6 +191,24 @@ func (ts *transactServer) NameTxAsync(ctx context.Context, param *payload.NameTx
 	return ts.BroadcastTxAsync(ctx, &TxEnvelopeParam{Payload: param.Any()})
 }
 
+func (ts *transactServer) ListQueuedTxs(param *QueuedTxsParam, stream Transact_ListQueuedTxsServer) error {
+	if !ts.transactor.QueuesFutureTxs() {
+		return fmt.Errorf("the future transaction queue is not enabled on this node")
+	}
+	for _, ftx := range ts.transactor.FutureTxs(param.Address) {
+		err := stream.Send(&QueuedTx{
+			Envelope: ftx.Envelope,
+			Address:  ftx.Address,
+			Sequence: ftx.Sequence,
+			Expires:  ftx.Expires,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (te *TxEnvelopeParam) GetEnvelope(chainID string) *txs.Envelope {
 	if te == nil {
 		return nil
@@ -126,5 +238,8 @@ func EnvelopeFromAny(chainID string, p *payload.Any) *txs.Envelope {
 	if p.GovTx != nil {
 		return txs.Enclose(chainID, p.GovTx)
 	}
+	if p.BatchTx != nil {
+		return txs.Enclose(chainID, p.BatchTx)
+	}
 	return nil
 }
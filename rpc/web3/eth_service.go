@@ -0,0 +1,657 @@
+package web3
+
+import (
+	bin "encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hyperledger/burrow/acm"
+	acmstate "github.com/hyperledger/burrow/acm/state"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/contexts"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/project"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	abciTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// The most blocks that a single eth_getLogs request may scan
+	MaxLogsBlockRange = 10000
+	// The most logs that a single eth_getLogs request may return
+	MaxLogs = 10000
+)
+
+// The current state and the execution history of the chain
+type State interface {
+	acmstate.Reader
+	rpcevents.IndexedProvider
+}
+
+// Provides the state as it was at earlier heights
+type History interface {
+	AtHeight(height uint64) (*execution.ReadState, bcm.BlockchainInfo, error)
+}
+
+// A JSON-RPC method taking its positional params as a JSON array
+type Method func(params json.RawMessage) (interface{}, error)
+
+// Implements the eth_*, net_*, and web3_* methods of the Ethereum JSON-RPC API that make sense for Burrow.
+//
+// Transactions sent with eth_sendRawTransaction must be signed Burrow transaction envelopes in the node's transaction
+// encoding (not RLP-encoded Ethereum transactions). Quantities of value are native Burrow units and since Burrow
+// charges no gas price the gas price is always zero.
+type EthService struct {
+	state      State
+	history    History
+	blockchain bcm.BlockchainInfo
+	transactor *execution.Transactor
+	nodeView   *tendermint.NodeView
+	txCodec    txs.Codec
+	// Limits on eth_getLogs
+	maxLogsBlockRange uint64
+	maxLogs           int
+	logger            *logging.Logger
+}
+
+func NewEthService(state State, history History, blockchain bcm.BlockchainInfo, transactor *execution.Transactor,
+	nodeView *tendermint.NodeView, txCodec txs.Codec, logger *logging.Logger) *EthService {
+
+	return &EthService{
+		state:             state,
+		history:           history,
+		blockchain:        blockchain,
+		transactor:        transactor,
+		nodeView:          nodeView,
+		txCodec:           txCodec,
+		maxLogsBlockRange: MaxLogsBlockRange,
+		maxLogs:           MaxLogs,
+		logger:            logger.WithScope("NewEthService"),
+	}
+}
+
+func (es *EthService) Methods() map[string]Method {
+	return map[string]Method{
+		"web3_clientVersion":        es.clientVersion,
+		"web3_sha3":                 es.sha3,
+		"net_version":               es.netVersion,
+		"net_listening":             es.netListening,
+		"net_peerCount":             es.netPeerCount,
+		"eth_chainId":               es.chainID,
+		"eth_accounts":              es.accounts,
+		"eth_gasPrice":              es.gasPrice,
+		"eth_blockNumber":           es.blockNumber,
+		"eth_getBalance":            es.getBalance,
+		"eth_getCode":               es.getCode,
+		"eth_getStorageAt":          es.getStorageAt,
+		"eth_getTransactionCount":   es.getTransactionCount,
+		"eth_call":                  es.call,
+		"eth_estimateGas":           es.estimateGas,
+		"eth_sendRawTransaction":    es.sendRawTransaction,
+		"eth_getTransactionByHash":  es.getTransactionByHash,
+		"eth_getTransactionReceipt": es.getTransactionReceipt,
+		"eth_getBlockByNumber":      es.getBlockByNumber,
+		"eth_getLogs":               es.getLogs,
+	}
+}
+
+// Methods
+
+func (es *EthService) clientVersion(params json.RawMessage) (interface{}, error) {
+	return "Burrow/v" + project.FullVersion(), nil
+}
+
+func (es *EthService) sha3(params json.RawMessage) (interface{}, error) {
+	var data Data
+	err := parseParams(params, 1, &data)
+	if err != nil {
+		return nil, err
+	}
+	return Data(sha3.Sha3(data)), nil
+}
+
+func (es *EthService) netVersion(params json.RawMessage) (interface{}, error) {
+	return strconv.FormatUint(es.networkID(), 10), nil
+}
+
+func (es *EthService) netListening(params json.RawMessage) (interface{}, error) {
+	return es.nodeView.IsListening(), nil
+}
+
+func (es *EthService) netPeerCount(params json.RawMessage) (interface{}, error) {
+	return Quantity(es.nodeView.Peers().Size()), nil
+}
+
+func (es *EthService) chainID(params json.RawMessage) (interface{}, error) {
+	return Quantity(es.networkID()), nil
+}
+
+func (es *EthService) accounts(params json.RawMessage) (interface{}, error) {
+	// Keys are held by the key server rather than the node
+	return []Data{}, nil
+}
+
+func (es *EthService) gasPrice(params json.RawMessage) (interface{}, error) {
+	return Quantity(0), nil
+}
+
+func (es *EthService) blockNumber(params json.RawMessage) (interface{}, error) {
+	return Quantity(es.blockchain.LastBlockHeight()), nil
+}
+
+func (es *EthService) getBalance(params json.RawMessage) (interface{}, error) {
+	var address Data
+	block := BlockNumber{Latest: true}
+	err := parseParams(params, 1, &address, &block)
+	if err != nil {
+		return nil, err
+	}
+	account, err := es.getAccount(address, block)
+	if err != nil || account == nil {
+		return Quantity(0), err
+	}
+	return Quantity(account.Balance()), nil
+}
+
+func (es *EthService) getCode(params json.RawMessage) (interface{}, error) {
+	var address Data
+	block := BlockNumber{Latest: true}
+	err := parseParams(params, 1, &address, &block)
+	if err != nil {
+		return nil, err
+	}
+	account, err := es.getAccount(address, block)
+	if err != nil || account == nil {
+		return Data{}, err
+	}
+	return Data(account.Code()), nil
+}
+
+func (es *EthService) getStorageAt(params json.RawMessage) (interface{}, error) {
+	var address, position Data
+	block := BlockNumber{Latest: true}
+	err := parseParams(params, 2, &address, &position, &block)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := address.Address()
+	if err != nil {
+		return nil, invalidParams("%v", err)
+	}
+	if len(position) > binary.Word256Length {
+		return nil, invalidParams("storage position %X is longer than %d bytes", []byte(position),
+			binary.Word256Length)
+	}
+	st, err := es.stateAt(block)
+	if err != nil {
+		return nil, err
+	}
+	value, err := st.GetStorage(addr, binary.LeftPadWord256(position))
+	if err != nil {
+		return nil, err
+	}
+	return Data(value.Bytes()), nil
+}
+
+func (es *EthService) getTransactionCount(params json.RawMessage) (interface{}, error) {
+	var address Data
+	block := BlockNumber{Latest: true}
+	err := parseParams(params, 1, &address, &block)
+	if err != nil {
+		return nil, err
+	}
+	account, err := es.getAccount(address, block)
+	if err != nil || account == nil {
+		return Quantity(0), err
+	}
+	return Quantity(account.Sequence()), nil
+}
+
+func (es *EthService) call(params json.RawMessage) (interface{}, error) {
+	txe, err := es.simulate(params)
+	if err != nil {
+		return nil, err
+	}
+	return Data(txe.GetResult().GetReturn()), nil
+}
+
+func (es *EthService) estimateGas(params json.RawMessage) (interface{}, error) {
+	tx, block, err := parseCallTx(params)
+	if err != nil {
+		return nil, err
+	}
+	st, tip, err := es.simulationState(block)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, txe, err := es.transactor.EstimateGasAt(st, tip, tx)
+	if err != nil {
+		return nil, serverError(nil, "%v", err)
+	}
+	if txe.Exception != nil {
+		return nil, serverError(Data(txe.GetResult().GetReturn()), "%v", txe.Exception)
+	}
+	return Quantity(gasLimit), nil
+}
+
+func (es *EthService) sendRawTransaction(params json.RawMessage) (interface{}, error) {
+	var data Data
+	err := parseParams(params, 1, &data)
+	if err != nil {
+		return nil, err
+	}
+	txEnv, err := es.txCodec.DecodeTx(data)
+	if err != nil {
+		return nil, invalidParams("could not decode transaction envelope: %v", err)
+	}
+	// Otherwise the transactor would sign with the node's keys
+	if len(txEnv.Signatories) == 0 {
+		return nil, invalidParams("transaction envelope must be signed")
+	}
+	receipt, err := es.transactor.BroadcastTxAsync(txEnv)
+	if err != nil {
+		return nil, serverError(nil, "%v", err)
+	}
+	return Data(receipt.TxHash), nil
+}
+
+func (es *EthService) getTransactionByHash(params json.RawMessage) (interface{}, error) {
+	var hash Data
+	err := parseParams(params, 1, &hash)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := es.state.GetTx(hash)
+	if err != nil || txe == nil {
+		return nil, err
+	}
+	return newTransaction(txe, es.blockHash(txe.Height)), nil
+}
+
+func (es *EthService) getTransactionReceipt(params json.RawMessage) (interface{}, error) {
+	var hash Data
+	err := parseParams(params, 1, &hash)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := es.state.GetTx(hash)
+	if err != nil || txe == nil {
+		return nil, err
+	}
+	be, err := es.state.GetBlock(txe.Height)
+	if err != nil {
+		return nil, err
+	}
+	if be == nil || txe.Index >= uint64(len(be.TxExecutions)) {
+		return nil, fmt.Errorf("could not find transaction %X in block at height %d", []byte(hash), txe.Height)
+	}
+	blockHash := es.blockHash(txe.Height)
+	tx := newTransaction(txe, blockHash)
+	receipt := &Receipt{
+		TransactionHash:  tx.Hash,
+		TransactionIndex: tx.TransactionIndex,
+		BlockHash:        blockHash,
+		BlockNumber:      tx.BlockNumber,
+		From:             tx.From,
+		To:               tx.To,
+		GasUsed:          Quantity(txe.GetResult().GetGasUsed()),
+		Logs:             []*Log{},
+	}
+	if txe.Receipt != nil && txe.Receipt.CreatesContract && txe.Exception == nil {
+		contractAddress := AddressData(txe.Receipt.ContractAddress)
+		receipt.ContractAddress = &contractAddress
+	}
+	if txe.Exception == nil {
+		receipt.Status = 1
+	}
+	for _, blockTxe := range be.TxExecutions[:txe.Index+1] {
+		receipt.CumulativeGasUsed += Quantity(blockTxe.GetResult().GetGasUsed())
+	}
+	forEachLog(be, func(logTxe *exec.TxExecution, ev *exec.LogEvent, logIndex uint64) {
+		if logTxe.Index == txe.Index {
+			receipt.Logs = append(receipt.Logs, newLog(logTxe, ev, logIndex, blockHash))
+		}
+	})
+	receipt.LogsBloom = logsBloom(receipt.Logs)
+	return receipt, nil
+}
+
+func (es *EthService) getBlockByNumber(params json.RawMessage) (interface{}, error) {
+	var block BlockNumber
+	var full bool
+	err := parseParams(params, 1, &block, &full)
+	if err != nil {
+		return nil, err
+	}
+	be, err := es.state.GetBlock(block.Resolve(es.blockchain.LastBlockHeight()))
+	if err != nil || be == nil {
+		return nil, err
+	}
+	header, err := decodeHeader(be)
+	if err != nil {
+		return nil, err
+	}
+	blockHash := es.blockHash(be.Height)
+	var logs []*Log
+	forEachLog(be, func(txe *exec.TxExecution, ev *exec.LogEvent, logIndex uint64) {
+		logs = append(logs, newLog(txe, ev, logIndex, blockHash))
+	})
+	result := &Block{
+		Number:     Quantity(be.Height),
+		Hash:       blockHash,
+		ParentHash: header.LastBlockId.Hash,
+		Nonce:      make(Data, 8),
+		LogsBloom:  logsBloom(logs),
+		Miner:      header.ProposerAddress,
+		ExtraData:  Data{},
+		// Burrow has no block gas limit so report the limit that applies to each transaction
+		GasLimit:     Quantity(contexts.GasLimit),
+		Timestamp:    Quantity(header.Time.Unix()),
+		Transactions: []interface{}{},
+		Uncles:       []Data{},
+	}
+	for _, txe := range be.TxExecutions {
+		result.GasUsed += Quantity(txe.GetResult().GetGasUsed())
+		if full {
+			result.Transactions = append(result.Transactions, newTransaction(txe, blockHash))
+		} else {
+			result.Transactions = append(result.Transactions, Data(txe.TxHash))
+		}
+	}
+	return result, nil
+}
+
+func (es *EthService) getLogs(params json.RawMessage) (interface{}, error) {
+	filter := new(FilterArgs)
+	err := parseParams(params, 1, filter)
+	if err != nil {
+		return nil, err
+	}
+	lastBlockHeight := es.blockchain.LastBlockHeight()
+	startHeight, endHeight := lastBlockHeight, lastBlockHeight
+	if filter.FromBlock != nil {
+		startHeight = filter.FromBlock.Resolve(lastBlockHeight)
+	}
+	if filter.ToBlock != nil {
+		endHeight = filter.ToBlock.Resolve(lastBlockHeight)
+	}
+	if endHeight >= startHeight && endHeight-startHeight >= es.maxLogsBlockRange {
+		return nil, invalidParams("block range %d to %d exceeds the limit of %d blocks, request a smaller range",
+			startHeight, endHeight, es.maxLogsBlockRange)
+	}
+	// Use the event index to skip blocks for the terms that every matching log must have
+	terms := new(exec.IndexTerms)
+	if len(filter.Addresses) == 1 {
+		terms.Addresses = filter.Addresses
+	}
+	for _, topics := range filter.Topics {
+		if len(topics) == 1 {
+			terms.Topics = append(terms.Topics, topics[0])
+		}
+	}
+	logs := []*Log{}
+	_, err = es.state.GetBlocksIndexed(startHeight, endHeight+1, terms, func(be *exec.BlockExecution) (stop bool) {
+		var blockHash Data
+		forEachLog(be, func(txe *exec.TxExecution, ev *exec.LogEvent, logIndex uint64) {
+			if !filter.matches(ev) {
+				return
+			}
+			if blockHash == nil {
+				blockHash = es.blockHash(be.Height)
+			}
+			logs = append(logs, newLog(txe, ev, logIndex, blockHash))
+		})
+		return len(logs) > es.maxLogs
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(logs) > es.maxLogs {
+		return nil, serverError(nil, "query returned more than %d logs, request a smaller block range", es.maxLogs)
+	}
+	return logs, nil
+}
+
+// Helpers
+
+// Ethereum tooling expects a numeric network identifier so derive one from the genesis hash (keeping within the
+// integers that can be represented exactly in JavaScript)
+func (es *EthService) networkID() uint64 {
+	var bs [8]byte
+	copy(bs[2:], es.blockchain.GenesisHash())
+	return bin.BigEndian.Uint64(bs[:])
+}
+
+func (es *EthService) stateAt(block BlockNumber) (acmstate.Reader, error) {
+	if block.Latest || block.Height == es.blockchain.LastBlockHeight() {
+		return es.state, nil
+	}
+	st, _, err := es.history.AtHeight(block.Height)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+func (es *EthService) getAccount(address Data, block BlockNumber) (acm.Account, error) {
+	addr, err := address.Address()
+	if err != nil {
+		return nil, invalidParams("%v", err)
+	}
+	st, err := es.stateAt(block)
+	if err != nil {
+		return nil, err
+	}
+	return st.GetAccount(addr)
+}
+
+// Runs the call described by the params of eth_call without committing it. A call with no To address creates a
+// contract.
+func (es *EthService) simulate(params json.RawMessage) (*exec.TxExecution, error) {
+	tx, block, err := parseCallTx(params)
+	if err != nil {
+		return nil, err
+	}
+	if tx.GasLimit == 0 {
+		tx.GasLimit = contexts.GasLimit
+	}
+	st, tip, err := es.simulationState(block)
+	if err != nil {
+		return nil, err
+	}
+	txe, err := es.transactor.CallTxSimAt(st, tip, tx)
+	if err != nil {
+		return nil, serverError(nil, "%v", err)
+	}
+	if txe.Exception != nil {
+		return nil, serverError(Data(txe.GetResult().GetReturn()), "%v", txe.Exception)
+	}
+	return txe, nil
+}
+
+// Returns the state and chain against which to simulate calls at block
+func (es *EthService) simulationState(block BlockNumber) (acmstate.Reader, bcm.BlockchainInfo, error) {
+	if block.Latest || block.Height == es.blockchain.LastBlockHeight() {
+		return es.transactor.MempoolAccounts, es.transactor.Tip, nil
+	}
+	st, tip, err := es.history.AtHeight(block.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+	return st, tip, nil
+}
+
+// Parses the call object and block number params of eth_call and eth_estimateGas as a CallTx
+func parseCallTx(params json.RawMessage) (*payload.CallTx, BlockNumber, error) {
+	args := new(CallArgs)
+	block := BlockNumber{Latest: true}
+	err := parseParams(params, 1, args, &block)
+	if err != nil {
+		return nil, block, err
+	}
+	tx := &payload.CallTx{
+		Input: &payload.TxInput{
+			Amount: uint64(args.Value),
+		},
+		GasLimit: uint64(args.Gas),
+		Data:     []byte(args.Data),
+	}
+	if len(args.From) > 0 {
+		tx.Input.Address, err = args.From.Address()
+		if err != nil {
+			return nil, block, invalidParams("%v", err)
+		}
+	}
+	if len(args.To) > 0 {
+		address, err := args.To.Address()
+		if err != nil {
+			return nil, block, invalidParams("%v", err)
+		}
+		tx.Address = &address
+	}
+	return tx, block, nil
+}
+
+// Returns the hash of the block at height if it is known
+func (es *EthService) blockHash(height uint64) Data {
+	hash, err := es.blockchain.BlockHash(height)
+	if err == nil {
+		return hash
+	}
+	// Outside the window of recent block hashes so take it from the header of the next block
+	be, err := es.state.GetBlock(height + 1)
+	if err != nil || be == nil {
+		return nil
+	}
+	header, err := decodeHeader(be)
+	if err != nil {
+		return nil
+	}
+	return header.LastBlockId.Hash
+}
+
+func (fa *FilterArgs) matches(ev *exec.LogEvent) bool {
+	if len(fa.Addresses) > 0 {
+		found := false
+		for _, address := range fa.Addresses {
+			if address == ev.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i, topics := range fa.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(ev.Topics) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			if topic == ev.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Calls consumer with each log of the successful transactions in a block along with its index within the block
+func forEachLog(be *exec.BlockExecution, consumer func(txe *exec.TxExecution, ev *exec.LogEvent, logIndex uint64)) {
+	var logIndex uint64
+	for _, txe := range be.TxExecutions {
+		if txe.Exception != nil {
+			continue
+		}
+		for _, ev := range txe.Events {
+			if ev.Log != nil {
+				consumer(txe, ev.Log, logIndex)
+				logIndex++
+			}
+		}
+	}
+}
+
+func newLog(txe *exec.TxExecution, ev *exec.LogEvent, logIndex uint64, blockHash Data) *Log {
+	log := &Log{
+		LogIndex:         Quantity(logIndex),
+		TransactionIndex: Quantity(txe.Index),
+		TransactionHash:  Data(txe.TxHash),
+		BlockHash:        blockHash,
+		BlockNumber:      Quantity(txe.Height),
+		Address:          AddressData(ev.Address),
+		Data:             Data(ev.Data),
+		Topics:           make([]Data, len(ev.Topics)),
+	}
+	for i, topic := range ev.Topics {
+		log.Topics[i] = topic.Bytes()
+	}
+	return log
+}
+
+func logsBloom(logs []*Log) Data {
+	bloom := new(exec.Bloom)
+	for _, log := range logs {
+		bloom.Add(log.Address)
+		for _, topic := range log.Topics {
+			bloom.Add(topic)
+		}
+	}
+	return bloom[:]
+}
+
+func newTransaction(txe *exec.TxExecution, blockHash Data) *Transaction {
+	tx := &Transaction{
+		Hash:             Data(txe.TxHash),
+		BlockHash:        blockHash,
+		BlockNumber:      Quantity(txe.Height),
+		TransactionIndex: Quantity(txe.Index),
+		Input:            Data{},
+	}
+	if txe.Envelope == nil {
+		return tx
+	}
+	inputs := txe.Envelope.Tx.GetInputs()
+	if len(inputs) > 0 {
+		tx.From = AddressData(inputs[0].Address)
+		tx.Nonce = Quantity(inputs[0].Sequence)
+		tx.Value = Quantity(inputs[0].Amount)
+	}
+	if callTx, ok := txe.Envelope.Tx.Payload.(*payload.CallTx); ok {
+		if callTx.Address != nil {
+			to := AddressData(*callTx.Address)
+			tx.To = &to
+		}
+		tx.Gas = Quantity(callTx.GasLimit)
+		tx.Input = Data(callTx.Data)
+	}
+	return tx
+}
+
+func decodeHeader(be *exec.BlockExecution) (*abciTypes.Header, error) {
+	header := new(abciTypes.Header)
+	if be.BlockHeader == nil {
+		return header, nil
+	}
+	err := json.Unmarshal([]byte(be.BlockHeader.JSON), header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode header of block at height %v: %v", be.Height, err)
+	}
+	return header, nil
+}
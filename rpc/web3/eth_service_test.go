@@ -0,0 +1,166 @@
+package web3
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/evm/sha3"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/db"
+)
+
+func TestEthService(t *testing.T) {
+	account := acm.NewConcreteAccountFromSecret("Foo").MutableAccount()
+	account.AddToBalance(100)
+	contract := crypto.Address{1, 2, 3}
+	topic := binary.LeftPadWord256([]byte("Topic"))
+
+	st := execution.NewState(db.NewMemDB())
+	_, err := st.Update(func(ws execution.Updatable) error {
+		err := ws.UpdateAccount(account)
+		if err != nil {
+			return err
+		}
+		err = ws.SetStorage(account.Address(), binary.One256, binary.Int64ToWord256(42))
+		if err != nil {
+			return err
+		}
+		txe := exec.NewTxExecution(txs.Enclose("test-chain", &payload.CallTx{
+			Input:    &payload.TxInput{Address: account.Address(), Amount: 1, Sequence: 1},
+			Address:  &contract,
+			GasLimit: 100,
+			Data:     []byte{1},
+		}))
+		txe.Height = 1
+		txe.Log(&exec.LogEvent{Address: contract, Topics: []binary.Word256{topic}, Data: []byte("data")})
+		txe.Return(nil, 21)
+		return ws.AddBlock(&exec.BlockExecution{Height: 1, TxExecutions: []*exec.TxExecution{txe}})
+	})
+	require.NoError(t, err)
+	_, err = st.Update(func(ws execution.Updatable) error {
+		return ws.AddBlock(&exec.BlockExecution{Height: 2})
+	})
+	require.NoError(t, err)
+
+	service := NewEthService(st, nil, &testTip{lastBlockHeight: 2}, nil, nil, txs.NewAminoCodec(),
+		logging.NewNoopLogger())
+	handler := NewHandler(service.Methods(), logging.NewNoopLogger())
+	call := func(method string, params ...interface{}) *Response {
+		bs, err := json.Marshal(params)
+		require.NoError(t, err)
+		return handler.Handle(&Request{JSONRPC: jsonRPCVersion, ID: json.RawMessage("1"), Method: method, Params: bs})
+	}
+	address := fmt.Sprintf("0x%x", account.Address().Bytes())
+
+	assert.Equal(t, `"0x2"`, string(call("eth_blockNumber").Result))
+	assert.Equal(t, `"0x64"`, string(call("eth_getBalance", address, "latest").Result))
+	assert.Equal(t, `"0x0"`, string(call("eth_getTransactionCount", address).Result))
+	assert.Equal(t, `"0x000000000000000000000000000000000000000000000000000000000000002a"`,
+		string(call("eth_getStorageAt", address, "0x1", "latest").Result))
+	assert.Equal(t, rpc.INVALID_PARAMS, call("eth_getBalance", "0x1234").Error.Code)
+	assert.Equal(t, rpc.METHOD_NOT_FOUND, call("eth_mine").Error.Code)
+
+	txHash := "0x" + hex.EncodeToString(txs.Enclose("test-chain", &payload.CallTx{
+		Input:    &payload.TxInput{Address: account.Address(), Amount: 1, Sequence: 1},
+		Address:  &contract,
+		GasLimit: 100,
+		Data:     []byte{1},
+	}).Tx.Hash())
+	receipt := new(Receipt)
+	require.NoError(t, json.Unmarshal(call("eth_getTransactionReceipt", txHash).Result, receipt))
+	assert.Equal(t, Quantity(1), receipt.Status)
+	assert.Equal(t, Quantity(21), receipt.GasUsed)
+	assert.Equal(t, AddressData(contract), *receipt.To)
+	require.Len(t, receipt.Logs, 1)
+	assert.Equal(t, Data("data"), receipt.Logs[0].Data)
+	assert.Equal(t, Data(sha3.Sha3([]byte{1})), receipt.BlockHash)
+	assert.Equal(t, "null", string(call("eth_getTransactionReceipt", "0x"+strings.Repeat("00", 20)).Result))
+
+	var logs []*Log
+	require.NoError(t, json.Unmarshal(call("eth_getLogs", map[string]interface{}{
+		"fromBlock": "earliest",
+		"topics":    []interface{}{fmt.Sprintf("0x%x", topic.Bytes())},
+	}).Result, &logs))
+	require.Len(t, logs, 1)
+	assert.Equal(t, AddressData(contract), logs[0].Address)
+	require.NoError(t, json.Unmarshal(call("eth_getLogs", map[string]interface{}{
+		"fromBlock": "0x0",
+		"address":   []string{address},
+	}).Result, &logs))
+	assert.Len(t, logs, 0)
+	assert.Equal(t, rpc.INVALID_PARAMS, call("eth_getLogs", map[string]interface{}{
+		"fromBlock": "0x0",
+		"toBlock":   fmt.Sprintf("0x%x", MaxLogsBlockRange),
+	}).Error.Code)
+	service.maxLogs = 0
+	assert.Equal(t, ServerErrorCode, call("eth_getLogs", map[string]interface{}{
+		"fromBlock": "earliest",
+	}).Error.Code)
+
+	block := make(map[string]interface{})
+	require.NoError(t, json.Unmarshal(call("eth_getBlockByNumber", "0x1", false).Result, &block))
+	assert.Equal(t, []interface{}{txHash}, block["transactions"])
+	assert.Equal(t, "0x15", block["gasUsed"])
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	handler := NewHandler(map[string]Method{
+		"echo": func(params json.RawMessage) (interface{}, error) {
+			var q Quantity
+			err := parseParams(params, 1, &q)
+			return q, err
+		},
+	}, logging.NewNoopLogger())
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(
+		`[{"jsonrpc":"2.0","id":7,"method":"echo","params":["0xff"]},{"jsonrpc":"2.0","id":"a","method":"nope"}]`)))
+	var responses []*Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &responses))
+	require.Len(t, responses, 2)
+	assert.Equal(t, "7", string(responses[0].ID))
+	assert.Equal(t, `"0xff"`, string(responses[0].Result))
+	assert.Equal(t, `"a"`, string(responses[1].ID))
+	assert.Equal(t, rpc.METHOD_NOT_FOUND, responses[1].Error.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(`{"id":1`)))
+	response := new(Response)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), response))
+	assert.Equal(t, "null", string(response.ID))
+	assert.Equal(t, rpc.PARSE_ERROR, response.Error.Code)
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/", strings.NewReader(
+		`{"jsonrpc":"2.0","id":1,"method":"echo","params":["`+strings.Repeat("f", MaxRequestBytes)+`"]}`)))
+	response = new(Response)
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), response))
+	assert.Equal(t, rpc.INVALID_REQUEST, response.Error.Code)
+}
+
+type testTip struct {
+	bcm.BlockchainInfo
+	lastBlockHeight uint64
+}
+
+func (tt *testTip) LastBlockHeight() uint64 {
+	return tt.lastBlockHeight
+}
+
+func (tt *testTip) BlockHash(height uint64) ([]byte, error) {
+	return sha3.Sha3([]byte{byte(height)}), nil
+}
@@ -0,0 +1,119 @@
+package web3
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The block tags accepted in place of a block number
+const (
+	LatestBlockTag   = "latest"
+	EarliestBlockTag = "earliest"
+	PendingBlockTag  = "pending"
+)
+
+// An unsigned integer encoded as 0x-prefixed hex with no leading zeros
+type Quantity uint64
+
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("0x%x", uint64(q)))
+}
+
+func (q *Quantity) UnmarshalJSON(bs []byte) error {
+	var str string
+	err := json.Unmarshal(bs, &str)
+	if err != nil {
+		// Be lenient and accept plain JSON numbers
+		var n uint64
+		if json.Unmarshal(bs, &n) != nil {
+			return fmt.Errorf("quantity must be a hex string but got %s", string(bs))
+		}
+		*q = Quantity(n)
+		return nil
+	}
+	if !strings.HasPrefix(str, "0x") {
+		return fmt.Errorf("hex quantity %s must start with 0x", str)
+	}
+	n, err := strconv.ParseUint(str[2:], 16, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse hex quantity %s: %v", str, err)
+	}
+	*q = Quantity(n)
+	return nil
+}
+
+// Arbitrary bytes encoded as 0x-prefixed hex
+type Data []byte
+
+func (d Data) MarshalJSON() ([]byte, error) {
+	return json.Marshal("0x" + hex.EncodeToString(d))
+}
+
+func (d *Data) UnmarshalJSON(bs []byte) error {
+	var str string
+	err := json.Unmarshal(bs, &str)
+	if err != nil {
+		return fmt.Errorf("data must be a hex string but got %s", string(bs))
+	}
+	str = strings.TrimPrefix(str, "0x")
+	// Some clients drop the leading zero of the first byte
+	if len(str)%2 == 1 {
+		str = "0" + str
+	}
+	*d, err = hex.DecodeString(str)
+	if err != nil {
+		return fmt.Errorf("could not parse hex data %s: %v", string(bs), err)
+	}
+	return nil
+}
+
+func (d Data) Address() (crypto.Address, error) {
+	return crypto.AddressFromBytes(d)
+}
+
+func AddressData(address crypto.Address) Data {
+	return address.Bytes()
+}
+
+// A block height or one of the block tags. Both pending and latest refer to the last committed block since Burrow
+// has instant finality.
+type BlockNumber struct {
+	Height uint64
+	Latest bool
+}
+
+func (bn *BlockNumber) UnmarshalJSON(bs []byte) error {
+	var str string
+	err := json.Unmarshal(bs, &str)
+	if err == nil {
+		switch str {
+		case LatestBlockTag, PendingBlockTag, "":
+			*bn = BlockNumber{Latest: true}
+			return nil
+		case EarliestBlockTag:
+			*bn = BlockNumber{}
+			return nil
+		}
+	}
+	var q Quantity
+	err = json.Unmarshal(bs, &q)
+	if err != nil {
+		return fmt.Errorf("block number must be a hex quantity or one of %s, %s, or %s but got %s",
+			LatestBlockTag, EarliestBlockTag, PendingBlockTag, string(bs))
+	}
+	*bn = BlockNumber{Height: uint64(q)}
+	return nil
+}
+
+// Returns the height referred to given the current last block height
+func (bn BlockNumber) Resolve(lastBlockHeight uint64) uint64 {
+	if bn.Latest {
+		return lastBlockHeight
+	}
+	return bn.Height
+}
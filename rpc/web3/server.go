@@ -0,0 +1,191 @@
+package web3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/server"
+)
+
+const (
+	jsonRPCVersion = "2.0"
+	// Used by Ethereum clients for errors raised while executing a request
+	ServerErrorCode = -32000
+	// The largest request body (single or batched) that will be read
+	MaxRequestBytes = 5 << 20
+)
+
+// Unlike the requests of rpc/lib the ID may be any JSON value (Ethereum clients typically use numbers)
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+type Error struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("JSON-RPC error %d: %s", err.Code, err.Message)
+}
+
+func invalidParams(format string, args ...interface{}) *Error {
+	return &Error{
+		Code:    rpc.INVALID_PARAMS,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func serverError(data interface{}, format string, args ...interface{}) *Error {
+	return &Error{
+		Code:    ServerErrorCode,
+		Message: fmt.Sprintf(format, args...),
+		Data:    data,
+	}
+}
+
+// Unmarshals the positional params into args, of which the first required must be provided
+func parseParams(params json.RawMessage, required int, args ...interface{}) error {
+	var raws []json.RawMessage
+	if len(params) > 0 && string(params) != "null" {
+		err := json.Unmarshal(params, &raws)
+		if err != nil {
+			return invalidParams("params must be an array: %v", err)
+		}
+	}
+	if len(raws) < required || len(raws) > len(args) {
+		return invalidParams("expected between %d and %d params but got %d", required, len(args), len(raws))
+	}
+	for i, raw := range raws {
+		// Optional params may be given as null
+		if i >= required && string(raw) == "null" {
+			continue
+		}
+		err := json.Unmarshal(raw, args[i])
+		if err != nil {
+			return invalidParams("could not parse param %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// Serves JSON-RPC 2.0 requests, either single or batched, POSTed over HTTP
+type Handler struct {
+	methods map[string]Method
+	logger  *logging.Logger
+}
+
+func NewHandler(methods map[string]Method, logger *logging.Logger) *Handler {
+	return &Handler{
+		methods: methods,
+		logger:  logger,
+	}
+}
+
+func StartServer(service *EthService, listenAddress string, logger *logging.Logger) (*http.Server, error) {
+	logger = logger.With(structure.ComponentKey, "RPC_Web3")
+	return server.StartHTTPServer(listenAddress, NewHandler(service.Methods(), logger), logger)
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "JSON-RPC requests must be sent with POST", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBytes))
+	if err != nil {
+		h.write(w, errorResponse(nil, rpc.INVALID_REQUEST, "could not read request: %v", err))
+		return
+	}
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		var requests []*Request
+		err = json.Unmarshal(body, &requests)
+		if err != nil {
+			h.write(w, errorResponse(nil, rpc.PARSE_ERROR, "could not parse batch request: %v", err))
+			return
+		}
+		if len(requests) == 0 {
+			h.write(w, errorResponse(nil, rpc.INVALID_REQUEST, "batch request is empty"))
+			return
+		}
+		responses := make([]*Response, len(requests))
+		for i, request := range requests {
+			responses[i] = h.Handle(request)
+		}
+		h.write(w, responses)
+		return
+	}
+	request := new(Request)
+	err = json.Unmarshal(body, request)
+	if err != nil {
+		h.write(w, errorResponse(nil, rpc.PARSE_ERROR, "could not parse request: %v", err))
+		return
+	}
+	h.write(w, h.Handle(request))
+}
+
+func (h *Handler) Handle(request *Request) *Response {
+	method, ok := h.methods[request.Method]
+	if !ok {
+		return errorResponse(request.ID, rpc.METHOD_NOT_FOUND, "method %s not found", request.Method)
+	}
+	result, err := method(request.Params)
+	if err != nil {
+		h.logger.TraceMsg("Error handling JSON-RPC request", "method", request.Method,
+			structure.ErrorKey, err)
+		response := errorResponse(request.ID, ServerErrorCode, "%v", err)
+		if rpcErr, ok := err.(*Error); ok {
+			response.Error = rpcErr
+		}
+		return response
+	}
+	bs, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(request.ID, rpc.INTERNAL_ERROR, "could not encode result: %v", err)
+	}
+	return &Response{
+		JSONRPC: jsonRPCVersion,
+		ID:      request.ID,
+		Result:  bs,
+	}
+}
+
+func (h *Handler) write(w http.ResponseWriter, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		h.logger.InfoMsg("Could not write JSON-RPC response", structure.ErrorKey, err)
+	}
+}
+
+func errorResponse(id json.RawMessage, code int, format string, args ...interface{}) *Response {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &Response{
+		JSONRPC: jsonRPCVersion,
+		ID:      id,
+		Error: &Error{
+			Code:    code,
+			Message: fmt.Sprintf(format, args...),
+		},
+	}
+}
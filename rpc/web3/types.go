@@ -0,0 +1,151 @@
+package web3
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// The call object taken by eth_call and eth_estimateGas
+type CallArgs struct {
+	From     Data     `json:"from"`
+	To       Data     `json:"to"`
+	Gas      Quantity `json:"gas"`
+	GasPrice Quantity `json:"gasPrice"`
+	Value    Quantity `json:"value"`
+	Data     Data     `json:"data"`
+}
+
+// The filter object taken by eth_getLogs
+type FilterArgs struct {
+	FromBlock *BlockNumber
+	ToBlock   *BlockNumber
+	// Logs from any of these addresses match (all addresses match if empty)
+	Addresses []crypto.Address
+	// For each position the topics that match at that position (any topic matches if empty)
+	Topics [][]binary.Word256
+}
+
+func (fa *FilterArgs) UnmarshalJSON(bs []byte) error {
+	filter := new(struct {
+		FromBlock *BlockNumber      `json:"fromBlock"`
+		ToBlock   *BlockNumber      `json:"toBlock"`
+		Address   json.RawMessage   `json:"address"`
+		Topics    []json.RawMessage `json:"topics"`
+		BlockHash *Data             `json:"blockHash"`
+	})
+	err := json.Unmarshal(bs, filter)
+	if err != nil {
+		return err
+	}
+	if filter.BlockHash != nil {
+		return fmt.Errorf("filtering by blockHash is not supported, use fromBlock and toBlock")
+	}
+	fa.FromBlock = filter.FromBlock
+	fa.ToBlock = filter.ToBlock
+	var addresses []Data
+	if len(filter.Address) > 0 && string(filter.Address) != "null" {
+		if filter.Address[0] == '[' {
+			err = json.Unmarshal(filter.Address, &addresses)
+		} else {
+			addresses = make([]Data, 1)
+			err = json.Unmarshal(filter.Address, &addresses[0])
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, address := range addresses {
+		addr, err := address.Address()
+		if err != nil {
+			return err
+		}
+		fa.Addresses = append(fa.Addresses, addr)
+	}
+	for _, raw := range filter.Topics {
+		var topics []Data
+		switch {
+		case len(raw) == 0 || string(raw) == "null":
+		case raw[0] == '[':
+			err = json.Unmarshal(raw, &topics)
+		default:
+			topics = make([]Data, 1)
+			err = json.Unmarshal(raw, &topics[0])
+		}
+		if err != nil {
+			return err
+		}
+		words := make([]binary.Word256, len(topics))
+		for i, topic := range topics {
+			if len(topic) != binary.Word256Length {
+				return fmt.Errorf("topic %X should be %d bytes long", []byte(topic), binary.Word256Length)
+			}
+			words[i] = binary.LeftPadWord256(topic)
+		}
+		fa.Topics = append(fa.Topics, words)
+	}
+	return nil
+}
+
+type Block struct {
+	Number       Quantity      `json:"number"`
+	Hash         Data          `json:"hash"`
+	ParentHash   Data          `json:"parentHash"`
+	Nonce        Data          `json:"nonce"`
+	LogsBloom    Data          `json:"logsBloom"`
+	Miner        Data          `json:"miner"`
+	Difficulty   Quantity      `json:"difficulty"`
+	ExtraData    Data          `json:"extraData"`
+	GasLimit     Quantity      `json:"gasLimit"`
+	GasUsed      Quantity      `json:"gasUsed"`
+	Timestamp    Quantity      `json:"timestamp"`
+	Transactions []interface{} `json:"transactions"`
+	Uncles       []Data        `json:"uncles"`
+}
+
+type Transaction struct {
+	Hash             Data     `json:"hash"`
+	Nonce            Quantity `json:"nonce"`
+	BlockHash        Data     `json:"blockHash"`
+	BlockNumber      Quantity `json:"blockNumber"`
+	TransactionIndex Quantity `json:"transactionIndex"`
+	From             Data     `json:"from"`
+	// Null for contract creation
+	To       *Data    `json:"to"`
+	Value    Quantity `json:"value"`
+	Gas      Quantity `json:"gas"`
+	GasPrice Quantity `json:"gasPrice"`
+	Input    Data     `json:"input"`
+}
+
+type Receipt struct {
+	TransactionHash  Data     `json:"transactionHash"`
+	TransactionIndex Quantity `json:"transactionIndex"`
+	BlockHash        Data     `json:"blockHash"`
+	BlockNumber      Quantity `json:"blockNumber"`
+	From             Data     `json:"from"`
+	// Null for contract creation
+	To                *Data    `json:"to"`
+	CumulativeGasUsed Quantity `json:"cumulativeGasUsed"`
+	GasUsed           Quantity `json:"gasUsed"`
+	// Null unless a contract was created
+	ContractAddress *Data  `json:"contractAddress"`
+	Logs            []*Log `json:"logs"`
+	LogsBloom       Data   `json:"logsBloom"`
+	// 1 for success and 0 for failure
+	Status Quantity `json:"status"`
+}
+
+type Log struct {
+	Removed          bool     `json:"removed"`
+	LogIndex         Quantity `json:"logIndex"`
+	TransactionIndex Quantity `json:"transactionIndex"`
+	TransactionHash  Data     `json:"transactionHash"`
+	BlockHash        Data     `json:"blockHash"`
+	BlockNumber      Quantity `json:"blockNumber"`
+	Address          Data     `json:"address"`
+	Data             Data     `json:"data"`
+	Topics           []Data   `json:"topics"`
+}
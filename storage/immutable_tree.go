@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"github.com/tendermint/iavl"
+)
+
+// A KVIterableReader backed by a merkle tree that can prove its contents against its root hash
+type ProvableReader interface {
+	KVIterableReader
+	Hash() []byte
+	// Returns the value at key (or nil) with a proof of its existence or absence
+	GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error)
+}
+
+// A read-only view of a merkle tree at some version
+type ImmutableTree struct {
+	*iavl.ImmutableTree
+}
+
+var _ ProvableReader = &ImmutableTree{}
+
+func (imt *ImmutableTree) Get(key []byte) []byte {
+	_, value := imt.ImmutableTree.Get(key)
+	return value
+}
+
+func (imt *ImmutableTree) Has(key []byte) bool {
+	return imt.Get(key) != nil
+}
+
+func (imt *ImmutableTree) Iterator(start, end []byte) KVIterator {
+	ch := make(chan KVPair)
+	go func() {
+		defer close(ch)
+		imt.IterateRange(start, end, true, func(key, value []byte) (stop bool) {
+			ch <- KVPair{key, value}
+			return
+		})
+	}()
+	return NewChannelIterator(ch, start, end)
+}
+
+func (imt *ImmutableTree) ReverseIterator(start, end []byte) KVIterator {
+	ch := make(chan KVPair)
+	go func() {
+		defer close(ch)
+		imt.IterateRange(start, end, false, func(key, value []byte) (stop bool) {
+			ch <- KVPair{key, value}
+			return
+		})
+	}()
+	return NewChannelIterator(ch, start, end)
+}
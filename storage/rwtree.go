@@ -14,13 +14,13 @@ type RWTree struct {
 	// Working tree accumulating writes
 	tree *iavl.MutableTree
 	// Read tree serving previous state
-	readTree *iavl.ImmutableTree
+	readTree *ImmutableTree
 }
 
 func NewRWTree(db dbm.DB, cacheSize int) *RWTree {
 	return &RWTree{
 		tree:     iavl.NewMutableTree(db, cacheSize),
-		readTree: iavl.NewImmutableTree(db, cacheSize),
+		readTree: &ImmutableTree{iavl.NewImmutableTree(db, cacheSize)},
 	}
 }
 
@@ -36,13 +36,10 @@ func (rwt *RWTree) Load(version int64) error {
 	if treeVersion != version {
 		return fmt.Errorf("tried to load version %d of RWTree, but got version %d", version, treeVersion)
 	}
-	// Load previous version for readTree
-	// Set readTree
-	if version > 0 {
-		rwt.readTree, err = rwt.tree.GetImmutable(version - 1)
-		if err != nil {
-			return fmt.Errorf("could not load previous version of RWTree to use as read version")
-		}
+	// Set readTree to serve the version we have just loaded
+	rwt.readTree, err = rwt.GetImmutable(version)
+	if err != nil {
+		return fmt.Errorf("could not load version %d of RWTree to use as read version", version)
 	}
 	return nil
 }
@@ -55,20 +52,33 @@ func (rwt *RWTree) Save() ([]byte, int64, error) {
 		return nil, 0, fmt.Errorf("could not save RWTree: %v", err)
 	}
 	// Take an immutable reference to the tree we just saved for querying
-	rwt.readTree, err = rwt.tree.GetImmutable(version)
+	rwt.readTree, err = rwt.GetImmutable(version)
 	if err != nil {
 		return nil, 0, fmt.Errorf("RWTree.Save() could not obtain ImmutableTree read tree: %v", err)
 	}
 	return hash, version, nil
 }
 
+// Returns a read-only view of a previously saved version of the tree
+func (rwt *RWTree) GetImmutable(version int64) (*ImmutableTree, error) {
+	tree, err := rwt.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+	return &ImmutableTree{tree}, nil
+}
+
+// Removes a previously saved version of the tree (which must not be the latest) from storage
+func (rwt *RWTree) DeleteVersion(version int64) error {
+	return rwt.tree.DeleteVersion(version)
+}
+
 func (rwt *RWTree) Set(key, value []byte) {
 	rwt.tree.Set(key, value)
 }
 
 func (rwt *RWTree) Get(key []byte) []byte {
-	_, value := rwt.readTree.Get(key)
-	return value
+	return rwt.readTree.Get(key)
 }
 
 func (rwt *RWTree) IterateRange(start, end []byte, ascending bool, fn func(key []byte, value []byte) bool) (stopped bool) {
@@ -79,6 +89,10 @@ func (rwt *RWTree) Hash() []byte {
 	return rwt.readTree.Hash()
 }
 
+func (rwt *RWTree) GetWithProof(key []byte) ([]byte, *iavl.RangeProof, error) {
+	return rwt.readTree.GetWithProof(key)
+}
+
 func (rwt *RWTree) Has(key []byte) bool {
 	return rwt.Get(key) != nil
 }
@@ -88,25 +102,9 @@ func (rwt *RWTree) Delete(key []byte) {
 }
 
 func (rwt *RWTree) Iterator(start, end []byte) dbm.Iterator {
-	ch := make(chan KVPair)
-	go func() {
-		defer close(ch)
-		rwt.readTree.IterateRange(start, end, true, func(key, value []byte) (stop bool) {
-			ch <- KVPair{key, value}
-			return
-		})
-	}()
-	return NewChannelIterator(ch, start, end)
+	return rwt.readTree.Iterator(start, end)
 }
 
 func (rwt *RWTree) ReverseIterator(start, end []byte) dbm.Iterator {
-	ch := make(chan KVPair)
-	go func() {
-		defer close(ch)
-		rwt.readTree.IterateRange(start, end, false, func(key, value []byte) (stop bool) {
-			ch <- KVPair{key, value}
-			return
-		})
-	}()
-	return NewChannelIterator(ch, start, end)
+	return rwt.readTree.ReverseIterator(start, end)
 }
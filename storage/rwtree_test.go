@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tendermint/libs/db"
 )
 
@@ -20,3 +21,43 @@ func TestSave(t *testing.T) {
 	rwt.Save()
 	assert.Equal(t, dam, rwt.Get(foo))
 }
+
+func TestLoad(t *testing.T) {
+	db := dbm.NewMemDB()
+	rwt := NewRWTree(db, 100)
+	foo := bz("foo")
+	rwt.Set(foo, bz("gaa"))
+	rwt.Save()
+	rwt.Set(foo, bz("dam"))
+	hash, version, err := rwt.Save()
+	require.NoError(t, err)
+
+	// Reads after loading are served from the loaded version
+	rwt = NewRWTree(db, 100)
+	require.NoError(t, rwt.Load(version))
+	assert.Equal(t, bz("dam"), rwt.Get(foo))
+	assert.Equal(t, hash, rwt.Hash())
+}
+
+func TestRWTree_GetImmutable(t *testing.T) {
+	db := dbm.NewMemDB()
+	rwt := NewRWTree(db, 100)
+	foo := bz("foo")
+	gaa := bz("gaa")
+	dam := bz("dam")
+	rwt.Set(foo, gaa)
+	_, version, err := rwt.Save()
+	require.NoError(t, err)
+	rwt.Set(foo, dam)
+	_, _, err = rwt.Save()
+	require.NoError(t, err)
+
+	tree, err := rwt.GetImmutable(version)
+	require.NoError(t, err)
+	assert.Equal(t, gaa, tree.Get(foo))
+	assert.Equal(t, dam, rwt.Get(foo))
+
+	require.NoError(t, rwt.DeleteVersion(version))
+	_, err = rwt.GetImmutable(version)
+	assert.Error(t, err)
+}
@@ -80,3 +80,34 @@ func TestAminoTxEnvelope(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, txEnv, txEnvOut)
 }
+
+func TestAminoEncodeTxDecodeTx_BatchTx(t *testing.T) {
+	codec := NewAminoCodec()
+	inputAccount := acm.GeneratePrivateAccountFromSecret("fooo")
+	outputAddress := crypto.Address{5, 4, 3, 2, 1}
+	tx := &payload.BatchTx{}
+	tx.Add(&payload.SendTx{
+		Inputs:  []*payload.TxInput{{Address: inputAccount.Address(), Amount: 2}},
+		Outputs: []*payload.TxOutput{{Address: outputAddress, Amount: 2}},
+	}, &payload.CallTx{
+		Input:    &payload.TxInput{Address: inputAccount.Address(), Amount: 1},
+		Address:  &outputAddress,
+		GasLimit: 233,
+		Data:     []byte("code"),
+	})
+	require.Len(t, tx.Inputs, 1)
+	tx.Inputs[0].Sequence = 3
+	txEnv := Enclose(chainID, tx)
+	require.NoError(t, txEnv.Sign(inputAccount))
+	txBytes, err := codec.EncodeTx(txEnv)
+	require.NoError(t, err)
+	txEnvOut, err := codec.DecodeTx(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, txEnv, txEnvOut)
+	// Round trip through the JSON used for SignBytes
+	bs, err := txEnv.Tx.MarshalJSON()
+	require.NoError(t, err)
+	txOut := new(Tx)
+	require.NoError(t, txOut.UnmarshalJSON(bs))
+	assert.Equal(t, txEnv.Tx.Payload, txOut.Payload)
+}
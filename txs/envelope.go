@@ -85,6 +85,21 @@ func (sig *Signatory) Validate() error {
 	if sig.Address == nil {
 		return fmt.Errorf("has nil Address: %v", sig)
 	}
+	if len(sig.Multisig) > 0 {
+		if sig.PublicKey != nil {
+			return fmt.Errorf("has both PublicKey and Multisig signatures: %v", sig)
+		}
+		for i, member := range sig.Multisig {
+			if len(member.Multisig) > 0 {
+				return fmt.Errorf("multisig signatory %v has nested Multisig signatures", i)
+			}
+			err := member.Validate()
+			if err != nil {
+				return fmt.Errorf("multisig signatory %v is invalid: %v", i, err)
+			}
+		}
+		return nil
+	}
 	if sig.PublicKey == nil {
 		return fmt.Errorf("has nil PublicKey: %v", sig)
 	}
@@ -92,7 +107,8 @@ func (sig *Signatory) Validate() error {
 }
 
 // Verifies the validity of the Signatories' Signatures in the Envelope. The Signatories must
-// appear in the same order as the inputs as returned by Tx.GetInputs().
+// appear in the same order as the inputs as returned by Tx.GetInputs(). Inputs from multisig accounts in getter must
+// be signed by the threshold of the account's members.
 func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error {
 	err := txEnv.Validate()
 	if err != nil {
@@ -118,6 +134,28 @@ func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error
 			return fmt.Errorf("signatory %v has address %v but input %v has address %v",
 				i, *s.Address, i, inputs[i].Address)
 		}
+		// Multisig accounts can only be verified against state
+		if getter != nil {
+			acc, err := getter.GetAccount(*s.Address)
+			if err != nil {
+				return fmt.Errorf("%s: could not get account %v: %v", errPrefix, *s.Address, err)
+			}
+			if acc != nil && acc.Multisig() != nil {
+				err = s.verifyMultisig(acc.Multisig(), signBytes)
+				if err != nil {
+					return fmt.Errorf("%s: %v", errPrefix, err)
+				}
+				continue
+			}
+		}
+		if len(s.Multisig) > 0 {
+			return fmt.Errorf("signatory %v has Multisig signatures but is not a known multisig account",
+				*s.Address)
+		}
+		if s.PublicKey.Address() != *s.Address {
+			return fmt.Errorf("%s: public key %v of signatory does not match its address %v", errPrefix,
+				s.PublicKey, *s.Address)
+		}
 		err = s.PublicKey.Verify(signBytes, s.Signature)
 		if err != nil {
 			return fmt.Errorf("invalid signature in signatory %v: %v", *s.Address, err)
@@ -126,9 +164,49 @@ func (txEnv *Envelope) Verify(getter state.AccountGetter, chainID string) error
 	return nil
 }
 
+// Checks that the Multisig signatures of the signatory for a multisig account with policy are those of at least the
+// policy's threshold of distinct members
+func (s *Signatory) verifyMultisig(policy *acm.MultisigPolicy, signBytes []byte) error {
+	if len(s.Multisig) == 0 {
+		return fmt.Errorf("signatory %v is a multisig account so must have Multisig signatures", *s.Address)
+	}
+	signed := make(map[crypto.Address]bool)
+	for _, member := range s.Multisig {
+		publicKey := policy.Member(*member.Address)
+		if publicKey == nil {
+			return fmt.Errorf("%v is not a member of multisig account %v", *member.Address, *s.Address)
+		}
+		if member.PublicKey.Address() != *member.Address {
+			return fmt.Errorf("public key %v of multisig signatory does not match its address %v",
+				member.PublicKey, *member.Address)
+		}
+		if signed[*member.Address] {
+			return fmt.Errorf("%v signed more than once for multisig account %v", *member.Address, *s.Address)
+		}
+		err := publicKey.Verify(signBytes, member.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid signature by %v for multisig account %v: %v", *member.Address,
+				*s.Address, err)
+		}
+		signed[*member.Address] = true
+	}
+	if uint64(len(signed)) < policy.Threshold {
+		return fmt.Errorf("multisig account %v requires %d signatures but has %d", *s.Address, policy.Threshold,
+			len(signed))
+	}
+	return nil
+}
+
 // Sign the Tx Envelope by adding Signatories containing the signatures for each TxInput.
 // signing accounts for each input must be provided (in any order).
 func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
+	return txEnv.SignMultisig(nil, signingAccounts...)
+}
+
+// Sign the Tx Envelope like Sign, but inputs from the multisig accounts controlled by policies are signed by those of
+// signingAccounts that are members of the account. Signatures from other members can be appended to the Multisig
+// signatures of the input's Signatory until the account's threshold is met.
+func (txEnv *Envelope) SignMultisig(policies []*acm.MultisigPolicy, signingAccounts ...acm.AddressableSigner) error {
 	// Clear any existing
 	txEnv.Signatories = nil
 	signBytes, err := txEnv.Tx.SignBytes()
@@ -139,8 +217,20 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	for _, sa := range signingAccounts {
 		signingAccountMap[sa.Address()] = sa
 	}
+	policyMap := make(map[crypto.Address]*acm.MultisigPolicy)
+	for _, policy := range policies {
+		policyMap[policy.Address()] = policy
+	}
 	// Sign in order of inputs
 	for i, in := range txEnv.Tx.GetInputs() {
+		if policy, ok := policyMap[in.Address]; ok {
+			signatory, err := signMultisig(policy, signBytes, signingAccountMap)
+			if err != nil {
+				return err
+			}
+			txEnv.Signatories = append(txEnv.Signatories, signatory)
+			continue
+		}
 		sa, ok := signingAccountMap[in.Address]
 		if !ok {
 			return fmt.Errorf("account to sign %v (position %v) not passed to Sign, passed: %v", in, i, signingAccounts)
@@ -160,6 +250,34 @@ func (txEnv *Envelope) Sign(signingAccounts ...acm.AddressableSigner) error {
 	return nil
 }
 
+func signMultisig(policy *acm.MultisigPolicy, signBytes []byte,
+	signingAccountMap map[crypto.Address]acm.AddressableSigner) (Signatory, error) {
+
+	address := policy.Address()
+	signatory := Signatory{Address: &address}
+	for _, publicKey := range policy.PublicKeys {
+		sa, ok := signingAccountMap[publicKey.Address()]
+		if !ok {
+			continue
+		}
+		sig, err := sa.Sign(signBytes)
+		if err != nil {
+			return Signatory{}, err
+		}
+		memberAddress := sa.Address()
+		memberPublicKey := sa.PublicKey()
+		signatory.Multisig = append(signatory.Multisig, Signatory{
+			Address:   &memberAddress,
+			PublicKey: &memberPublicKey,
+			Signature: sig,
+		})
+	}
+	if len(signatory.Multisig) == 0 {
+		return Signatory{}, fmt.Errorf("no members of multisig account %v passed to Sign", address)
+	}
+	return signatory, nil
+}
+
 func (txEnv *Envelope) Tagged() query.Tagged {
 	return query.MergeTags(query.MustReflectTags(txEnv, "Signatories"), txEnv.Tx.Tagged())
 }
@@ -0,0 +1,55 @@
+package payload
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+func (tx *BatchTx) Type() Type {
+	return TypeBatch
+}
+
+func (tx *BatchTx) GetInputs() []*TxInput {
+	return tx.Inputs
+}
+
+func (tx *BatchTx) String() string {
+	return fmt.Sprintf("BatchTx{%v -> %v}", tx.Inputs, tx.Txs)
+}
+
+func (tx *BatchTx) Any() *Any {
+	return &Any{
+		BatchTx: tx,
+	}
+}
+
+// The total of the fees paid by the transactions in the batch
+func (tx *BatchTx) GetFee() uint64 {
+	var fee uint64
+	for _, any := range tx.Txs {
+		fee += Fee(any.GetValue())
+	}
+	return fee
+}
+
+// Adds each payload to the batch along with an input for each of their input addresses not already included
+func (tx *BatchTx) Add(payloads ...Payload) {
+	for _, p := range payloads {
+		for _, input := range p.GetInputs() {
+			if !tx.hasInput(input.Address) {
+				tx.Inputs = append(tx.Inputs, &TxInput{Address: input.Address})
+			}
+		}
+		tx.Txs = append(tx.Txs, p.Any())
+	}
+}
+
+func (tx *BatchTx) hasInput(address crypto.Address) bool {
+	for _, input := range tx.Inputs {
+		if input.Address == address {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,9 @@ func (tx *GovTx) GetInputs() []*TxInput {
 }
 
 func (tx *GovTx) String() string {
+	if len(tx.MinimumFees) > 0 {
+		return fmt.Sprintf("GovTx{%v -> %v, %v}", tx.Inputs, tx.AccountUpdates, tx.MinimumFees)
+	}
 	return fmt.Sprintf("GovTx{%v -> %v}", tx.Inputs, tx.AccountUpdates)
 }
 
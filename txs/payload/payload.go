@@ -11,6 +11,7 @@ Account Txs:
  - SendTx         Send coins to address
  - CallTx         Send a msg to a contract that runs in the vm
  - NameTx	  Store some value under a name in the global namereg
+ - BatchTx        Execute several account and admin txs atomically
 
 Validation Txs:
  - BondTx         New validator posts a bond
@@ -29,6 +30,8 @@ const (
 	TypeSend = Type(0x01)
 	TypeCall = Type(0x02)
 	TypeName = Type(0x03)
+	// Executes other transactions atomically
+	TypeBatch = Type(0x04)
 
 	// Validation transactions
 	TypeBond   = Type(0x11)
@@ -44,6 +47,7 @@ var nameFromType = map[Type]string{
 	TypeSend:        "SendTx",
 	TypeCall:        "CallTx",
 	TypeName:        "NameTx",
+	TypeBatch:       "BatchTx",
 	TypeBond:        "BondTx",
 	TypeUnbond:      "UnbondTx",
 	TypePermissions: "PermsTx",
@@ -79,6 +83,15 @@ func (typ *Type) UnmarshalText(data []byte) error {
 	return nil
 }
 
+// Whether payloads of this type carry a fee, and so whether a minimum fee can be set for them
+func (typ Type) PaysFee() bool {
+	switch typ {
+	case TypeCall, TypeName, TypeBatch:
+		return true
+	}
+	return false
+}
+
 // Protobuf support
 func (typ Type) Marshal() ([]byte, error) {
 	return typ.MarshalText()
@@ -113,6 +126,41 @@ func New(txType Type) (Payload, error) {
 		return &PermsTx{}, nil
 	case TypeGovernance:
 		return &GovTx{}, nil
+	case TypeBatch:
+		return &BatchTx{}, nil
 	}
 	return nil, fmt.Errorf("unknown payload type: %d", txType)
 }
+
+// Returns the payload held by any or nil if it holds none
+func (any *Any) GetValue() Payload {
+	switch {
+	case any == nil:
+		return nil
+	case any.CallTx != nil:
+		return any.CallTx
+	case any.SendTx != nil:
+		return any.SendTx
+	case any.NameTx != nil:
+		return any.NameTx
+	case any.PermsTx != nil:
+		return any.PermsTx
+	case any.GovTx != nil:
+		return any.GovTx
+	case any.BondTx != nil:
+		return any.BondTx
+	case any.UnbondTx != nil:
+		return any.UnbondTx
+	case any.BatchTx != nil:
+		return any.BatchTx
+	}
+	return nil
+}
+
+// Returns the fee paid by tx or zero if it does not carry one
+func Fee(tx Payload) uint64 {
+	if feePayer, ok := tx.(interface{ GetFee() uint64 }); ok {
+		return feePayer.GetFee()
+	}
+	return 0
+}
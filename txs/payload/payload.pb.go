@@ -18,6 +18,8 @@
 		BondTx
 		UnbondTx
 		GovTx
+		MinimumFee
+		BatchTx
 */
 package payload
 
@@ -27,6 +29,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import permission "github.com/hyperledger/burrow/permission"
+import acm "github.com/hyperledger/burrow/acm"
 import spec "github.com/hyperledger/burrow/genesis/spec"
 
 import github_com_hyperledger_burrow_crypto "github.com/hyperledger/burrow/crypto"
@@ -54,6 +57,7 @@ type Any struct {
 	GovTx    *GovTx    `protobuf:"bytes,5,opt,name=GovTx" json:"GovTx,omitempty"`
 	BondTx   *BondTx   `protobuf:"bytes,6,opt,name=BondTx" json:"BondTx,omitempty"`
 	UnbondTx *UnbondTx `protobuf:"bytes,7,opt,name=UnbondTx" json:"UnbondTx,omitempty"`
+	BatchTx  *BatchTx  `protobuf:"bytes,8,opt,name=BatchTx" json:"BatchTx,omitempty"`
 }
 
 func (m *Any) Reset()                    { *m = Any{} }
@@ -110,6 +114,13 @@ func (m *Any) GetUnbondTx() *UnbondTx {
 	return nil
 }
 
+func (m *Any) GetBatchTx() *BatchTx {
+	if m != nil {
+		return m.BatchTx
+	}
+	return nil
+}
+
 func (*Any) XXX_MessageName() string {
 	return "payload.Any"
 }
@@ -182,6 +193,8 @@ type CallTx struct {
 	Fee uint64 `protobuf:"varint,4,opt,name=Fee,proto3" json:"Fee,omitempty"`
 	// EVM bytecode payload
 	Data github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,5,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	// Metadata to store with the contract, only allowed when creating a contract
+	ContractMeta *acm.ContractMeta `protobuf:"bytes,6,opt,name=ContractMeta" json:"ContractMeta,omitempty"`
 }
 
 func (m *CallTx) Reset()                    { *m = CallTx{} }
@@ -209,6 +222,13 @@ func (m *CallTx) GetFee() uint64 {
 	return 0
 }
 
+func (m *CallTx) GetContractMeta() *acm.ContractMeta {
+	if m != nil {
+		return m.ContractMeta
+	}
+	return nil
+}
+
 func (*CallTx) XXX_MessageName() string {
 	return "payload.CallTx"
 }
@@ -337,6 +357,8 @@ func (*UnbondTx) XXX_MessageName() string {
 type GovTx struct {
 	Inputs         []*TxInput              `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
 	AccountUpdates []*spec.TemplateAccount `protobuf:"bytes,2,rep,name=AccountUpdates" json:"AccountUpdates,omitempty"`
+	// Changes to the minimum fees that transactions must pay
+	MinimumFees []*MinimumFee `protobuf:"bytes,3,rep,name=MinimumFees" json:"MinimumFees,omitempty"`
 }
 
 func (m *GovTx) Reset()                    { *m = GovTx{} }
@@ -346,6 +368,52 @@ func (*GovTx) Descriptor() ([]byte, []int) { return fileDescriptorPayload, []int
 func (*GovTx) XXX_MessageName() string {
 	return "payload.GovTx"
 }
+
+// The minimum fee that must be paid by transactions of a payload type
+type MinimumFee struct {
+	TxType Type   `protobuf:"varint,1,opt,name=TxType,proto3,casttype=Type" json:"TxType,omitempty"`
+	Fee    uint64 `protobuf:"varint,2,opt,name=Fee,proto3" json:"Fee,omitempty"`
+}
+
+func (m *MinimumFee) Reset()                    { *m = MinimumFee{} }
+func (m *MinimumFee) String() string            { return proto.CompactTextString(m) }
+func (*MinimumFee) ProtoMessage()               {}
+func (*MinimumFee) Descriptor() ([]byte, []int) { return fileDescriptorPayload, []int{10} }
+
+func (m *MinimumFee) GetTxType() Type {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *MinimumFee) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (*MinimumFee) XXX_MessageName() string {
+	return "payload.MinimumFee"
+}
+
+// Several transactions executed in order that either all succeed or all fail, leaving state unchanged
+type BatchTx struct {
+	// The signers of the batch, which must include every input of the transactions in Txs. The sequence numbers of
+	// these inputs are checked and incremented rather than those of the transactions in Txs.
+	Inputs []*TxInput `protobuf:"bytes,1,rep,name=Inputs" json:"Inputs,omitempty"`
+	// The transactions to execute, which may be SendTx, CallTx, NameTx, or PermsTx
+	Txs []*Any `protobuf:"bytes,2,rep,name=Txs" json:"Txs,omitempty"`
+}
+
+func (m *BatchTx) Reset()                    { *m = BatchTx{} }
+func (*BatchTx) ProtoMessage()               {}
+func (*BatchTx) Descriptor() ([]byte, []int) { return fileDescriptorPayload, []int{11} }
+
+func (*BatchTx) XXX_MessageName() string {
+	return "payload.BatchTx"
+}
 func init() {
 	proto.RegisterType((*Any)(nil), "payload.Any")
 	golang_proto.RegisterType((*Any)(nil), "payload.Any")
@@ -367,6 +435,10 @@ func init() {
 	golang_proto.RegisterType((*UnbondTx)(nil), "payload.UnbondTx")
 	proto.RegisterType((*GovTx)(nil), "payload.GovTx")
 	golang_proto.RegisterType((*GovTx)(nil), "payload.GovTx")
+	proto.RegisterType((*MinimumFee)(nil), "payload.MinimumFee")
+	golang_proto.RegisterType((*MinimumFee)(nil), "payload.MinimumFee")
+	proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
+	golang_proto.RegisterType((*BatchTx)(nil), "payload.BatchTx")
 }
 func (m *Any) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -453,6 +525,16 @@ func (m *Any) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n7
 	}
+	if m.BatchTx != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.BatchTx.Size()))
+		n8, err := m.BatchTx.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
 	return i, nil
 }
 
@@ -474,11 +556,11 @@ func (m *TxInput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n8, err := m.Address.MarshalTo(dAtA[i:])
+	n9, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n9
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -510,11 +592,11 @@ func (m *TxOutput) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0xa
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n9, err := m.Address.MarshalTo(dAtA[i:])
+	n10, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n10
 	if m.Amount != 0 {
 		dAtA[i] = 0x10
 		i++
@@ -542,21 +624,21 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n10, err := m.Input.MarshalTo(dAtA[i:])
+		n11, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n11
 	}
 	if m.Address != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-		n11, err := m.Address.MarshalTo(dAtA[i:])
+		n12, err := m.Address.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n12
 	}
 	if m.GasLimit != 0 {
 		dAtA[i] = 0x18
@@ -571,11 +653,21 @@ func (m *CallTx) MarshalTo(dAtA []byte) (int, error) {
 	dAtA[i] = 0x2a
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Data.Size()))
-	n12, err := m.Data.MarshalTo(dAtA[i:])
+	n13, err := m.Data.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n12
+	i += n13
+	if m.ContractMeta != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.ContractMeta.Size()))
+		n14, err := m.ContractMeta.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
 	return i, nil
 }
 
@@ -640,20 +732,20 @@ func (m *PermsTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n13, err := m.Input.MarshalTo(dAtA[i:])
+		n15, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.PermArgs.Size()))
-	n14, err := m.PermArgs.MarshalTo(dAtA[i:])
+	n16, err := m.PermArgs.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n14
+	i += n16
 	return i, nil
 }
 
@@ -676,11 +768,11 @@ func (m *NameTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n15, err := m.Input.MarshalTo(dAtA[i:])
+		n17, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	if len(m.Name) > 0 {
 		dAtA[i] = 0x12
@@ -763,20 +855,20 @@ func (m *UnbondTx) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPayload(dAtA, i, uint64(m.Input.Size()))
-		n16, err := m.Input.MarshalTo(dAtA[i:])
+		n18, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	dAtA[i] = 0x12
 	i++
 	i = encodeVarintPayload(dAtA, i, uint64(m.Address.Size()))
-	n17, err := m.Address.MarshalTo(dAtA[i:])
+	n19, err := m.Address.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n19
 	if m.Height != 0 {
 		dAtA[i] = 0x18
 		i++
@@ -824,6 +916,88 @@ func (m *GovTx) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.MinimumFees) > 0 {
+		for _, msg := range m.MinimumFees {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *MinimumFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinimumFee) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.TxType))
+	}
+	if m.Fee != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPayload(dAtA, i, uint64(m.Fee))
+	}
+	return i, nil
+}
+
+func (m *BatchTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchTx) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, msg := range m.Inputs {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, msg := range m.Txs {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPayload(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -867,6 +1041,10 @@ func (m *Any) Size() (n int) {
 		l = m.UnbondTx.Size()
 		n += 1 + l + sovPayload(uint64(l))
 	}
+	if m.BatchTx != nil {
+		l = m.BatchTx.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	return n
 }
 
@@ -914,6 +1092,10 @@ func (m *CallTx) Size() (n int) {
 	}
 	l = m.Data.Size()
 	n += 1 + l + sovPayload(uint64(l))
+	if m.ContractMeta != nil {
+		l = m.ContractMeta.Size()
+		n += 1 + l + sovPayload(uint64(l))
+	}
 	return n
 }
 
@@ -1016,6 +1198,42 @@ func (m *GovTx) Size() (n int) {
 			n += 1 + l + sovPayload(uint64(l))
 		}
 	}
+	if len(m.MinimumFees) > 0 {
+		for _, e := range m.MinimumFees {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinimumFee) Size() (n int) {
+	var l int
+	_ = l
+	if m.TxType != 0 {
+		n += 1 + sovPayload(uint64(m.TxType))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovPayload(uint64(m.Fee))
+	}
+	return n
+}
+
+func (m *BatchTx) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
+	if len(m.Txs) > 0 {
+		for _, e := range m.Txs {
+			l = e.Size()
+			n += 1 + l + sovPayload(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1292,6 +1510,39 @@ func (m *Any) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BatchTx == nil {
+				m.BatchTx = &BatchTx{}
+			}
+			if err := m.BatchTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -1692,6 +1943,39 @@ func (m *CallTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContractMeta == nil {
+				m.ContractMeta = &acm.ContractMeta{}
+			}
+			if err := m.ContractMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -2433,6 +2717,237 @@ func (m *GovTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumFees = append(m.MinimumFees, &MinimumFee{})
+			if err := m.MinimumFees[len(m.MinimumFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinimumFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinimumFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinimumFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxType", wireType)
+			}
+			m.TxType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxType |= (Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPayload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inputs = append(m.Inputs, &TxInput{})
+			if err := m.Inputs[len(m.Inputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, &Any{})
+			if err := m.Txs[len(m.Txs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPayload(dAtA[iNdEx:])
@@ -2563,47 +3078,54 @@ func init() { proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 func init() { golang_proto.RegisterFile("payload.proto", fileDescriptorPayload) }
 
 var fileDescriptorPayload = []byte{
-	// 665 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3b, 0x6f, 0xd4, 0x40,
-	0x10, 0xce, 0xe6, 0x9c, 0xbb, 0x63, 0x09, 0x21, 0xac, 0x00, 0x9d, 0xae, 0xb8, 0x43, 0x11, 0x82,
-	0xf0, 0x88, 0x0f, 0xf1, 0x2a, 0xd2, 0xa0, 0xbb, 0x20, 0x92, 0x20, 0x14, 0xd0, 0xc6, 0x69, 0xe8,
-	0xfc, 0x58, 0x1c, 0x8b, 0xb3, 0xd7, 0xd8, 0x6b, 0xb0, 0x3b, 0x4a, 0x7a, 0x1a, 0xca, 0x14, 0x54,
-	0xfc, 0x0a, 0xca, 0x94, 0xd4, 0x14, 0x11, 0x4a, 0x7e, 0x06, 0x0d, 0xda, 0xf1, 0xae, 0xef, 0x38,
-	0x20, 0xba, 0x80, 0x44, 0xb7, 0x33, 0xdf, 0xb7, 0x9e, 0x99, 0x6f, 0x66, 0xd6, 0xf8, 0x4c, 0x6c,
-	0x17, 0x43, 0x6e, 0x7b, 0x66, 0x9c, 0x70, 0xc1, 0x49, 0x43, 0x99, 0xed, 0x15, 0x3f, 0x10, 0xbb,
-	0x99, 0x63, 0xba, 0x3c, 0xec, 0xf9, 0xdc, 0xe7, 0x3d, 0xc0, 0x9d, 0xec, 0x05, 0x58, 0x60, 0xc0,
-	0xa9, 0xbc, 0xd7, 0x5e, 0x8c, 0x59, 0x12, 0x06, 0x69, 0x1a, 0xf0, 0x48, 0x79, 0x70, 0x1a, 0x33,
-	0xb7, 0x3c, 0x2f, 0x7d, 0x9a, 0xc5, 0xb5, 0x7e, 0x54, 0x90, 0xab, 0xb8, 0xbe, 0x66, 0x0f, 0x87,
-	0x56, 0xde, 0x42, 0x97, 0xd0, 0xf2, 0xe9, 0xdb, 0x67, 0x4d, 0x1d, 0xbd, 0x74, 0x53, 0x05, 0x4b,
-	0xe2, 0x36, 0x8b, 0x3c, 0x2b, 0x6f, 0xcd, 0x4e, 0x10, 0x4b, 0x37, 0x55, 0xb0, 0x24, 0x6e, 0xd9,
-	0x21, 0xb3, 0xf2, 0x56, 0x6d, 0x82, 0x58, 0xba, 0xa9, 0x82, 0xc9, 0x75, 0xdc, 0x78, 0xc6, 0x92,
-	0x30, 0xb5, 0xf2, 0x96, 0x01, 0xcc, 0xc5, 0x8a, 0xa9, 0xfc, 0x54, 0x13, 0xc8, 0x65, 0x3c, 0xb7,
-	0xce, 0x5f, 0x5b, 0x79, 0x6b, 0x0e, 0x98, 0x0b, 0x15, 0x13, 0xbc, 0xb4, 0x04, 0x65, 0xe8, 0x01,
-	0x87, 0x1c, 0xeb, 0x13, 0xa1, 0x4b, 0x37, 0x55, 0x30, 0x59, 0xc1, 0xcd, 0x9d, 0xc8, 0x29, 0xa9,
-	0x0d, 0xa0, 0x9e, 0xab, 0xa8, 0x1a, 0xa0, 0x15, 0x65, 0xe9, 0x3d, 0xc2, 0x0d, 0x2b, 0xdf, 0x8c,
-	0xe2, 0x4c, 0x90, 0x2d, 0xdc, 0xe8, 0x7b, 0x5e, 0xc2, 0xd2, 0x14, 0x14, 0x9b, 0x1f, 0xdc, 0xdd,
-	0x3f, 0xe8, 0xce, 0x7c, 0x3d, 0xe8, 0xde, 0x1c, 0x6b, 0xcf, 0x6e, 0x11, 0xb3, 0x64, 0xc8, 0x3c,
-	0x9f, 0x25, 0x3d, 0x27, 0x4b, 0x12, 0xfe, 0xa6, 0xe7, 0x26, 0x45, 0x2c, 0xb8, 0xa9, 0xee, 0x52,
-	0xfd, 0x11, 0x72, 0x11, 0xd7, 0xfb, 0x21, 0xcf, 0x22, 0x01, 0xba, 0x1a, 0x54, 0x59, 0xa4, 0x8d,
-	0x9b, 0xdb, 0xec, 0x55, 0xc6, 0x22, 0x97, 0x81, 0x90, 0x06, 0xad, 0xec, 0x55, 0xe3, 0xc3, 0x5e,
-	0x77, 0x66, 0x29, 0xc7, 0x4d, 0x2b, 0x7f, 0x9a, 0x89, 0xff, 0x98, 0x95, 0x8a, 0xfc, 0x1d, 0xe9,
-	0xa9, 0x21, 0x57, 0xf0, 0x1c, 0xe8, 0xa2, 0xc6, 0x67, 0xd4, 0x42, 0xa5, 0x17, 0x2d, 0x61, 0xf2,
-	0x78, 0x94, 0xe0, 0x2c, 0x24, 0x78, 0xeb, 0xef, 0x93, 0x6b, 0xe3, 0xe6, 0xba, 0x9d, 0x3e, 0x09,
-	0xc2, 0x40, 0x68, 0x69, 0xb4, 0x4d, 0x16, 0x71, 0xed, 0x11, 0x63, 0x30, 0x50, 0x06, 0x95, 0x47,
-	0xb2, 0x89, 0x8d, 0x87, 0xb6, 0xb0, 0x61, 0x72, 0xe6, 0x07, 0xf7, 0x94, 0x2e, 0x2b, 0xc7, 0x87,
-	0x76, 0x82, 0xc8, 0x4e, 0x0a, 0x73, 0x83, 0xe5, 0x83, 0x42, 0xb0, 0x94, 0xc2, 0x27, 0x54, 0xf5,
-	0x81, 0xde, 0x04, 0xb2, 0x8c, 0xeb, 0x50, 0x9d, 0x14, 0xbd, 0xf6, 0xdb, 0xea, 0x15, 0x4e, 0x6e,
-	0xe0, 0x46, 0xd9, 0x29, 0x59, 0x7e, 0xed, 0xa7, 0x79, 0xd3, 0x3d, 0xa4, 0x9a, 0xb1, 0xda, 0x7c,
-	0xb7, 0xd7, 0x9d, 0x81, 0x50, 0xbc, 0x5a, 0x91, 0xa9, 0x85, 0xbe, 0x8f, 0x9b, 0xf2, 0x4a, 0x3f,
-	0xf1, 0x53, 0xb5, 0xa9, 0xe7, 0xcd, 0xb1, 0x97, 0x40, 0x63, 0x03, 0x43, 0x0a, 0x41, 0x2b, 0xae,
-	0xaa, 0x2d, 0xd6, 0xcb, 0x3b, 0x75, 0x3c, 0x82, 0x0d, 0x79, 0x03, 0x62, 0x9d, 0xa2, 0x70, 0x96,
-	0x3e, 0x90, 0xbc, 0x56, 0xfa, 0xe4, 0xf9, 0xd7, 0xc6, 0xa8, 0x88, 0x2f, 0xf5, 0xce, 0x9e, 0x40,
-	0xcd, 0xd1, 0xfa, 0xf2, 0x3f, 0xcb, 0x59, 0x51, 0xc6, 0xf4, 0xfc, 0x88, 0x46, 0x8b, 0x3f, 0x75,
-	0x85, 0x5b, 0x93, 0xa3, 0xfb, 0xef, 0xbb, 0xb5, 0xc1, 0x02, 0x7f, 0x57, 0x0f, 0xaf, 0xb2, 0xc6,
-	0xd2, 0x7c, 0x8b, 0xd4, 0x73, 0x77, 0x02, 0x4d, 0xd6, 0xf0, 0x42, 0xdf, 0x75, 0xe5, 0x92, 0xee,
-	0xc4, 0x9e, 0x2d, 0x98, 0x1e, 0xb4, 0x0b, 0x26, 0xbc, 0xfa, 0x16, 0x0b, 0xe3, 0xa1, 0x2d, 0x98,
-	0xe2, 0x40, 0xfb, 0x11, 0x9d, 0xb8, 0x32, 0x4a, 0x61, 0xf0, 0x60, 0xff, 0xb0, 0x83, 0xbe, 0x1c,
-	0x76, 0xd0, 0xb7, 0xc3, 0x0e, 0xfa, 0x7c, 0xd4, 0x41, 0xfb, 0x47, 0x1d, 0xf4, 0xfc, 0xda, 0xf1,
-	0x15, 0x8b, 0x3c, 0xed, 0xa9, 0xfc, 0x9c, 0x3a, 0xfc, 0x67, 0xee, 0xfc, 0x08, 0x00, 0x00, 0xff,
-	0xff, 0xf8, 0x92, 0x62, 0xb5, 0xce, 0x06, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xaf, 0x63, 0x37, 0x71, 0xaf, 0x69, 0x69, 0x8f, 0x3f, 0xb2, 0x32, 0x24, 0x55, 0x84, 0xa0,
+	0xfc, 0xa9, 0x83, 0x80, 0x32, 0x74, 0x81, 0xb8, 0xa8, 0x7f, 0x10, 0x2d, 0xe8, 0xea, 0x2e, 0x48,
+	0x0c, 0x8e, 0x73, 0x24, 0x16, 0xb1, 0xcf, 0xd8, 0x67, 0xb0, 0xbf, 0x01, 0x3b, 0x0b, 0x63, 0x07,
+	0x3e, 0x01, 0x0b, 0x2b, 0x63, 0x47, 0x26, 0x06, 0x86, 0x0a, 0xb5, 0xdf, 0x82, 0x09, 0xdd, 0xf9,
+	0xce, 0x49, 0x03, 0x54, 0x2d, 0x48, 0x6c, 0xf7, 0xde, 0xef, 0x77, 0xf7, 0xde, 0xfd, 0xde, 0xbb,
+	0x77, 0x60, 0x26, 0x74, 0xb2, 0x01, 0x71, 0xba, 0x66, 0x18, 0x11, 0x4a, 0x60, 0x45, 0x98, 0xb5,
+	0xa5, 0x9e, 0x47, 0xfb, 0x49, 0xc7, 0x74, 0x89, 0xdf, 0xea, 0x91, 0x1e, 0x69, 0x71, 0xbc, 0x93,
+	0xbc, 0xe0, 0x16, 0x37, 0xf8, 0x2a, 0xdf, 0x57, 0x9b, 0x0b, 0x71, 0xe4, 0x7b, 0x71, 0xec, 0x91,
+	0x40, 0x78, 0xa6, 0x1c, 0xd7, 0x17, 0x4b, 0x10, 0x87, 0xd8, 0xcd, 0xd7, 0xcd, 0xaf, 0x25, 0xa0,
+	0xb6, 0x83, 0x0c, 0x5e, 0x05, 0xe5, 0x55, 0x67, 0x30, 0xb0, 0x53, 0x43, 0x59, 0x50, 0x16, 0xa7,
+	0x6f, 0x9f, 0x33, 0x65, 0x22, 0xb9, 0x1b, 0x09, 0x98, 0x11, 0x77, 0x70, 0xd0, 0xb5, 0x53, 0xa3,
+	0x34, 0x46, 0xcc, 0xdd, 0x48, 0xc0, 0x8c, 0xb8, 0xed, 0xf8, 0xd8, 0x4e, 0x0d, 0x75, 0x8c, 0x98,
+	0xbb, 0x91, 0x80, 0xe1, 0x75, 0x50, 0x79, 0x8a, 0x23, 0x3f, 0xb6, 0x53, 0x43, 0xe3, 0xcc, 0xb9,
+	0x82, 0x29, 0xfc, 0x48, 0x12, 0xe0, 0x65, 0x30, 0xb9, 0x4e, 0x5e, 0xdb, 0xa9, 0x31, 0xc9, 0x99,
+	0xb3, 0x05, 0x93, 0x7b, 0x51, 0x0e, 0xb2, 0xd0, 0x16, 0xe1, 0x39, 0x96, 0xc7, 0x42, 0xe7, 0x6e,
+	0x24, 0x60, 0xb8, 0x04, 0xf4, 0xdd, 0xa0, 0x93, 0x53, 0x2b, 0x9c, 0x3a, 0x5f, 0x50, 0x25, 0x80,
+	0x0a, 0x0a, 0xcb, 0xd4, 0x72, 0xa8, 0xdb, 0xb7, 0x53, 0x43, 0x1f, 0xcb, 0x54, 0xf8, 0x91, 0x24,
+	0x34, 0xdf, 0x29, 0xa0, 0x62, 0xa7, 0x9b, 0x41, 0x98, 0x50, 0xb8, 0x0d, 0x2a, 0xed, 0x6e, 0x37,
+	0xc2, 0x71, 0xcc, 0xd5, 0xad, 0x5a, 0x77, 0xf7, 0x0f, 0x1a, 0x13, 0xdf, 0x0e, 0x1a, 0x37, 0x47,
+	0xaa, 0xda, 0xcf, 0x42, 0x1c, 0x0d, 0x70, 0xb7, 0x87, 0xa3, 0x56, 0x27, 0x89, 0x22, 0xf2, 0xa6,
+	0xe5, 0x46, 0x59, 0x48, 0x89, 0x29, 0xf6, 0x22, 0x79, 0x08, 0xbc, 0x04, 0xca, 0x6d, 0x9f, 0x24,
+	0x01, 0xe5, 0x35, 0xd0, 0x90, 0xb0, 0x60, 0x0d, 0xe8, 0x3b, 0xf8, 0x55, 0x82, 0x03, 0x17, 0x73,
+	0xd1, 0x35, 0x54, 0xd8, 0x2b, 0xda, 0xfb, 0xbd, 0xc6, 0x44, 0x33, 0x05, 0xba, 0x9d, 0x3e, 0x49,
+	0xe8, 0x7f, 0xcc, 0x4a, 0x44, 0xfe, 0x58, 0x92, 0x1d, 0x06, 0xaf, 0x80, 0x49, 0xae, 0x8b, 0xa1,
+	0x8c, 0x89, 0x28, 0xf4, 0x42, 0x39, 0x0c, 0x1f, 0x0d, 0x13, 0x2c, 0xf1, 0x04, 0x6f, 0xfd, 0x7d,
+	0x72, 0x35, 0xa0, 0xaf, 0x3b, 0xf1, 0x63, 0xcf, 0xf7, 0xa8, 0x94, 0x46, 0xda, 0x70, 0x0e, 0xa8,
+	0x6b, 0x18, 0xf3, 0xe6, 0xd3, 0x10, 0x5b, 0xc2, 0x4d, 0xa0, 0x3d, 0x74, 0xa8, 0xc3, 0xbb, 0xac,
+	0x6a, 0x2d, 0x0b, 0x5d, 0x96, 0x4e, 0x0e, 0xdd, 0xf1, 0x02, 0x27, 0xca, 0xcc, 0x0d, 0x9c, 0x5a,
+	0x19, 0xc5, 0x31, 0xe2, 0x47, 0xc0, 0x65, 0x50, 0x5d, 0x25, 0x01, 0x8d, 0x1c, 0x97, 0x6e, 0x61,
+	0xea, 0x88, 0x8e, 0x9c, 0x37, 0xd9, 0x73, 0x1c, 0x05, 0xd0, 0x31, 0x9a, 0x10, 0xcd, 0x93, 0x8f,
+	0x0d, 0x2e, 0x82, 0x32, 0x17, 0x85, 0xd5, 0x4a, 0xfd, 0xad, 0x68, 0x02, 0x87, 0x37, 0x40, 0x25,
+	0x2f, 0x30, 0x53, 0x4d, 0x3d, 0xd6, 0xd2, 0xb2, 0xf4, 0x48, 0x32, 0x56, 0xf4, 0xb7, 0x7b, 0x8d,
+	0x09, 0x1e, 0x8a, 0x14, 0xaf, 0xf0, 0xd4, 0xf5, 0xb9, 0x07, 0x74, 0xb6, 0xa5, 0x1d, 0xf5, 0x62,
+	0x31, 0x0c, 0x2e, 0x98, 0x23, 0x73, 0x47, 0x62, 0x96, 0xc6, 0xf4, 0x43, 0x05, 0x57, 0xdc, 0x2d,
+	0x94, 0xf3, 0xe1, 0xd4, 0xf1, 0x20, 0xd0, 0xd8, 0x0e, 0x1e, 0x6b, 0x0a, 0xf1, 0x35, 0xf3, 0xf1,
+	0x4a, 0xa9, 0xb9, 0x8f, 0x4b, 0xfe, 0x4b, 0x3d, 0x45, 0xc4, 0x97, 0x72, 0x2c, 0x9c, 0x41, 0xcd,
+	0xe1, 0x84, 0x20, 0x7f, 0x96, 0xb3, 0xa0, 0x8c, 0xe8, 0xf9, 0x41, 0x01, 0xc3, 0xc1, 0x71, 0xda,
+	0x1b, 0x6e, 0x8f, 0x77, 0xfc, 0xbf, 0x3f, 0xc9, 0x0d, 0xec, 0xf5, 0xfa, 0xb2, 0xe7, 0x85, 0x35,
+	0x92, 0xe6, 0x27, 0x45, 0x4c, 0xd4, 0x33, 0x68, 0xb2, 0x0a, 0x66, 0xdb, 0xae, 0xcb, 0xde, 0xf6,
+	0x6e, 0xd8, 0x75, 0x28, 0x96, 0x8d, 0x76, 0xd1, 0xe4, 0x1f, 0x8b, 0x8d, 0xfd, 0x70, 0xe0, 0x50,
+	0x2c, 0x38, 0xbc, 0xfc, 0x0a, 0x1a, 0xdb, 0x02, 0x97, 0xc1, 0xf4, 0x96, 0x17, 0x78, 0x7e, 0xe2,
+	0xaf, 0x61, 0x1c, 0x1b, 0x2a, 0x3f, 0xe1, 0x7c, 0x11, 0x73, 0x88, 0xa1, 0x51, 0xde, 0x48, 0xe6,
+	0x0f, 0x00, 0x18, 0x02, 0x70, 0x01, 0x94, 0xed, 0xd4, 0xce, 0x42, 0xcc, 0x25, 0x9e, 0xb1, 0xf4,
+	0x1f, 0x07, 0x0d, 0x8d, 0xd9, 0x48, 0xf8, 0x65, 0x57, 0x94, 0x8a, 0xae, 0x68, 0x3e, 0x2f, 0xc6,
+	0xf9, 0x19, 0x2e, 0x5f, 0x07, 0xaa, 0x9d, 0xca, 0x1b, 0x57, 0x0b, 0x5a, 0x3b, 0xc8, 0x10, 0x03,
+	0x86, 0x09, 0x5a, 0xf7, 0xf7, 0x0f, 0xeb, 0xca, 0x97, 0xc3, 0xba, 0xf2, 0xfd, 0xb0, 0xae, 0x7c,
+	0x3e, 0xaa, 0x2b, 0xfb, 0x47, 0x75, 0xe5, 0xd9, 0xb5, 0x93, 0x2b, 0x49, 0xd3, 0xb8, 0x25, 0xce,
+	0xec, 0x94, 0xf9, 0x17, 0x7d, 0xe7, 0xe7, 0x00, 0x62, 0x92, 0x8d, 0xc5, 0x14, 0x08, 0x00, 0x00,
 }
@@ -18,6 +18,9 @@ func (tx *UnbondTx) Type() Type {
 }
 
 func (tx *UnbondTx) GetInputs() []*TxInput {
+	if tx.Input == nil {
+		return nil
+	}
 	return []*TxInput{tx.Input}
 }
 
@@ -66,6 +66,8 @@ type Signatory struct {
 	Address   *github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address,omitempty"`
 	PublicKey *crypto.PublicKey                              `protobuf:"bytes,2,opt,name=PublicKey" json:"PublicKey,omitempty"`
 	Signature github_com_hyperledger_burrow_crypto.Signature `protobuf:"bytes,3,opt,name=Signature,proto3,customtype=github.com/hyperledger/burrow/crypto.Signature" json:"Signature"`
+	// The signatures of members of the multisig account at Address, which are given instead of PublicKey and Signature
+	Multisig []Signatory `protobuf:"bytes,4,rep,name=Multisig" json:"Multisig,omitempty"`
 }
 
 func (m *Signatory) Reset()                    { *m = Signatory{} }
@@ -80,6 +82,13 @@ func (m *Signatory) GetPublicKey() *crypto.PublicKey {
 	return nil
 }
 
+func (m *Signatory) GetMultisig() []Signatory {
+	if m != nil {
+		return m.Multisig
+	}
+	return nil
+}
+
 func (*Signatory) XXX_MessageName() string {
 	return "txs.Signatory"
 }
@@ -209,6 +218,18 @@ func (m *Signatory) MarshalTo(dAtA []byte) (int, error) {
 		return 0, err
 	}
 	i += n4
+	if len(m.Multisig) > 0 {
+		for _, msg := range m.Multisig {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintTxs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -299,6 +320,12 @@ func (m *Signatory) Size() (n int) {
 	}
 	l = m.Signature.Size()
 	n += 1 + l + sovTxs(uint64(l))
+	if len(m.Multisig) > 0 {
+		for _, e := range m.Multisig {
+			l = e.Size()
+			n += 1 + l + sovTxs(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -568,6 +595,37 @@ func (m *Signatory) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, Signatory{})
+			if err := m.Multisig[len(m.Multisig)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxs(dAtA[iNdEx:])
@@ -847,32 +905,34 @@ func init() { proto.RegisterFile("txs.proto", fileDescriptorTxs) }
 func init() { golang_proto.RegisterFile("txs.proto", fileDescriptorTxs) }
 
 var fileDescriptorTxs = []byte{
-	// 422 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6e, 0xd4, 0x30,
-	0x10, 0xc6, 0xeb, 0xec, 0x6a, 0xdb, 0xf5, 0x16, 0x2a, 0x7c, 0x40, 0xab, 0x1e, 0x92, 0xb2, 0xa7,
-	0x3d, 0xd0, 0x04, 0x2d, 0x50, 0x24, 0x6e, 0xa4, 0x42, 0xaa, 0x8a, 0x90, 0x90, 0xc9, 0x89, 0x03,
-	0x22, 0x7f, 0x86, 0x6c, 0xa4, 0x10, 0x47, 0xb6, 0x03, 0xf6, 0x9b, 0x70, 0xe4, 0x09, 0x78, 0x06,
-	0x8e, 0x7b, 0xe4, 0xbc, 0x87, 0x08, 0x6d, 0xdf, 0x82, 0x13, 0x8a, 0x71, 0xb6, 0x55, 0x0f, 0x94,
-	0xde, 0x3c, 0xf6, 0x7c, 0xbf, 0xf9, 0x66, 0xc6, 0x78, 0x2c, 0x95, 0xf0, 0x6b, 0xce, 0x24, 0x23,
-	0x03, 0xa9, 0xc4, 0xe1, 0x71, 0x5e, 0xc8, 0x65, 0x93, 0xf8, 0x29, 0xfb, 0x14, 0xe4, 0x2c, 0x67,
-	0x81, 0x79, 0x4b, 0x9a, 0x8f, 0x26, 0x32, 0x81, 0x39, 0xfd, 0xd5, 0x1c, 0xee, 0xa7, 0x5c, 0xd7,
-	0xd2, 0x46, 0xb3, 0x0f, 0x78, 0xef, 0x65, 0xf5, 0x19, 0x4a, 0x56, 0x03, 0x39, 0xc1, 0x93, 0xb7,
-	0x45, 0x5e, 0xc5, 0x92, 0xf1, 0x02, 0xc4, 0x14, 0x1d, 0x0d, 0xe6, 0x93, 0xc5, 0x5d, 0xbf, 0x2b,
-	0xd7, 0xdf, 0xeb, 0x70, 0xb8, 0x6a, 0xbd, 0x1d, 0x7a, 0x35, 0x91, 0xdc, 0xc7, 0x4e, 0xa4, 0xa6,
-	0xce, 0x11, 0x9a, 0xef, 0x87, 0xa3, 0x75, 0xeb, 0x39, 0x91, 0xa2, 0x4e, 0xa4, 0x9e, 0x0f, 0xbf,
-	0x7e, 0xf3, 0x76, 0x66, 0x2d, 0xc2, 0xe3, 0xad, 0x9c, 0x9c, 0xe3, 0xdd, 0x17, 0x59, 0xc6, 0x41,
-	0x74, 0xfc, 0x4e, 0xf0, 0x68, 0xdd, 0x7a, 0x0f, 0xaf, 0x74, 0xb0, 0xd4, 0x35, 0xf0, 0x12, 0xb2,
-	0x1c, 0x78, 0x90, 0x34, 0x9c, 0xb3, 0x2f, 0x81, 0x35, 0x6c, 0x75, 0xb4, 0x07, 0x90, 0x00, 0x8f,
-	0xdf, 0x34, 0x49, 0x59, 0xa4, 0xaf, 0x40, 0x9b, 0xf2, 0x93, 0xc5, 0x3d, 0xdf, 0x26, 0x6f, 0x1f,
-	0xe8, 0x65, 0x0e, 0x89, 0x7a, 0x27, 0x0d, 0x87, 0xe9, 0xc0, 0x94, 0x3f, 0xe9, 0xda, 0x59, 0xb7,
-	0x9e, 0xff, 0x5f, 0x16, 0xb6, 0x6a, 0x7a, 0x09, 0x9a, 0x7d, 0x77, 0xf0, 0x2e, 0x85, 0x14, 0x8a,
-	0x5a, 0x92, 0x73, 0x3c, 0x8a, 0x54, 0xa4, 0x6b, 0x30, 0xdd, 0xdd, 0x09, 0x17, 0xbf, 0x6f, 0x44,
-	0x4b, 0x25, 0x82, 0x3a, 0xd6, 0x25, 0x8b, 0x33, 0xbf, 0x53, 0x52, 0x4b, 0x20, 0xaf, 0x3b, 0xd6,
-	0x59, 0x2c, 0x96, 0x76, 0xb4, 0x4f, 0xad, 0xd5, 0xe3, 0x7f, 0xf3, 0x92, 0xa2, 0x8a, 0xb9, 0xf6,
-	0xcf, 0x40, 0x85, 0x5a, 0x82, 0xa0, 0x16, 0x42, 0xe6, 0xf8, 0xe0, 0x94, 0x43, 0x2c, 0x41, 0x9c,
-	0xb2, 0x4a, 0xf2, 0x38, 0x95, 0x66, 0x04, 0x7b, 0xf4, 0xfa, 0x35, 0x79, 0x8f, 0x0f, 0xfa, 0x73,
-	0xbf, 0xab, 0xa1, 0x71, 0xf0, 0xc4, 0x3a, 0xb8, 0xdd, 0xbe, 0xae, 0xc3, 0xc2, 0x67, 0xab, 0x8d,
-	0x8b, 0x7e, 0x6e, 0x5c, 0xf4, 0x6b, 0xe3, 0xa2, 0x1f, 0x17, 0x2e, 0x5a, 0x5d, 0xb8, 0xe8, 0xdd,
-	0x83, 0x1b, 0xc7, 0x94, 0x8c, 0xcc, 0x9f, 0x7d, 0xfc, 0x27, 0x00, 0x00, 0xff, 0xff, 0xcd, 0x2f,
-	0x9b, 0x0b, 0x02, 0x03, 0x00, 0x00,
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x6b, 0x27, 0x4a, 0x93, 0x4b, 0xa1, 0xe2, 0x06, 0x14, 0x65, 0xb0, 0x4b, 0xa6, 0x0c,
+	0xad, 0x8d, 0x02, 0x14, 0x89, 0x0d, 0x57, 0xa0, 0xaa, 0xa8, 0x12, 0x3a, 0x3c, 0x31, 0x20, 0x6c,
+	0xe7, 0xe1, 0x9c, 0xe4, 0xe4, 0xac, 0xbb, 0x33, 0xf8, 0xbe, 0x09, 0x23, 0x13, 0x23, 0x9f, 0x81,
+	0x31, 0x23, 0x73, 0x06, 0x0b, 0xa5, 0x1b, 0x1f, 0x81, 0x09, 0xf9, 0x38, 0xbb, 0x55, 0x85, 0x28,
+	0x6c, 0xf7, 0xee, 0xde, 0xff, 0xf7, 0xfe, 0xef, 0xbd, 0x43, 0x03, 0x59, 0x0a, 0x2f, 0xe7, 0x4c,
+	0x32, 0xdc, 0x91, 0xa5, 0x18, 0x1f, 0xa5, 0x54, 0x2e, 0x8a, 0xd8, 0x4b, 0xd8, 0xd2, 0x4f, 0x59,
+	0xca, 0x7c, 0xfd, 0x16, 0x17, 0xef, 0x74, 0xa4, 0x03, 0x7d, 0xfa, 0xad, 0x19, 0xef, 0x25, 0x5c,
+	0xe5, 0xd2, 0x44, 0x93, 0xb7, 0xa8, 0xff, 0x6c, 0xf5, 0x1e, 0x32, 0x96, 0x03, 0x3e, 0x46, 0xc3,
+	0x57, 0x34, 0x5d, 0x45, 0x92, 0x71, 0x0a, 0x62, 0x64, 0x1d, 0x74, 0xa6, 0xc3, 0xd9, 0x6d, 0xaf,
+	0x2e, 0xd7, 0xdc, 0xab, 0xa0, 0xbb, 0xae, 0xdc, 0x1d, 0x72, 0x35, 0x11, 0xdf, 0x45, 0x76, 0x58,
+	0x8e, 0xec, 0x03, 0x6b, 0xba, 0x17, 0xf4, 0x36, 0x95, 0x6b, 0x87, 0x25, 0xb1, 0xc3, 0xf2, 0x49,
+	0xf7, 0xe3, 0x27, 0x77, 0x67, 0xf2, 0xd9, 0x46, 0x83, 0x56, 0x8e, 0xcf, 0xd0, 0xee, 0xd3, 0xf9,
+	0x9c, 0x83, 0xa8, 0xf9, 0xb5, 0xe0, 0xfe, 0xa6, 0x72, 0x0f, 0xaf, 0x74, 0xb0, 0x50, 0x39, 0xf0,
+	0x0c, 0xe6, 0x29, 0x70, 0x3f, 0x2e, 0x38, 0x67, 0x1f, 0x7c, 0x63, 0xd8, 0xe8, 0x48, 0x03, 0xc0,
+	0x3e, 0x1a, 0xbc, 0x2c, 0xe2, 0x8c, 0x26, 0x2f, 0x40, 0xe9, 0xf2, 0xc3, 0xd9, 0x1d, 0xcf, 0x24,
+	0xb7, 0x0f, 0xe4, 0x32, 0x07, 0x87, 0x8d, 0x93, 0x82, 0xc3, 0xa8, 0xa3, 0xcb, 0x1f, 0xd7, 0xed,
+	0x6c, 0x2a, 0xd7, 0xfb, 0x27, 0x0b, 0xad, 0x9a, 0x5c, 0x82, 0xf0, 0x73, 0xd4, 0x3f, 0x2f, 0x32,
+	0x49, 0x05, 0x4d, 0x47, 0xdd, 0x3f, 0xce, 0x6c, 0x5c, 0x17, 0xf9, 0x51, 0xb9, 0xb8, 0xc9, 0x3b,
+	0x64, 0x4b, 0x2a, 0x61, 0x99, 0x4b, 0x45, 0x5a, 0xed, 0xe4, 0x8b, 0x8d, 0x76, 0x09, 0x24, 0x40,
+	0x73, 0x89, 0xcf, 0x50, 0x2f, 0x2c, 0x43, 0x95, 0x83, 0x9e, 0xd2, 0xad, 0x60, 0xf6, 0xf3, 0x46,
+	0x8b, 0xb2, 0x14, 0x7e, 0x1e, 0xa9, 0x8c, 0x45, 0x73, 0xaf, 0x56, 0x12, 0x43, 0xc0, 0xe7, 0x35,
+	0xeb, 0x34, 0x12, 0x0b, 0xb3, 0xa2, 0x47, 0xa6, 0xe5, 0xa3, 0xbf, 0xf3, 0x62, 0xba, 0x8a, 0xb8,
+	0xf2, 0x4e, 0xa1, 0x0c, 0x94, 0x04, 0x41, 0x0c, 0x04, 0x4f, 0xd1, 0xfe, 0x09, 0x87, 0x48, 0x82,
+	0x38, 0x61, 0x2b, 0xc9, 0xa3, 0x44, 0xea, 0x51, 0xf6, 0xc9, 0xf5, 0x6b, 0xfc, 0x06, 0xed, 0x37,
+	0xe7, 0x66, 0xe7, 0x5d, 0xed, 0xe0, 0xa1, 0x71, 0xf0, 0x7f, 0x7b, 0xbf, 0x0e, 0x0b, 0x1e, 0xaf,
+	0xb7, 0x8e, 0xf5, 0x6d, 0xeb, 0x58, 0xdf, 0xb7, 0x8e, 0xf5, 0xf5, 0xc2, 0xb1, 0xd6, 0x17, 0x8e,
+	0xf5, 0xfa, 0xde, 0x8d, 0x63, 0x8a, 0x7b, 0xfa, 0xef, 0x3f, 0xf8, 0x35, 0x00, 0xf6, 0x8e, 0x36,
+	0x48, 0x4a, 0x03, 0x00, 0x00,
 }